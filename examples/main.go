@@ -88,7 +88,7 @@ func exampleSet() {
 	fmt.Printf("Even numbers in set1: %v\n", evenNumbers)
 
 	hasEven := set1.Any(func(x int) bool { return x%2 == 0 })
-	allPositive := set1.All(func(x int) bool { return x > 0 })
+	allPositive := set1.Every(func(x int) bool { return x > 0 })
 	fmt.Printf("Has even numbers: %v\n", hasEven)
 	fmt.Printf("All positive: %v\n", allPositive)
 
@@ -576,7 +576,8 @@ func exampleGraph() {
 	}
 
 	// Minimum spanning tree (MST) - Prim's algorithm
-	fmt.Printf("Minimum Spanning Tree (Prim's) starting from 1: %v\n", graph.PrimMST(1))
+	mst, mstWeight := graph.PrimMST(1)
+	fmt.Printf("Minimum Spanning Tree (Prim's) starting from 1: %v (weight %.1f)\n", mst, mstWeight)
 
 	// Functional operations
 	largeDegreeNodes := graph.Filter(func(node int, degree int) bool { return degree > 2 })
@@ -646,40 +647,12 @@ func exampleAdvancedFeatures() {
 		}
 	}
 
-	// Example: Sliding window using Deque
+	// Example: Sliding window maximum using MonotonicQueue
 	fmt.Println("\nSliding Window Maximum:")
 	numbers := []int{1, 3, -1, -3, 5, 3, 6, 7}
 	k := 3 // window size
 
-	deque := stl.NewDeque[int](len(numbers))
-	result := make([]int, 0, len(numbers)-k+1)
-
-	for i, num := range numbers {
-		// Remove elements outside the window
-		if !deque.IsEmpty() {
-			if front, _ := deque.Front(); front <= i-k {
-				deque.PopFront()
-			}
-		}
-
-		// Remove smaller elements from the back
-		for !deque.IsEmpty() {
-			if back, _ := deque.Back(); numbers[back] < num {
-				deque.PopBack()
-			} else {
-				break
-			}
-		}
-
-		deque.PushBack(i)
-
-		// Add maximum to result
-		if i >= k-1 {
-			if front, _ := deque.Front(); front != -1 {
-				result = append(result, numbers[front])
-			}
-		}
-	}
+	result := stl.SlidingWindowMax(numbers, k, func(a, b int) bool { return a < b })
 
 	fmt.Printf("Sliding window maximum for window size %d: %v\n", k, result)
 }
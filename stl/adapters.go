@@ -0,0 +1,113 @@
+package stl
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// sliceAdapter exposes a slice-backed container to container/heap and sort. It holds a
+// pointer to the container's slice so that heap.Push and heap.Pop can resize it.
+type sliceAdapter[T any] struct {
+	data *[]T
+	less func(T, T) bool
+}
+
+func (a sliceAdapter[T]) Len() int           { return len(*a.data) }
+func (a sliceAdapter[T]) Less(i, j int) bool { return a.less((*a.data)[i], (*a.data)[j]) }
+func (a sliceAdapter[T]) Swap(i, j int)      { (*a.data)[i], (*a.data)[j] = (*a.data)[j], (*a.data)[i] }
+func (a sliceAdapter[T]) Push(x any)         { *a.data = append(*a.data, x.(T)) }
+
+func (a sliceAdapter[T]) Pop() any {
+	n := len(*a.data) - 1
+	item := (*a.data)[n]
+	var zero T
+	(*a.data)[n] = zero
+	*a.data = (*a.data)[:n]
+	return item
+}
+
+// dequeAdapter exposes a Deque to container/heap and sort through its indexed API.
+type dequeAdapter[T any] struct {
+	d    *Deque[T]
+	less func(T, T) bool
+}
+
+func (a dequeAdapter[T]) Len() int { return a.d.Size() }
+
+func (a dequeAdapter[T]) Less(i, j int) bool {
+	x, _ := a.d.At(i)
+	y, _ := a.d.At(j)
+	return a.less(x, y)
+}
+
+func (a dequeAdapter[T]) Swap(i, j int) { a.d.Swap(i, j) }
+func (a dequeAdapter[T]) Push(x any)    { a.d.PushBack(x.(T)) }
+
+func (a dequeAdapter[T]) Pop() any {
+	item, _ := a.d.PopBack()
+	return item
+}
+
+// priorityQueueAdapter exposes a PriorityQueue's heap array to container/heap using the
+// queue's own ordering, including stable tie-breaking.
+type priorityQueueAdapter[T any] struct {
+	pq *PriorityQueue[T]
+}
+
+func (a priorityQueueAdapter[T]) Len() int           { return len(a.pq.data) }
+func (a priorityQueueAdapter[T]) Less(i, j int) bool { return a.pq.before(i, j) }
+func (a priorityQueueAdapter[T]) Swap(i, j int)      { a.pq.swap(i, j) }
+
+func (a priorityQueueAdapter[T]) Push(x any) {
+	a.pq.data = append(a.pq.data, x.(T))
+	if a.pq.seqs != nil {
+		a.pq.seqs = append(a.pq.seqs, a.pq.nextSeq)
+		a.pq.nextSeq++
+	}
+}
+
+func (a priorityQueueAdapter[T]) Pop() any {
+	item := a.pq.data[len(a.pq.data)-1]
+	a.pq.removeLast(len(a.pq.data) - 1)
+	return item
+}
+
+// AsHeapInterface returns a view of the priority queue for container/heap. The queue's heap
+// array already satisfies the heap invariant, so heap.Push, heap.Pop, heap.Fix, and
+// heap.Remove can be mixed freely with the queue's own methods.
+func (pq *PriorityQueue[T]) AsHeapInterface() heap.Interface {
+	return priorityQueueAdapter[T]{pq: pq}
+}
+
+// AsHeapInterface returns a view of the stack for container/heap ordered by less. Call
+// heap.Init before other heap functions; the heap root is the bottom of the stack.
+func (s *Stack[T]) AsHeapInterface(less func(T, T) bool) heap.Interface {
+	return sliceAdapter[T]{data: &s.data, less: less}
+}
+
+// AsSortInterface returns a view of the stack for sort, ordered from bottom to top by less.
+func (s *Stack[T]) AsSortInterface(less func(T, T) bool) sort.Interface {
+	return sliceAdapter[T]{data: &s.data, less: less}
+}
+
+// AsHeapInterface returns a view of the queue for container/heap ordered by less. Call
+// heap.Init before other heap functions; the heap root is the front of the queue.
+func (q *Queue[T]) AsHeapInterface(less func(T, T) bool) heap.Interface {
+	return sliceAdapter[T]{data: &q.data, less: less}
+}
+
+// AsSortInterface returns a view of the queue for sort, ordered from front to back by less.
+func (q *Queue[T]) AsSortInterface(less func(T, T) bool) sort.Interface {
+	return sliceAdapter[T]{data: &q.data, less: less}
+}
+
+// AsHeapInterface returns a view of the deque for container/heap ordered by less. Call
+// heap.Init before other heap functions; the heap root is the front of the deque.
+func (d *Deque[T]) AsHeapInterface(less func(T, T) bool) heap.Interface {
+	return dequeAdapter[T]{d: d, less: less}
+}
+
+// AsSortInterface returns a view of the deque for sort, ordered from front to back by less.
+func (d *Deque[T]) AsSortInterface(less func(T, T) bool) sort.Interface {
+	return dequeAdapter[T]{d: d, less: less}
+}
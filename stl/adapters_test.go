@@ -0,0 +1,66 @@
+package stl
+
+import (
+	"container/heap"
+	"sort"
+	"testing"
+)
+
+func TestPriorityQueueAsHeapInterface(t *testing.T) {
+	pq := NewPriorityQueue(lessInt)
+	for _, value := range []int{5, 2, 8} {
+		pq.Enqueue(value)
+	}
+
+	h := pq.AsHeapInterface()
+	heap.Push(h, 1)
+	heap.Push(h, 7)
+	if value := heap.Pop(h).(int); value != 1 {
+		t.Errorf("Expected heap.Pop to return 1, got %d", value)
+	}
+
+	// The queue and container/heap share the same heap array
+	expected := []int{2, 5, 7, 8}
+	for _, want := range expected {
+		if value, _ := pq.Dequeue(); value != want {
+			t.Fatalf("Expected %d, got %d", want, value)
+		}
+	}
+}
+
+func TestContainerSortAndHeapAdapters(t *testing.T) {
+	stack := NewStack[int]()
+	stack.PushAll([]int{3, 1, 2})
+	sort.Sort(stack.AsSortInterface(lessInt))
+	if top, _ := stack.Peek(); top != 3 || stack.String() != "Stack[1 2 3]" {
+		t.Errorf("Expected sorted stack [1 2 3], got %s", stack.String())
+	}
+
+	queue := NewQueue[string]()
+	queue.EnqueueAll([]string{"pear", "apple", "fig"})
+	sort.Sort(queue.AsSortInterface(func(a, b string) bool { return a < b }))
+	if front, _ := queue.Peek(); front != "apple" {
+		t.Errorf("Expected apple at the front, got %s", front)
+	}
+
+	deque := NewDeque[int](2)
+	deque.PushBack(4)
+	deque.PushFront(9)
+	deque.PushBack(6)
+	h := deque.AsHeapInterface(lessInt)
+	heap.Init(h)
+	heap.Push(h, 1)
+	var popped []int
+	for h.Len() > 0 {
+		popped = append(popped, heap.Pop(h).(int))
+	}
+	if !sort.IntsAreSorted(popped) || len(popped) != 4 {
+		t.Errorf("Expected ascending heap order, got %v", popped)
+	}
+
+	descending := NewDequeFromSlice([]int{1, 3, 2})
+	sort.Sort(sort.Reverse(descending.AsSortInterface(lessInt)))
+	if front, _ := descending.Front(); front != 3 {
+		t.Errorf("Expected 3 at the front after a reverse sort, got %d", front)
+	}
+}
@@ -0,0 +1,67 @@
+package stl
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAppendTo(t *testing.T) {
+	values := []int{3, 1, 2}
+	prefix := []int{9}
+
+	ordered := map[string]interface{ AppendTo([]int) []int }{
+		"SortedList": NewSortedListFromSlice(values, lessInt),
+		"AVLTree":    NewAVLTreeFromSlice(values, lessInt),
+		"Treap":      NewTreapFromSlice(values, lessInt),
+	}
+	for name, c := range ordered {
+		if got := c.AppendTo(prefix[:1:1]); !slices.Equal(got, []int{9, 1, 2, 3}) {
+			t.Errorf("%s: Expected [9 1 2 3], got %v", name, got)
+		}
+	}
+
+	deque := NewDequeFromSlice(values)
+	if got := deque.AppendTo(prefix[:1:1]); !slices.Equal(got, []int{9, 3, 1, 2}) {
+		t.Errorf("Expected [9 3 1 2], got %v", got)
+	}
+	set := NewSetFromSlice(values)
+	if got := set.AppendTo(prefix[:1:1]); len(got) != 4 || got[0] != 9 {
+		t.Errorf("Expected the prefix followed by 3 elements, got %v", got)
+	}
+
+	tm := NewTreeMap[int, string](lessInt)
+	tm.Put(2, "b")
+	tm.Put(1, "a")
+	if keys, vals := tm.AppendKeys([]int{0}), tm.AppendValues(nil); !slices.Equal(keys, []int{0, 1, 2}) || !slices.Equal(vals, []string{"a", "b"}) {
+		t.Errorf("Expected [0 1 2] and [a b], got %v and %v", keys, vals)
+	}
+}
+
+func TestAppendToReusesBuffer(t *testing.T) {
+	list := NewLinkedList[int]()
+	ring := NewRingBuffer[int](4, OverflowOverwrite)
+	for i := 0; i < 4; i++ {
+		list.PushBack(i)
+		ring.Push(i)
+	}
+	lhm := NewLinkedHashMap[int, int](false)
+	lhm.Put(1, 1)
+
+	buf := make([]int, 0, 16)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = list.AppendTo(buf[:0])
+		buf = ring.AppendTo(buf)
+		buf = lhm.AppendKeys(buf)
+	})
+	if allocs != 0 {
+		t.Errorf("Expected no allocations with a large enough buffer, got %v", allocs)
+	}
+	if !slices.Equal(buf, []int{0, 1, 2, 3, 0, 1, 2, 3, 1}) {
+		t.Errorf("Expected [0 1 2 3 0 1 2 3 1], got %v", buf)
+	}
+
+	// Empty containers leave dst untouched
+	if got := NewStack[int]().AppendTo(nil); got != nil {
+		t.Errorf("Expected nil, got %v", got)
+	}
+}
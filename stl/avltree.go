@@ -0,0 +1,544 @@
+package stl
+
+import (
+	"fmt"
+)
+
+// avlNode represents a node in an AVLTree.
+type avlNode[T comparable] struct {
+	value  T
+	left   *avlNode[T]
+	right  *avlNode[T]
+	height int
+	size   int
+}
+
+// AVLTree represents a self-balancing binary search tree of unique values. Insert, Delete,
+// Search, Rank, and Select take O(log n) in the worst case.
+type AVLTree[T comparable] struct {
+	root *avlNode[T]
+	less func(T, T) bool
+}
+
+// NewAVLTree creates a new empty AVL tree with a comparator function.
+func NewAVLTree[T comparable](less func(T, T) bool) *AVLTree[T] {
+	return &AVLTree[T]{
+		less: less,
+	}
+}
+
+// NewAVLTreeFromSlice creates an AVL tree from a slice, ignoring duplicates.
+func NewAVLTreeFromSlice[T comparable](slice []T, less func(T, T) bool) *AVLTree[T] {
+	tree := NewAVLTree[T](less)
+	for _, item := range slice {
+		tree.Insert(item)
+	}
+	return tree
+}
+
+// avlHeight returns the height of a subtree, 0 for an empty one.
+func avlHeight[T comparable](node *avlNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.height
+}
+
+// avlSize returns the number of nodes in a subtree.
+func avlSize[T comparable](node *avlNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+// update recomputes the cached height and size of node from its children.
+func (t *AVLTree[T]) update(node *avlNode[T]) {
+	node.height = 1 + max(avlHeight(node.left), avlHeight(node.right))
+	node.size = 1 + avlSize(node.left) + avlSize(node.right)
+}
+
+// rotateLeft lifts the right child of node into its place and returns it.
+func (t *AVLTree[T]) rotateLeft(node *avlNode[T]) *avlNode[T] {
+	pivot := node.right
+	node.right = pivot.left
+	pivot.left = node
+	t.update(node)
+	t.update(pivot)
+	return pivot
+}
+
+// rotateRight lifts the left child of node into its place and returns it.
+func (t *AVLTree[T]) rotateRight(node *avlNode[T]) *avlNode[T] {
+	pivot := node.left
+	node.left = pivot.right
+	pivot.right = node
+	t.update(node)
+	t.update(pivot)
+	return pivot
+}
+
+// rebalance restores the AVL invariant at node and returns the new subtree root.
+func (t *AVLTree[T]) rebalance(node *avlNode[T]) *avlNode[T] {
+	t.update(node)
+
+	switch balance := avlHeight(node.left) - avlHeight(node.right); {
+	case balance > 1:
+		if avlHeight(node.left.left) < avlHeight(node.left.right) {
+			node.left = t.rotateLeft(node.left)
+		}
+		return t.rotateRight(node)
+	case balance < -1:
+		if avlHeight(node.right.right) < avlHeight(node.right.left) {
+			node.right = t.rotateRight(node.right)
+		}
+		return t.rotateLeft(node)
+	}
+
+	return node
+}
+
+// Insert adds a value to the tree. Inserting a value that is already present has no effect.
+func (t *AVLTree[T]) Insert(value T) {
+	t.root = t.insertRecursive(t.root, value)
+}
+
+// insertRecursive is the recursive helper for Insert.
+func (t *AVLTree[T]) insertRecursive(node *avlNode[T], value T) *avlNode[T] {
+	if node == nil {
+		return &avlNode[T]{value: value, height: 1, size: 1}
+	}
+
+	switch {
+	case t.less(value, node.value):
+		node.left = t.insertRecursive(node.left, value)
+	case t.less(node.value, value):
+		node.right = t.insertRecursive(node.right, value)
+	default:
+		return node
+	}
+
+	return t.rebalance(node)
+}
+
+// Search checks if a value exists in the tree.
+func (t *AVLTree[T]) Search(value T) bool {
+	current := t.root
+	for current != nil {
+		switch {
+		case t.less(value, current.value):
+			current = current.left
+		case t.less(current.value, value):
+			current = current.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Delete removes a value from the tree and reports whether it was present.
+func (t *AVLTree[T]) Delete(value T) bool {
+	if !t.Search(value) {
+		return false
+	}
+	t.root = t.deleteRecursive(t.root, value)
+	return true
+}
+
+// deleteRecursive is the recursive helper for Delete.
+func (t *AVLTree[T]) deleteRecursive(node *avlNode[T], value T) *avlNode[T] {
+	if node == nil {
+		return nil
+	}
+
+	switch {
+	case t.less(value, node.value):
+		node.left = t.deleteRecursive(node.left, value)
+	case t.less(node.value, value):
+		node.right = t.deleteRecursive(node.right, value)
+	default:
+		if node.left == nil {
+			return node.right
+		} else if node.right == nil {
+			return node.left
+		}
+
+		// Replace with the inorder successor and delete it from the right subtree
+		successor := node.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		node.value = successor.value
+		node.right = t.deleteRecursive(node.right, successor.value)
+	}
+
+	return t.rebalance(node)
+}
+
+// Size returns the number of values in the tree.
+func (t *AVLTree[T]) Size() int {
+	return avlSize(t.root)
+}
+
+// IsEmpty checks if the tree is empty.
+func (t *AVLTree[T]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Clear removes all values from the tree.
+func (t *AVLTree[T]) Clear() {
+	t.root = nil
+}
+
+// Min returns the minimum value in the tree.
+func (t *AVLTree[T]) Min() (T, bool) {
+	if t.root == nil {
+		var zero T
+		return zero, false
+	}
+	current := t.root
+	for current.left != nil {
+		current = current.left
+	}
+	return current.value, true
+}
+
+// Max returns the maximum value in the tree.
+func (t *AVLTree[T]) Max() (T, bool) {
+	if t.root == nil {
+		var zero T
+		return zero, false
+	}
+	current := t.root
+	for current.right != nil {
+		current = current.right
+	}
+	return current.value, true
+}
+
+// Floor returns the largest value less than or equal to the given value.
+func (t *AVLTree[T]) Floor(value T) (T, bool) {
+	var floor *avlNode[T]
+	current := t.root
+	for current != nil {
+		switch {
+		case t.less(value, current.value):
+			current = current.left
+		case t.less(current.value, value):
+			floor = current
+			current = current.right
+		default:
+			return current.value, true
+		}
+	}
+	return avlNodeValue(floor)
+}
+
+// Ceiling returns the smallest value greater than or equal to the given value.
+func (t *AVLTree[T]) Ceiling(value T) (T, bool) {
+	var ceiling *avlNode[T]
+	current := t.root
+	for current != nil {
+		switch {
+		case t.less(current.value, value):
+			current = current.right
+		case t.less(value, current.value):
+			ceiling = current
+			current = current.left
+		default:
+			return current.value, true
+		}
+	}
+	return avlNodeValue(ceiling)
+}
+
+// Successor returns the smallest value strictly greater than the given value.
+func (t *AVLTree[T]) Successor(value T) (T, bool) {
+	var successor *avlNode[T]
+	current := t.root
+	for current != nil {
+		if t.less(value, current.value) {
+			successor = current
+			current = current.left
+		} else {
+			current = current.right
+		}
+	}
+	return avlNodeValue(successor)
+}
+
+// Predecessor returns the largest value strictly less than the given value.
+func (t *AVLTree[T]) Predecessor(value T) (T, bool) {
+	var predecessor *avlNode[T]
+	current := t.root
+	for current != nil {
+		if t.less(current.value, value) {
+			predecessor = current
+			current = current.right
+		} else {
+			current = current.left
+		}
+	}
+	return avlNodeValue(predecessor)
+}
+
+// avlNodeValue returns the value of node, or false if node is nil.
+func avlNodeValue[T comparable](node *avlNode[T]) (T, bool) {
+	if node == nil {
+		var zero T
+		return zero, false
+	}
+	return node.value, true
+}
+
+// Rank returns the number of values less than the given value.
+func (t *AVLTree[T]) Rank(value T) int {
+	rank := 0
+	current := t.root
+	for current != nil {
+		switch {
+		case t.less(value, current.value):
+			current = current.left
+		case t.less(current.value, value):
+			rank += 1 + avlSize(current.left)
+			current = current.right
+		default:
+			return rank + avlSize(current.left)
+		}
+	}
+	return rank
+}
+
+// Select returns the value with the given rank.
+func (t *AVLTree[T]) Select(rank int) (T, bool) {
+	if rank < 0 || rank >= t.Size() {
+		var zero T
+		return zero, false
+	}
+
+	current := t.root
+	for {
+		leftSize := avlSize(current.left)
+		switch {
+		case rank < leftSize:
+			current = current.left
+		case rank > leftSize:
+			rank -= leftSize + 1
+			current = current.right
+		default:
+			return current.value, true
+		}
+	}
+}
+
+// Height returns the height of the tree (-1 for an empty tree).
+func (t *AVLTree[T]) Height() int {
+	return avlHeight(t.root) - 1
+}
+
+// IsBalanced checks if the tree is balanced. It always holds for an AVL tree.
+func (t *AVLTree[T]) IsBalanced() bool {
+	return t.isBalancedRecursive(t.root) != -1
+}
+
+// isBalancedRecursive is the recursive helper for IsBalanced.
+func (t *AVLTree[T]) isBalancedRecursive(node *avlNode[T]) int {
+	if node == nil {
+		return 0
+	}
+
+	leftHeight := t.isBalancedRecursive(node.left)
+	if leftHeight == -1 {
+		return -1
+	}
+
+	rightHeight := t.isBalancedRecursive(node.right)
+	if rightHeight == -1 {
+		return -1
+	}
+
+	if abs(leftHeight-rightHeight) > 1 {
+		return -1
+	}
+
+	return 1 + max(leftHeight, rightHeight)
+}
+
+// Contains checks if a value exists in the tree.
+func (t *AVLTree[T]) Contains(value T) bool {
+	return t.Search(value)
+}
+
+// ToSlice returns the values in sorted order.
+func (t *AVLTree[T]) ToSlice() []T {
+	return t.InOrder()
+}
+
+// InOrder returns the tree values in in-order (sorted) traversal.
+func (t *AVLTree[T]) InOrder() []T {
+	return t.AppendTo(nil)
+}
+
+// AppendTo appends the values in sorted order to dst and returns the extended slice.
+func (t *AVLTree[T]) AppendTo(dst []T) []T {
+	t.ForEach(func(value T) {
+		dst = append(dst, value)
+	})
+	return dst
+}
+
+// PreOrder returns the tree values in pre-order traversal.
+func (t *AVLTree[T]) PreOrder() []T {
+	var result []T
+	t.preOrderRecursive(t.root, &result)
+	return result
+}
+
+// preOrderRecursive is the recursive helper for PreOrder.
+func (t *AVLTree[T]) preOrderRecursive(node *avlNode[T], result *[]T) {
+	if node != nil {
+		*result = append(*result, node.value)
+		t.preOrderRecursive(node.left, result)
+		t.preOrderRecursive(node.right, result)
+	}
+}
+
+// PostOrder returns the tree values in post-order traversal.
+func (t *AVLTree[T]) PostOrder() []T {
+	var result []T
+	t.postOrderRecursive(t.root, &result)
+	return result
+}
+
+// postOrderRecursive is the recursive helper for PostOrder.
+func (t *AVLTree[T]) postOrderRecursive(node *avlNode[T], result *[]T) {
+	if node != nil {
+		t.postOrderRecursive(node.left, result)
+		t.postOrderRecursive(node.right, result)
+		*result = append(*result, node.value)
+	}
+}
+
+// LevelOrder returns the tree values in level-order traversal (breadth-first).
+func (t *AVLTree[T]) LevelOrder() []T {
+	var result []T
+	if t.root == nil {
+		return result
+	}
+
+	queue := []*avlNode[T]{t.root}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		result = append(result, node.value)
+
+		if node.left != nil {
+			queue = append(queue, node.left)
+		}
+		if node.right != nil {
+			queue = append(queue, node.right)
+		}
+	}
+	return result
+}
+
+// String returns a string representation of the tree.
+func (t *AVLTree[T]) String() string {
+	return fmt.Sprintf("AVLTree%v", t.InOrder())
+}
+
+// ForEach applies a function to each value in sorted order.
+func (t *AVLTree[T]) ForEach(fn func(T)) {
+	t.forEachRecursive(t.root, fn)
+}
+
+// forEachRecursive is the recursive helper for ForEach.
+func (t *AVLTree[T]) forEachRecursive(node *avlNode[T], fn func(T)) {
+	if node != nil {
+		t.forEachRecursive(node.left, fn)
+		fn(node.value)
+		t.forEachRecursive(node.right, fn)
+	}
+}
+
+// Filter returns a new tree containing values that satisfy the predicate.
+func (t *AVLTree[T]) Filter(predicate func(T) bool) *AVLTree[T] {
+	result := NewAVLTree[T](t.less)
+	t.ForEach(func(value T) {
+		if predicate(value) {
+			result.Insert(value)
+		}
+	})
+	return result
+}
+
+// Clone creates a deep copy of the tree, preserving its shape.
+func (t *AVLTree[T]) Clone() *AVLTree[T] {
+	return t.CloneWith(identity[T])
+}
+
+// CloneWith creates a copy of the tree, preserving its shape, whose values are copied by
+// cloneValue, which must preserve their order.
+func (t *AVLTree[T]) CloneWith(cloneValue func(T) T) *AVLTree[T] {
+	return &AVLTree[T]{
+		root: cloneAVLNode(t.root, cloneValue),
+		less: t.less,
+	}
+}
+
+// cloneAVLNode copies a subtree, copying each value with cloneValue.
+func cloneAVLNode[T comparable](node *avlNode[T], cloneValue func(T) T) *avlNode[T] {
+	if node == nil {
+		return nil
+	}
+	clone := *node
+	clone.value = cloneValue(node.value)
+	clone.left = cloneAVLNode(node.left, cloneValue)
+	clone.right = cloneAVLNode(node.right, cloneValue)
+	return &clone
+}
+
+// Equals checks if two trees contain the same values.
+func (t *AVLTree[T]) Equals(other *AVLTree[T]) bool {
+	if t.Size() != other.Size() {
+		return false
+	}
+
+	values1 := t.InOrder()
+	values2 := other.InOrder()
+
+	for i := 0; i < len(values1); i++ {
+		if values1[i] != values2[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Range returns all values in the tree between min and max (inclusive).
+func (t *AVLTree[T]) Range(min, max T) []T {
+	var result []T
+	t.rangeRecursive(t.root, min, max, &result)
+	return result
+}
+
+// rangeRecursive is the recursive helper for Range.
+func (t *AVLTree[T]) rangeRecursive(node *avlNode[T], min, max T, result *[]T) {
+	if node == nil {
+		return
+	}
+
+	if t.less(min, node.value) {
+		t.rangeRecursive(node.left, min, max, result)
+	}
+
+	if !t.less(node.value, min) && !t.less(max, node.value) {
+		*result = append(*result, node.value)
+	}
+
+	if t.less(node.value, max) {
+		t.rangeRecursive(node.right, min, max, result)
+	}
+}
@@ -0,0 +1,113 @@
+package stl
+
+import "testing"
+
+func TestAVLTreeBasicOperations(t *testing.T) {
+	tree := NewAVLTreeFromSlice([]int{50, 30, 70, 20, 40, 60, 80, 30}, lessInt)
+
+	if tree.Size() != 7 {
+		t.Errorf("Expected size 7, got %d", tree.Size())
+	}
+	if !tree.Search(40) || tree.Search(45) {
+		t.Error("Search returned an unexpected result")
+	}
+	if !tree.Delete(30) || tree.Delete(30) {
+		t.Error("Expected Delete to succeed once")
+	}
+	if tree.String() != "AVLTree[20 40 50 60 70 80]" {
+		t.Errorf("Unexpected tree %s", tree.String())
+	}
+
+	if value, _ := tree.Min(); value != 20 {
+		t.Errorf("Expected min 20, got %d", value)
+	}
+	if value, _ := tree.Max(); value != 80 {
+		t.Errorf("Expected max 80, got %d", value)
+	}
+	if value, _ := tree.Floor(55); value != 50 {
+		t.Errorf("Expected floor 50, got %d", value)
+	}
+	if value, _ := tree.Ceiling(55); value != 60 {
+		t.Errorf("Expected ceiling 60, got %d", value)
+	}
+	if value, _ := tree.Successor(50); value != 60 {
+		t.Errorf("Expected successor 60, got %d", value)
+	}
+	if value, _ := tree.Predecessor(50); value != 40 {
+		t.Errorf("Expected predecessor 40, got %d", value)
+	}
+	if _, ok := tree.Successor(80); ok {
+		t.Error("Maximum should have no successor")
+	}
+	if rank := tree.Rank(60); rank != 3 {
+		t.Errorf("Expected rank 3, got %d", rank)
+	}
+	if value, _ := tree.Select(3); value != 60 {
+		t.Errorf("Expected value 60 at rank 3, got %d", value)
+	}
+	if values := tree.Range(35, 65); len(values) != 3 || values[0] != 40 || values[2] != 60 {
+		t.Errorf("Expected range [40 50 60], got %v", values)
+	}
+
+	tree.Clear()
+	if !tree.IsEmpty() || tree.Height() != -1 {
+		t.Error("Tree should be empty after Clear")
+	}
+}
+
+func TestAVLTreeStaysBalanced(t *testing.T) {
+	tree := NewAVLTree[int](lessInt)
+	const n = 1 << 12
+
+	for i := 0; i < n; i++ {
+		tree.Insert(i)
+	}
+	if !tree.IsBalanced() || tree.Height() > 18 {
+		t.Errorf("Expected balanced tree after sorted insertions, got height %d", tree.Height())
+	}
+
+	for i := n - 1; i >= 0; i -= 3 {
+		tree.Delete(i)
+	}
+	if !tree.IsBalanced() {
+		t.Error("Tree should stay balanced after deletions")
+	}
+	values := tree.InOrder()
+	for i := 1; i < len(values); i++ {
+		if values[i-1] >= values[i] {
+			t.Fatalf("In-order traversal is not sorted at %d", i)
+		}
+	}
+	if len(values) != tree.Size() {
+		t.Errorf("Expected %d values, got %d", tree.Size(), len(values))
+	}
+}
+
+func TestAVLTreeTraversalsAndCopies(t *testing.T) {
+	tree := NewAVLTreeFromSlice([]int{1, 2, 3}, lessInt)
+
+	// Sorted insertion of three values rotates 2 to the root
+	if order := tree.PreOrder(); order[0] != 2 || order[1] != 1 || order[2] != 3 {
+		t.Errorf("Expected pre-order [2 1 3], got %v", order)
+	}
+	if order := tree.PostOrder(); order[0] != 1 || order[1] != 3 || order[2] != 2 {
+		t.Errorf("Expected post-order [1 3 2], got %v", order)
+	}
+	if order := tree.LevelOrder(); order[0] != 2 || len(order) != 3 {
+		t.Errorf("Expected level-order starting at 2, got %v", order)
+	}
+
+	clone := tree.Clone()
+	if !clone.Equals(tree) {
+		t.Error("Clone should equal the original")
+	}
+	clone.Insert(4)
+	if tree.Search(4) || clone.Equals(tree) {
+		t.Error("Clone should be independent of the original")
+	}
+
+	odd := clone.Filter(func(v int) bool { return v%2 == 1 })
+	if odd.String() != "AVLTree[1 3]" {
+		t.Errorf("Expected filtered tree [1 3], got %s", odd)
+	}
+}
@@ -0,0 +1,249 @@
+package stl
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// BitSet represents a set of non-negative integers stored as a dynamic bit vector. It grows
+// on demand and is far denser than Set[int] when the integers are small and dense.
+type BitSet struct {
+	words []uint64
+}
+
+// NewBitSet creates a new empty bit set with room for bits 0..capacity-1 before growing.
+func NewBitSet(capacity int) *BitSet {
+	return &BitSet{words: make([]uint64, 0, (max(capacity, 0)+63)/64)}
+}
+
+// NewBitSetFromSlice creates a bit set with the given bits set. Negative values are ignored.
+func NewBitSetFromSlice(slice []int) *BitSet {
+	bs := NewBitSet(0)
+	for _, i := range slice {
+		bs.Set(i)
+	}
+	return bs
+}
+
+// grow makes room for at least n words.
+func (bs *BitSet) grow(n int) {
+	if n > len(bs.words) {
+		bs.words = append(bs.words, make([]uint64, n-len(bs.words))...)
+	}
+}
+
+// trim drops trailing zero words so equal sets have equal lengths.
+func (bs *BitSet) trim() {
+	n := len(bs.words)
+	for n > 0 && bs.words[n-1] == 0 {
+		n--
+	}
+	bs.words = bs.words[:n]
+}
+
+// Set sets bit i. Negative indices are ignored.
+func (bs *BitSet) Set(i int) {
+	if i < 0 {
+		return
+	}
+	bs.grow(i/64 + 1)
+	bs.words[i/64] |= 1 << (uint(i) % 64)
+}
+
+// Clear clears bit i.
+func (bs *BitSet) Clear(i int) {
+	if i < 0 || i/64 >= len(bs.words) {
+		return
+	}
+	bs.words[i/64] &^= 1 << (uint(i) % 64)
+	bs.trim()
+}
+
+// Flip toggles bit i. Negative indices are ignored.
+func (bs *BitSet) Flip(i int) {
+	if i < 0 {
+		return
+	}
+	bs.grow(i/64 + 1)
+	bs.words[i/64] ^= 1 << (uint(i) % 64)
+	bs.trim()
+}
+
+// Test checks if bit i is set.
+func (bs *BitSet) Test(i int) bool {
+	if i < 0 || i/64 >= len(bs.words) {
+		return false
+	}
+	return bs.words[i/64]&(1<<(uint(i)%64)) != 0
+}
+
+// Count returns the number of set bits.
+func (bs *BitSet) Count() int {
+	count := 0
+	for _, word := range bs.words {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+// Len returns the index of the highest set bit plus one, or 0 if no bit is set.
+func (bs *BitSet) Len() int {
+	if len(bs.words) == 0 {
+		return 0
+	}
+	last := len(bs.words) - 1
+	return last*64 + bits.Len64(bs.words[last])
+}
+
+// IsEmpty checks if no bit is set.
+func (bs *BitSet) IsEmpty() bool {
+	return len(bs.words) == 0
+}
+
+// ClearAll clears every bit.
+func (bs *BitSet) ClearAll() {
+	bs.words = bs.words[:0]
+}
+
+// NextSetBit returns the first set bit at or after from.
+func (bs *BitSet) NextSetBit(from int) (int, bool) {
+	from = max(from, 0)
+	w := from / 64
+	if w >= len(bs.words) {
+		return 0, false
+	}
+
+	word := bs.words[w] >> (uint(from) % 64)
+	if word != 0 {
+		return from + bits.TrailingZeros64(word), true
+	}
+	for w++; w < len(bs.words); w++ {
+		if bs.words[w] != 0 {
+			return w*64 + bits.TrailingZeros64(bs.words[w]), true
+		}
+	}
+	return 0, false
+}
+
+// NextClearBit returns the first clear bit at or after from.
+func (bs *BitSet) NextClearBit(from int) int {
+	from = max(from, 0)
+	w := from / 64
+	if w >= len(bs.words) {
+		return from
+	}
+
+	word := ^bs.words[w] >> (uint(from) % 64)
+	if word != 0 {
+		return from + bits.TrailingZeros64(word)
+	}
+	for w++; w < len(bs.words); w++ {
+		if bs.words[w] != ^uint64(0) {
+			return w*64 + bits.TrailingZeros64(^bs.words[w])
+		}
+	}
+	return len(bs.words) * 64
+}
+
+// combine returns a new bit set applying op to each pair of words, treating missing words as zero.
+func (bs *BitSet) combine(other *BitSet, op func(a, b uint64) uint64) *BitSet {
+	n := max(len(bs.words), len(other.words))
+	result := &BitSet{words: make([]uint64, n)}
+	for i := range result.words {
+		var a, b uint64
+		if i < len(bs.words) {
+			a = bs.words[i]
+		}
+		if i < len(other.words) {
+			b = other.words[i]
+		}
+		result.words[i] = op(a, b)
+	}
+	result.trim()
+	return result
+}
+
+// And returns a new bit set with the bits set in both sets.
+func (bs *BitSet) And(other *BitSet) *BitSet {
+	return bs.combine(other, func(a, b uint64) uint64 { return a & b })
+}
+
+// Or returns a new bit set with the bits set in either set.
+func (bs *BitSet) Or(other *BitSet) *BitSet {
+	return bs.combine(other, func(a, b uint64) uint64 { return a | b })
+}
+
+// Xor returns a new bit set with the bits set in exactly one set.
+func (bs *BitSet) Xor(other *BitSet) *BitSet {
+	return bs.combine(other, func(a, b uint64) uint64 { return a ^ b })
+}
+
+// AndNot returns a new bit set with the bits set in bs but not in other.
+func (bs *BitSet) AndNot(other *BitSet) *BitSet {
+	return bs.combine(other, func(a, b uint64) uint64 { return a &^ b })
+}
+
+// Intersects checks if the two sets share any set bit.
+func (bs *BitSet) Intersects(other *BitSet) bool {
+	for i := 0; i < min(len(bs.words), len(other.words)); i++ {
+		if bs.words[i]&other.words[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSubset checks if every bit set in bs is also set in other.
+func (bs *BitSet) IsSubset(other *BitSet) bool {
+	if len(bs.words) > len(other.words) {
+		return false
+	}
+	for i, word := range bs.words {
+		if word&^other.words[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals checks if two bit sets have the same bits set.
+func (bs *BitSet) Equals(other *BitSet) bool {
+	if len(bs.words) != len(other.words) {
+		return false
+	}
+	for i, word := range bs.words {
+		if word != other.words[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone creates a copy of the bit set.
+func (bs *BitSet) Clone() *BitSet {
+	return &BitSet{words: append([]uint64(nil), bs.words...)}
+}
+
+// ForEach applies a function to each set bit in ascending order.
+func (bs *BitSet) ForEach(fn func(int)) {
+	for w, word := range bs.words {
+		for word != 0 {
+			fn(w*64 + bits.TrailingZeros64(word))
+			word &= word - 1
+		}
+	}
+}
+
+// ToSlice returns the set bits in ascending order.
+func (bs *BitSet) ToSlice() []int {
+	result := make([]int, 0, bs.Count())
+	bs.ForEach(func(i int) {
+		result = append(result, i)
+	})
+	return result
+}
+
+// String returns a string representation of the bit set.
+func (bs *BitSet) String() string {
+	return fmt.Sprintf("BitSet%v", bs.ToSlice())
+}
@@ -0,0 +1,109 @@
+package stl
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestBitSetBasicOperations(t *testing.T) {
+	bs := NewBitSet(128)
+	bs.Set(1)
+	bs.Set(64)
+	bs.Set(200)
+	bs.Flip(3)
+	bs.Flip(1)
+	bs.Set(-5)
+
+	if bs.String() != "BitSet[3 64 200]" || bs.Count() != 3 {
+		t.Errorf("Unexpected bit set %s", bs.String())
+	}
+	if !bs.Test(64) || bs.Test(1) || bs.Test(-1) || bs.Test(10000) {
+		t.Error("Test returned an unexpected result")
+	}
+	if bs.Len() != 201 {
+		t.Errorf("Expected length 201, got %d", bs.Len())
+	}
+
+	if next, ok := bs.NextSetBit(4); !ok || next != 64 {
+		t.Errorf("Expected next set bit 64, got %d", next)
+	}
+	if next, ok := bs.NextSetBit(65); !ok || next != 200 {
+		t.Errorf("Expected next set bit 200, got %d", next)
+	}
+	if _, ok := bs.NextSetBit(201); ok {
+		t.Error("Expected no set bit past the end")
+	}
+	if next := bs.NextClearBit(3); next != 4 {
+		t.Errorf("Expected next clear bit 4, got %d", next)
+	}
+
+	bs.Clear(200)
+	if bs.Len() != 65 {
+		t.Errorf("Expected length 65 after clearing the top bit, got %d", bs.Len())
+	}
+
+	bs.ClearAll()
+	if !bs.IsEmpty() || bs.Count() != 0 {
+		t.Error("Bit set should be empty after ClearAll")
+	}
+}
+
+func TestBitSetWordOperations(t *testing.T) {
+	a := NewBitSetFromSlice([]int{1, 2, 3, 100})
+	b := NewBitSetFromSlice([]int{2, 3, 4})
+
+	if and := a.And(b); and.String() != "BitSet[2 3]" {
+		t.Errorf("Unexpected and %s", and.String())
+	}
+	if or := a.Or(b); or.String() != "BitSet[1 2 3 4 100]" {
+		t.Errorf("Unexpected or %s", or.String())
+	}
+	if xor := a.Xor(b); xor.String() != "BitSet[1 4 100]" {
+		t.Errorf("Unexpected xor %s", xor.String())
+	}
+	if andNot := a.AndNot(b); andNot.String() != "BitSet[1 100]" {
+		t.Errorf("Unexpected and-not %s", andNot.String())
+	}
+
+	// Trailing zero words must not affect equality
+	if !b.Equals(a.And(b).Or(NewBitSetFromSlice([]int{4}))) {
+		t.Error("Expected equal bit sets")
+	}
+	if !a.Intersects(b) || a.IsSubset(b) || !a.And(b).IsSubset(b) {
+		t.Error("Subset checks returned an unexpected result")
+	}
+
+	clone := a.Clone()
+	clone.Set(7)
+	if a.Test(7) {
+		t.Error("Clone should not share storage")
+	}
+}
+
+func TestBitSetMatchesSet(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	bs := NewBitSet(0)
+	expected := NewSet[int]()
+
+	for i := 0; i < 5000; i++ {
+		bit := r.Intn(1000)
+		if r.Intn(2) == 0 {
+			bs.Set(bit)
+			expected.Add(bit)
+		} else {
+			bs.Clear(bit)
+			expected.Remove(bit)
+		}
+	}
+
+	if bs.Count() != expected.Size() {
+		t.Fatalf("Expected %d bits, got %d", expected.Size(), bs.Count())
+	}
+	previous := -1
+	for bit, ok := bs.NextSetBit(0); ok; bit, ok = bs.NextSetBit(bit + 1) {
+		if bit <= previous || !expected.Contains(bit) {
+			t.Fatalf("Unexpected bit %d", bit)
+		}
+		previous = bit
+	}
+}
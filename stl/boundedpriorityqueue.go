@@ -0,0 +1,279 @@
+package stl
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// EvictionPolicy decides what BoundedPriorityQueue.Enqueue does when the queue is full.
+type EvictionPolicy int
+
+const (
+	// EvictWorst drops the lowest-priority element, which may be the new one.
+	EvictWorst EvictionPolicy = iota
+	// RejectNew keeps the queue unchanged and drops the new element.
+	RejectNew
+)
+
+// String returns the name of the policy.
+func (p EvictionPolicy) String() string {
+	switch p {
+	case EvictWorst:
+		return "EvictWorst"
+	case RejectNew:
+		return "RejectNew"
+	default:
+		return fmt.Sprintf("EvictionPolicy(%d)", int(p))
+	}
+}
+
+// BoundedPriorityQueue is a priority queue holding at most a fixed number of elements. It is
+// a min-max heap, so both the highest- and the lowest-priority element are reachable in
+// O(1) and removable in O(log n).
+type BoundedPriorityQueue[T any] struct {
+	less     func(T, T) bool
+	data     []T
+	capacity int
+	policy   EvictionPolicy
+}
+
+// NewBoundedPriorityQueue creates a bounded priority queue that evicts its lowest-priority
+// element when full. Non-positive capacities are treated as 1.
+func NewBoundedPriorityQueue[T any](capacity int, less func(T, T) bool) *BoundedPriorityQueue[T] {
+	return NewBoundedPriorityQueueWithPolicy(capacity, less, EvictWorst)
+}
+
+// NewBoundedPriorityQueueWithPolicy creates a bounded priority queue with the given
+// eviction policy.
+func NewBoundedPriorityQueueWithPolicy[T any](capacity int, less func(T, T) bool, policy EvictionPolicy) *BoundedPriorityQueue[T] {
+	capacity = max(capacity, 1)
+	return &BoundedPriorityQueue[T]{
+		less:     less,
+		data:     make([]T, 0, capacity),
+		capacity: capacity,
+		policy:   policy,
+	}
+}
+
+// Enqueue adds an element. If the queue is full, one element is dropped according to the
+// policy and returned with true: the worst element under EvictWorst (item itself if it is
+// no better than the current worst), or item under RejectNew.
+func (pq *BoundedPriorityQueue[T]) Enqueue(item T) (T, bool) {
+	if len(pq.data) < pq.capacity {
+		pq.data = append(pq.data, item)
+		pq.pushUp(len(pq.data) - 1)
+		var zero T
+		return zero, false
+	}
+
+	if pq.policy == RejectNew {
+		return item, true
+	}
+
+	worst := pq.worstIndex()
+	evicted := pq.data[worst]
+	if !pq.less(item, evicted) {
+		return item, true
+	}
+
+	pq.removeAt(worst)
+	pq.data = append(pq.data, item)
+	pq.pushUp(len(pq.data) - 1)
+	return evicted, true
+}
+
+// Dequeue removes and returns the highest-priority element.
+func (pq *BoundedPriorityQueue[T]) Dequeue() (T, bool) {
+	if len(pq.data) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	item := pq.data[0]
+	pq.removeAt(0)
+	return item, true
+}
+
+// DequeueWorst removes and returns the lowest-priority element.
+func (pq *BoundedPriorityQueue[T]) DequeueWorst() (T, bool) {
+	if len(pq.data) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	worst := pq.worstIndex()
+	item := pq.data[worst]
+	pq.removeAt(worst)
+	return item, true
+}
+
+// Peek returns the highest-priority element without removing it.
+func (pq *BoundedPriorityQueue[T]) Peek() (T, bool) {
+	if len(pq.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.data[0], true
+}
+
+// PeekWorst returns the lowest-priority element without removing it.
+func (pq *BoundedPriorityQueue[T]) PeekWorst() (T, bool) {
+	if len(pq.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.data[pq.worstIndex()], true
+}
+
+// Size returns the number of elements in the queue.
+func (pq *BoundedPriorityQueue[T]) Size() int {
+	return len(pq.data)
+}
+
+// Capacity returns the maximum number of elements the queue holds.
+func (pq *BoundedPriorityQueue[T]) Capacity() int {
+	return pq.capacity
+}
+
+// Policy returns the eviction policy.
+func (pq *BoundedPriorityQueue[T]) Policy() EvictionPolicy {
+	return pq.policy
+}
+
+// IsEmpty returns true if the queue is empty.
+func (pq *BoundedPriorityQueue[T]) IsEmpty() bool {
+	return len(pq.data) == 0
+}
+
+// IsFull returns true if the queue holds Capacity elements.
+func (pq *BoundedPriorityQueue[T]) IsFull() bool {
+	return len(pq.data) == pq.capacity
+}
+
+// Clear removes all elements from the queue.
+func (pq *BoundedPriorityQueue[T]) Clear() {
+	clear(pq.data)
+	pq.data = pq.data[:0]
+}
+
+// ToSlice returns a copy of the elements in heap order.
+func (pq *BoundedPriorityQueue[T]) ToSlice() []T {
+	return append([]T(nil), pq.data...)
+}
+
+// String returns a string representation of the queue in heap order.
+func (pq *BoundedPriorityQueue[T]) String() string {
+	return fmt.Sprintf("BoundedPriorityQueue%v", pq.data)
+}
+
+// isMinLevel reports whether index i lies on an even (min) level of the min-max heap.
+func isMinLevel(i int) bool {
+	return bits.Len(uint(i+1))%2 == 1
+}
+
+// worstIndex returns the position of the lowest-priority element, which is the larger
+// child of the root.
+func (pq *BoundedPriorityQueue[T]) worstIndex() int {
+	switch len(pq.data) {
+	case 1:
+		return 0
+	case 2:
+		return 1
+	}
+	if pq.less(pq.data[1], pq.data[2]) {
+		return 2
+	}
+	return 1
+}
+
+// removeAt replaces position i, which must be the root or one of its children, with the
+// last element and restores the heap.
+func (pq *BoundedPriorityQueue[T]) removeAt(i int) {
+	last := len(pq.data) - 1
+	pq.data[i] = pq.data[last]
+	var zero T
+	pq.data[last] = zero
+	pq.data = pq.data[:last]
+	if i < len(pq.data) {
+		pq.trickleDown(i)
+	}
+}
+
+// pushUp moves a newly appended element to its place on the min or max levels.
+func (pq *BoundedPriorityQueue[T]) pushUp(i int) {
+	if i == 0 {
+		return
+	}
+
+	parent := (i - 1) / 2
+	if isMinLevel(i) {
+		if pq.less(pq.data[parent], pq.data[i]) {
+			pq.data[i], pq.data[parent] = pq.data[parent], pq.data[i]
+			pq.pushUpLevel(parent, false)
+		} else {
+			pq.pushUpLevel(i, true)
+		}
+	} else {
+		if pq.less(pq.data[i], pq.data[parent]) {
+			pq.data[i], pq.data[parent] = pq.data[parent], pq.data[i]
+			pq.pushUpLevel(parent, true)
+		} else {
+			pq.pushUpLevel(i, false)
+		}
+	}
+}
+
+// pushUpLevel moves an element up through its grandparents on min or max levels.
+func (pq *BoundedPriorityQueue[T]) pushUpLevel(i int, minLevel bool) {
+	for i > 2 {
+		grandparent := ((i-1)/2 - 1) / 2
+		if !pq.ordered(pq.data[i], pq.data[grandparent], minLevel) {
+			return
+		}
+		pq.data[i], pq.data[grandparent] = pq.data[grandparent], pq.data[i]
+		i = grandparent
+	}
+}
+
+// ordered reports whether a belongs above b on a min level (a < b) or a max level (a > b).
+func (pq *BoundedPriorityQueue[T]) ordered(a, b T, minLevel bool) bool {
+	if minLevel {
+		return pq.less(a, b)
+	}
+	return pq.less(b, a)
+}
+
+// trickleDown moves the element at i down through its children and grandchildren.
+func (pq *BoundedPriorityQueue[T]) trickleDown(i int) {
+	minLevel := isMinLevel(i)
+	n := len(pq.data)
+
+	for {
+		// Find the most extreme of the children and grandchildren
+		first := 2*i + 1
+		if first >= n {
+			return
+		}
+		m := first
+		for _, j := range [...]int{first + 1, 2*first + 1, 2*first + 2, 2*first + 3, 2*first + 4} {
+			if j < n && pq.ordered(pq.data[j], pq.data[m], minLevel) {
+				m = j
+			}
+		}
+
+		if !pq.ordered(pq.data[m], pq.data[i], minLevel) {
+			return
+		}
+		pq.data[m], pq.data[i] = pq.data[i], pq.data[m]
+		if m <= first+1 {
+			return
+		}
+
+		// m is a grandchild; keep it consistent with its parent on the other level
+		parent := (m - 1) / 2
+		if pq.ordered(pq.data[parent], pq.data[m], minLevel) {
+			pq.data[m], pq.data[parent] = pq.data[parent], pq.data[m]
+		}
+		i = m
+	}
+}
@@ -0,0 +1,89 @@
+package stl
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestBoundedPriorityQueueEviction(t *testing.T) {
+	pq := NewBoundedPriorityQueue(3, lessInt)
+	for _, value := range []int{5, 1, 9} {
+		if _, dropped := pq.Enqueue(value); dropped {
+			t.Errorf("Unexpected drop while enqueuing %d", value)
+		}
+	}
+
+	if evicted, dropped := pq.Enqueue(3); !dropped || evicted != 9 {
+		t.Errorf("Expected 9 to be evicted, got %d", evicted)
+	}
+	if evicted, dropped := pq.Enqueue(7); !dropped || evicted != 7 {
+		t.Errorf("Expected the new worst element 7 to be dropped, got %d", evicted)
+	}
+	if worst, _ := pq.PeekWorst(); worst != 5 || !pq.IsFull() {
+		t.Errorf("Expected worst 5, got %d", worst)
+	}
+
+	expected := []int{1, 3, 5}
+	for _, want := range expected {
+		if value, _ := pq.Dequeue(); value != want {
+			t.Fatalf("Expected %d, got %d", want, value)
+		}
+	}
+	if _, ok := pq.Dequeue(); ok {
+		t.Error("Dequeue on an empty queue should fail")
+	}
+}
+
+func TestBoundedPriorityQueueReject(t *testing.T) {
+	pq := NewBoundedPriorityQueueWithPolicy(2, lessInt, RejectNew)
+	pq.Enqueue(4)
+	pq.Enqueue(8)
+
+	if rejected, dropped := pq.Enqueue(1); !dropped || rejected != 1 {
+		t.Errorf("Expected 1 to be rejected, got %d", rejected)
+	}
+	if best, _ := pq.Peek(); best != 4 || pq.Policy() != RejectNew || pq.Policy().String() != "RejectNew" {
+		t.Errorf("Expected best 4 under RejectNew, got %d", best)
+	}
+	if worst, _ := pq.DequeueWorst(); worst != 8 || pq.Size() != 1 {
+		t.Errorf("Expected to remove worst 8, got %d", worst)
+	}
+
+	pq.Clear()
+	if !pq.IsEmpty() || pq.Capacity() != 2 {
+		t.Error("Queue should be empty after Clear")
+	}
+}
+
+func TestBoundedPriorityQueueRandomized(t *testing.T) {
+	r := rand.New(rand.NewSource(13))
+
+	for _, capacity := range []int{1, 2, 7, 64} {
+		pq := NewBoundedPriorityQueue(capacity, lessInt)
+		var kept []int
+		for i := 0; i < 1000; i++ {
+			value := r.Intn(500)
+			pq.Enqueue(value)
+			kept = append(kept, value)
+			sort.Ints(kept)
+			if len(kept) > capacity {
+				kept = kept[:capacity]
+			}
+
+			if r.Intn(10) == 0 {
+				worst, _ := pq.DequeueWorst()
+				if worst != kept[len(kept)-1] {
+					t.Fatalf("Capacity %d: expected worst %d, got %d", capacity, kept[len(kept)-1], worst)
+				}
+				kept = kept[:len(kept)-1]
+			}
+		}
+
+		for _, want := range kept {
+			if value, _ := pq.Dequeue(); value != want {
+				t.Fatalf("Capacity %d: expected %d, got %d", capacity, want, value)
+			}
+		}
+	}
+}
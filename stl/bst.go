@@ -1,7 +1,9 @@
 package stl
 
 import (
+	"cmp"
 	"fmt"
+	"iter"
 	"math"
 )
 
@@ -28,6 +30,12 @@ func NewBST[T comparable](less func(T, T) bool) *BST[T] {
 	}
 }
 
+// NewOrderedBST creates a new empty binary search tree ordered by the natural order of T.
+// WithComparator replaces the natural order.
+func NewOrderedBST[T cmp.Ordered](opts ...Option) *BST[T] {
+	return NewBST[T](comparatorOf(applyOptions(opts), cmp.Less[T]))
+}
+
 // NewBSTFromSlice creates a BST from a slice.
 func NewBSTFromSlice[T comparable](slice []T, less func(T, T) bool) *BST[T] {
 	bst := NewBST[T](less)
@@ -333,9 +341,13 @@ func abs(x int) int {
 
 // InOrder returns the BST elements in in-order traversal.
 func (bst *BST[T]) InOrder() []T {
-	var result []T
-	bst.inOrderRecursive(bst.Root, &result)
-	return result
+	return bst.AppendTo(nil)
+}
+
+// AppendTo appends the elements in in-order traversal to dst and returns the extended slice.
+func (bst *BST[T]) AppendTo(dst []T) []T {
+	bst.inOrderRecursive(bst.Root, &dst)
+	return dst
 }
 
 // inOrderRecursive is the recursive helper for InOrder.
@@ -412,6 +424,34 @@ func (bst *BST[T]) ForEach(fn func(T)) {
 	bst.forEachRecursive(bst.Root, fn)
 }
 
+// All returns an iterator over the elements in in-order traversal.
+func (bst *BST[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		bst.allRecursive(bst.Root, yield)
+	}
+}
+
+// allRecursive is the recursive helper for All. It returns false once yield asks to stop.
+func (bst *BST[T]) allRecursive(node *BSTNode[T], yield func(T) bool) bool {
+	if node == nil {
+		return true
+	}
+	return bst.allRecursive(node.Left, yield) && yield(node.Value) && bst.allRecursive(node.Right, yield)
+}
+
+// Iterator returns a seekable iterator over the elements in sorted order. It navigates by
+// value, so the tree may be modified while iterating.
+func (bst *BST[T]) Iterator() SeekableIterator[T] {
+	return &orderedIterator[T]{
+		min:     bst.Min,
+		max:     bst.Max,
+		higher:  bst.Successor,
+		lower:   bst.Predecessor,
+		ceiling: bst.Ceiling,
+		remove:  func(value T) { bst.Delete(value) },
+	}
+}
+
 // forEachRecursive is the recursive helper for ForEach.
 func (bst *BST[T]) forEachRecursive(node *BSTNode[T], fn func(T)) {
 	if node != nil {
@@ -421,6 +461,27 @@ func (bst *BST[T]) forEachRecursive(node *BSTNode[T], fn func(T)) {
 	}
 }
 
+// Reduce folds the elements into a single value, starting from initial and visiting the
+// elements in sorted order.
+func (bst *BST[T]) Reduce(initial T, fn func(T, T) T) T {
+	result := initial
+	bst.ForEach(func(element T) {
+		result = fn(result, element)
+	})
+	return result
+}
+
+// CountIf returns the number of elements that satisfy the predicate.
+func (bst *BST[T]) CountIf(predicate func(T) bool) int {
+	count := 0
+	bst.ForEach(func(element T) {
+		if predicate(element) {
+			count++
+		}
+	})
+	return count
+}
+
 // Filter returns a new BST containing elements that satisfy the predicate.
 func (bst *BST[T]) Filter(predicate func(T) bool) *BST[T] {
 	result := NewBST[T](bst.Less)
@@ -441,17 +502,23 @@ func (bst *BST[T]) filterRecursive(node *BSTNode[T], predicate func(T) bool, res
 
 // Clone creates a deep copy of the BST.
 func (bst *BST[T]) Clone() *BST[T] {
+	return bst.CloneWith(identity[T])
+}
+
+// CloneWith creates a copy of the BST whose elements are copied by cloneElem, which must
+// preserve their order.
+func (bst *BST[T]) CloneWith(cloneElem func(T) T) *BST[T] {
 	result := NewBST[T](bst.Less)
-	bst.cloneRecursive(bst.Root, result)
+	bst.cloneRecursive(bst.Root, result, cloneElem)
 	return result
 }
 
 // cloneRecursive is the recursive helper for Clone.
-func (bst *BST[T]) cloneRecursive(node *BSTNode[T], result *BST[T]) {
+func (bst *BST[T]) cloneRecursive(node *BSTNode[T], result *BST[T], cloneElem func(T) T) {
 	if node != nil {
-		bst.cloneRecursive(node.Left, result)
-		result.Insert(node.Value)
-		bst.cloneRecursive(node.Right, result)
+		bst.cloneRecursive(node.Left, result, cloneElem)
+		result.Insert(cloneElem(node.Value))
+		bst.cloneRecursive(node.Right, result, cloneElem)
 	}
 }
 
@@ -203,3 +203,45 @@ func TestBSTWithCustomType(t *testing.T) {
 		t.Errorf("Expected oldest to be Charlie, got %s", oldest.Name)
 	}
 }
+
+func TestBSTAll(t *testing.T) {
+	bst := NewBSTFromSlice([]int{5, 3, 8, 1, 4}, func(a, b int) bool { return a < b })
+
+	var got []int
+	for x := range bst.All() {
+		if x > 4 {
+			break
+		}
+		got = append(got, x)
+	}
+	if len(got) != 3 || got[0] != 1 || got[1] != 3 || got[2] != 4 {
+		t.Errorf("Expected in-order prefix [1 3 4], got %v", got)
+	}
+}
+
+func TestBSTAggregations(t *testing.T) {
+	bst := NewBSTFromSlice([]int{3, 1, 2}, lessInt)
+	// In-order traversal folds 1, 2, 3
+	if digits := bst.Reduce(0, func(a, b int) int { return a*10 + b }); digits != 123 {
+		t.Errorf("Expected 123, got %d", digits)
+	}
+	if count := bst.CountIf(func(x int) bool { return x < 3 }); count != 2 {
+		t.Errorf("Expected 2, got %d", count)
+	}
+	if sum := Sum(bst.All()); sum != 6 {
+		t.Errorf("Expected Sum of 6, got %d", sum)
+	}
+}
+
+func TestOrderedBST(t *testing.T) {
+	bst := NewOrderedBST[int]()
+	for _, value := range []int{5, 2, 8} {
+		bst.Insert(value)
+	}
+	if smallest, ok := bst.Min(); !ok || smallest != 2 {
+		t.Errorf("Expected min 2, got %d", smallest)
+	}
+	if !bst.Search(8) || bst.Search(3) {
+		t.Error("Expected 8 to be found and 3 not")
+	}
+}
@@ -0,0 +1,404 @@
+package stl
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DefaultBTreeDegree is the minimum degree used by NewBTreeMap when the requested degree is
+// too small.
+const DefaultBTreeDegree = 32
+
+// btreeNode is a node of a BTreeMap holding sorted entries and, for internal nodes, one more
+// child than entries.
+type btreeNode[K comparable, V any] struct {
+	entries  []Entry[K, V]
+	children []*btreeNode[K, V]
+}
+
+// isLeaf reports whether the node has no children.
+func (n *btreeNode[K, V]) isLeaf() bool {
+	return len(n.children) == 0
+}
+
+// BTreeMap represents an ordered map stored in a B-tree. Each node keeps between degree-1 and
+// 2*degree-1 entries in a contiguous slice, which makes the tree shallow and cache-friendly for
+// large data sets.
+type BTreeMap[K comparable, V any] struct {
+	root   *btreeNode[K, V]
+	less   func(K, K) bool
+	degree int
+	size   int
+}
+
+// NewBTreeMap creates a new empty BTreeMap with the given minimum degree and comparator.
+// Degrees below 2 are replaced by DefaultBTreeDegree.
+func NewBTreeMap[K comparable, V any](degree int, less func(K, K) bool) *BTreeMap[K, V] {
+	if degree < 2 {
+		degree = DefaultBTreeDegree
+	}
+	return &BTreeMap[K, V]{
+		root:   &btreeNode[K, V]{},
+		less:   less,
+		degree: degree,
+	}
+}
+
+// find returns the index of the first entry in n whose key is not less than key, and whether
+// that entry has exactly this key.
+func (bt *BTreeMap[K, V]) find(n *btreeNode[K, V], key K) (int, bool) {
+	i := sort.Search(len(n.entries), func(i int) bool {
+		return !bt.less(n.entries[i].Key, key)
+	})
+	return i, i < len(n.entries) && !bt.less(key, n.entries[i].Key)
+}
+
+// Get returns the value associated with the given key.
+func (bt *BTreeMap[K, V]) Get(key K) (V, bool) {
+	n := bt.root
+	for {
+		i, found := bt.find(n, key)
+		if found {
+			return n.entries[i].Value, true
+		}
+		if n.isLeaf() {
+			var zero V
+			return zero, false
+		}
+		n = n.children[i]
+	}
+}
+
+// ContainsKey checks if a key exists in the map.
+func (bt *BTreeMap[K, V]) ContainsKey(key K) bool {
+	_, found := bt.Get(key)
+	return found
+}
+
+// Put adds or updates a key-value pair.
+func (bt *BTreeMap[K, V]) Put(key K, value V) {
+	if len(bt.root.entries) == 2*bt.degree-1 {
+		oldRoot := bt.root
+		bt.root = &btreeNode[K, V]{children: []*btreeNode[K, V]{oldRoot}}
+		bt.splitChild(bt.root, 0)
+	}
+
+	n := bt.root
+	for {
+		i, found := bt.find(n, key)
+		if found {
+			n.entries[i].Value = value
+			return
+		}
+
+		if n.isLeaf() {
+			n.entries = append(n.entries, Entry[K, V]{})
+			copy(n.entries[i+1:], n.entries[i:])
+			n.entries[i] = Entry[K, V]{Key: key, Value: value}
+			bt.size++
+			return
+		}
+
+		// Split a full child before descending so there is always room for a promoted entry
+		if len(n.children[i].entries) == 2*bt.degree-1 {
+			bt.splitChild(n, i)
+			switch {
+			case bt.less(n.entries[i].Key, key):
+				i++
+			case !bt.less(key, n.entries[i].Key):
+				n.entries[i].Value = value
+				return
+			}
+		}
+		n = n.children[i]
+	}
+}
+
+// splitChild splits the full child at index i of parent around its median entry, which moves
+// up into parent.
+func (bt *BTreeMap[K, V]) splitChild(parent *btreeNode[K, V], i int) {
+	t := bt.degree
+	child := parent.children[i]
+	median := child.entries[t-1]
+
+	right := &btreeNode[K, V]{
+		entries: append([]Entry[K, V](nil), child.entries[t:]...),
+	}
+	if !child.isLeaf() {
+		right.children = append([]*btreeNode[K, V](nil), child.children[t:]...)
+		clear(child.children[t:])
+		child.children = child.children[:t]
+	}
+	clear(child.entries[t-1:])
+	child.entries = child.entries[:t-1]
+
+	parent.entries = append(parent.entries, Entry[K, V]{})
+	copy(parent.entries[i+1:], parent.entries[i:])
+	parent.entries[i] = median
+
+	parent.children = append(parent.children, nil)
+	copy(parent.children[i+2:], parent.children[i+1:])
+	parent.children[i+1] = right
+}
+
+// Remove removes a key-value pair and reports whether the key was present.
+func (bt *BTreeMap[K, V]) Remove(key K) bool {
+	if !bt.ContainsKey(key) {
+		return false
+	}
+
+	bt.remove(bt.root, key)
+	bt.size--
+
+	if len(bt.root.entries) == 0 && !bt.root.isLeaf() {
+		bt.root = bt.root.children[0]
+	}
+	return true
+}
+
+// remove deletes key from the subtree rooted at n, which is known to contain it. Every node it
+// descends into is first given at least degree entries so a deletion never underflows.
+func (bt *BTreeMap[K, V]) remove(n *btreeNode[K, V], key K) {
+	t := bt.degree
+
+	for {
+		i, found := bt.find(n, key)
+
+		if found && n.isLeaf() {
+			n.entries = append(n.entries[:i], n.entries[i+1:]...)
+			return
+		}
+
+		if found {
+			switch {
+			case len(n.children[i].entries) >= t:
+				// Replace with the predecessor and delete it from the left subtree
+				pred := bt.maxEntry(n.children[i])
+				n.entries[i] = pred
+				n, key = n.children[i], pred.Key
+			case len(n.children[i+1].entries) >= t:
+				// Replace with the successor and delete it from the right subtree
+				succ := bt.minEntry(n.children[i+1])
+				n.entries[i] = succ
+				n, key = n.children[i+1], succ.Key
+			default:
+				bt.merge(n, i)
+				n = n.children[i]
+			}
+			continue
+		}
+
+		if len(n.children[i].entries) < t {
+			i = bt.fill(n, i)
+		}
+		n = n.children[i]
+	}
+}
+
+// fill gives the child at index i of n at least degree entries by borrowing from a sibling or
+// merging with one, and returns the index of the child that now covers the original range.
+func (bt *BTreeMap[K, V]) fill(n *btreeNode[K, V], i int) int {
+	t := bt.degree
+	child := n.children[i]
+
+	switch {
+	case i > 0 && len(n.children[i-1].entries) >= t:
+		// Rotate the separator down from the parent and the left sibling's last entry up
+		left := n.children[i-1]
+		child.entries = append([]Entry[K, V]{n.entries[i-1]}, child.entries...)
+		n.entries[i-1] = left.entries[len(left.entries)-1]
+		left.entries = left.entries[:len(left.entries)-1]
+		if !left.isLeaf() {
+			child.children = append([]*btreeNode[K, V]{left.children[len(left.children)-1]}, child.children...)
+			left.children = left.children[:len(left.children)-1]
+		}
+		return i
+	case i < len(n.children)-1 && len(n.children[i+1].entries) >= t:
+		// Rotate the separator down from the parent and the right sibling's first entry up
+		right := n.children[i+1]
+		child.entries = append(child.entries, n.entries[i])
+		n.entries[i] = right.entries[0]
+		right.entries = append(right.entries[:0], right.entries[1:]...)
+		if !right.isLeaf() {
+			child.children = append(child.children, right.children[0])
+			right.children = append(right.children[:0], right.children[1:]...)
+		}
+		return i
+	case i < len(n.children)-1:
+		bt.merge(n, i)
+		return i
+	default:
+		bt.merge(n, i-1)
+		return i - 1
+	}
+}
+
+// merge joins the children at indexes i and i+1 of n around the separator entry i.
+func (bt *BTreeMap[K, V]) merge(n *btreeNode[K, V], i int) {
+	left, right := n.children[i], n.children[i+1]
+
+	left.entries = append(left.entries, n.entries[i])
+	left.entries = append(left.entries, right.entries...)
+	left.children = append(left.children, right.children...)
+
+	n.entries = append(n.entries[:i], n.entries[i+1:]...)
+	n.children = append(n.children[:i+1], n.children[i+2:]...)
+}
+
+// minEntry returns the entry with the smallest key in a non-empty subtree.
+func (bt *BTreeMap[K, V]) minEntry(n *btreeNode[K, V]) Entry[K, V] {
+	for !n.isLeaf() {
+		n = n.children[0]
+	}
+	return n.entries[0]
+}
+
+// maxEntry returns the entry with the largest key in a non-empty subtree.
+func (bt *BTreeMap[K, V]) maxEntry(n *btreeNode[K, V]) Entry[K, V] {
+	for !n.isLeaf() {
+		n = n.children[len(n.children)-1]
+	}
+	return n.entries[len(n.entries)-1]
+}
+
+// Min returns the key-value pair with the minimum key.
+func (bt *BTreeMap[K, V]) Min() (K, V, bool) {
+	if bt.size == 0 {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	entry := bt.minEntry(bt.root)
+	return entry.Key, entry.Value, true
+}
+
+// Max returns the key-value pair with the maximum key.
+func (bt *BTreeMap[K, V]) Max() (K, V, bool) {
+	if bt.size == 0 {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	entry := bt.maxEntry(bt.root)
+	return entry.Key, entry.Value, true
+}
+
+// Size returns the number of key-value pairs.
+func (bt *BTreeMap[K, V]) Size() int {
+	return bt.size
+}
+
+// IsEmpty checks if the map is empty.
+func (bt *BTreeMap[K, V]) IsEmpty() bool {
+	return bt.size == 0
+}
+
+// Clear removes all key-value pairs.
+func (bt *BTreeMap[K, V]) Clear() {
+	bt.root = &btreeNode[K, V]{}
+	bt.size = 0
+}
+
+// Degree returns the minimum degree of the tree.
+func (bt *BTreeMap[K, V]) Degree() int {
+	return bt.degree
+}
+
+// Height returns the number of levels below the root (0 for a tree that is a single leaf).
+func (bt *BTreeMap[K, V]) Height() int {
+	height := 0
+	for n := bt.root; !n.isLeaf(); n = n.children[0] {
+		height++
+	}
+	return height
+}
+
+// ForEach applies a function to each key-value pair in key order.
+func (bt *BTreeMap[K, V]) ForEach(fn func(K, V)) {
+	bt.ascend(bt.root, nil, nil, func(key K, value V) bool {
+		fn(key, value)
+		return true
+	})
+}
+
+// RangeFunc calls fn for each key-value pair between min and max (inclusive) in key order,
+// stopping early if fn returns false.
+func (bt *BTreeMap[K, V]) RangeFunc(min, max K, fn func(K, V) bool) {
+	bt.ascend(bt.root, &min, &max, fn)
+}
+
+// Range returns all key-value pairs between min and max (inclusive) in key order.
+func (bt *BTreeMap[K, V]) Range(min, max K) []Entry[K, V] {
+	var result []Entry[K, V]
+	bt.RangeFunc(min, max, func(key K, value V) bool {
+		result = append(result, Entry[K, V]{Key: key, Value: value})
+		return true
+	})
+	return result
+}
+
+// ascend visits the entries of a subtree between the optional inclusive bounds in key order.
+// It returns false once fn has stopped the traversal.
+func (bt *BTreeMap[K, V]) ascend(n *btreeNode[K, V], min, max *K, fn func(K, V) bool) bool {
+	start := 0
+	if min != nil {
+		start, _ = bt.find(n, *min)
+	}
+
+	for i := start; i <= len(n.entries); i++ {
+		if !n.isLeaf() && !bt.ascend(n.children[i], min, max, fn) {
+			return false
+		}
+		if i == len(n.entries) {
+			break
+		}
+		entry := n.entries[i]
+		if max != nil && bt.less(*max, entry.Key) {
+			return false
+		}
+		if !fn(entry.Key, entry.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Keys returns all keys in sorted order.
+func (bt *BTreeMap[K, V]) Keys() []K {
+	return bt.AppendKeys(make([]K, 0, bt.size))
+}
+
+// AppendKeys appends the keys in sorted order to dst and returns the extended slice.
+func (bt *BTreeMap[K, V]) AppendKeys(dst []K) []K {
+	bt.ForEach(func(key K, _ V) {
+		dst = append(dst, key)
+	})
+	return dst
+}
+
+// Values returns all values in key order.
+func (bt *BTreeMap[K, V]) Values() []V {
+	return bt.AppendValues(make([]V, 0, bt.size))
+}
+
+// AppendValues appends the values in key order to dst and returns the extended slice.
+func (bt *BTreeMap[K, V]) AppendValues(dst []V) []V {
+	bt.ForEach(func(_ K, value V) {
+		dst = append(dst, value)
+	})
+	return dst
+}
+
+// Entries returns all key-value pairs in key order.
+func (bt *BTreeMap[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, bt.size)
+	bt.ForEach(func(key K, value V) {
+		entries = append(entries, Entry[K, V]{Key: key, Value: value})
+	})
+	return entries
+}
+
+// String returns a string representation of the map.
+func (bt *BTreeMap[K, V]) String() string {
+	return fmt.Sprintf("BTreeMap%v", bt.Entries())
+}
@@ -0,0 +1,152 @@
+package stl
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// checkBTree verifies the B-tree invariants: sorted keys, entry counts within bounds, and all
+// leaves at the same depth.
+func checkBTree[K comparable, V any](t *testing.T, bt *BTreeMap[K, V]) {
+	t.Helper()
+
+	leafDepth := -1
+	var walk func(n *btreeNode[K, V], depth int, isRoot bool)
+	walk = func(n *btreeNode[K, V], depth int, isRoot bool) {
+		if !isRoot && (len(n.entries) < bt.degree-1 || len(n.entries) > 2*bt.degree-1) {
+			t.Fatalf("Node has %d entries, outside [%d, %d]", len(n.entries), bt.degree-1, 2*bt.degree-1)
+		}
+		for i := 1; i < len(n.entries); i++ {
+			if !bt.less(n.entries[i-1].Key, n.entries[i].Key) {
+				t.Fatalf("Node entries are not sorted")
+			}
+		}
+		if n.isLeaf() {
+			if leafDepth == -1 {
+				leafDepth = depth
+			} else if leafDepth != depth {
+				t.Fatalf("Leaves at depths %d and %d", leafDepth, depth)
+			}
+			return
+		}
+		if len(n.children) != len(n.entries)+1 {
+			t.Fatalf("Node has %d entries but %d children", len(n.entries), len(n.children))
+		}
+		for _, child := range n.children {
+			walk(child, depth+1, false)
+		}
+	}
+	walk(bt.root, 0, true)
+}
+
+func TestBTreeMapBasicOperations(t *testing.T) {
+	bt := NewBTreeMap[string, int](2, func(a, b string) bool { return a < b })
+
+	for i, key := range []string{"m", "c", "x", "a", "e", "p", "z", "b", "d"} {
+		bt.Put(key, i)
+	}
+	bt.Put("a", 100)
+
+	if bt.Size() != 9 {
+		t.Errorf("Expected size 9, got %d", bt.Size())
+	}
+	if value, ok := bt.Get("a"); !ok || value != 100 {
+		t.Errorf("Expected updated value 100, got %d", value)
+	}
+	if _, ok := bt.Get("q"); ok {
+		t.Error("Missing key should not be found")
+	}
+	if keys := bt.Keys(); keys[0] != "a" || keys[8] != "z" {
+		t.Errorf("Expected sorted keys, got %v", keys)
+	}
+	if key, _, _ := bt.Min(); key != "a" {
+		t.Errorf("Expected min a, got %s", key)
+	}
+	if key, _, _ := bt.Max(); key != "z" {
+		t.Errorf("Expected max z, got %s", key)
+	}
+	if entries := bt.Range("c", "n"); len(entries) != 4 || entries[0].Key != "c" || entries[3].Key != "m" {
+		t.Errorf("Expected range [c d e m], got %v", entries)
+	}
+
+	if !bt.Remove("m") || bt.Remove("m") {
+		t.Error("Expected Remove to succeed once")
+	}
+	checkBTree(t, bt)
+
+	bt.Clear()
+	if !bt.IsEmpty() || bt.Height() != 0 {
+		t.Error("Map should be empty after Clear")
+	}
+	if _, _, ok := bt.Min(); ok {
+		t.Error("Min of empty map should fail")
+	}
+}
+
+func TestBTreeMapRandomOperations(t *testing.T) {
+	for _, degree := range []int{2, 3, 8} {
+		bt := NewBTreeMap[int, int](degree, lessInt)
+		reference := make(map[int]int)
+		r := rand.New(rand.NewSource(int64(degree)))
+
+		for step := 0; step < 5000; step++ {
+			key := r.Intn(500)
+			if r.Intn(3) == 0 {
+				_, exists := reference[key]
+				if bt.Remove(key) != exists {
+					t.Fatalf("Degree %d: Remove(%d) disagreed with reference", degree, key)
+				}
+				delete(reference, key)
+			} else {
+				bt.Put(key, step)
+				reference[key] = step
+			}
+		}
+
+		checkBTree(t, bt)
+		if bt.Size() != len(reference) {
+			t.Fatalf("Degree %d: expected size %d, got %d", degree, len(reference), bt.Size())
+		}
+		previous := -1
+		bt.ForEach(func(key, value int) {
+			if key <= previous || reference[key] != value {
+				t.Fatalf("Degree %d: unexpected entry %d=%d", degree, key, value)
+			}
+			previous = key
+		})
+	}
+}
+
+func TestBTreeMapRangeFunc(t *testing.T) {
+	bt := NewBTreeMap[int, int](0, lessInt)
+	if bt.Degree() != DefaultBTreeDegree {
+		t.Errorf("Expected default degree %d, got %d", DefaultBTreeDegree, bt.Degree())
+	}
+	for i := 0; i < 10000; i++ {
+		bt.Put(i, i*2)
+	}
+	if height := bt.Height(); height > 3 {
+		t.Errorf("Expected a shallow tree, got height %d", height)
+	}
+
+	var keys []int
+	bt.RangeFunc(4000, 9000, func(key, value int) bool {
+		keys = append(keys, key)
+		return len(keys) < 5
+	})
+	if len(keys) != 5 || keys[0] != 4000 || keys[4] != 4004 {
+		t.Errorf("Expected RangeFunc to stop after 5 keys from 4000, got %v", keys)
+	}
+	if entries := bt.Range(9995, 20000); len(entries) != 5 || entries[4].Value != 19998 {
+		t.Errorf("Expected last 5 entries, got %v", entries)
+	}
+}
+
+func BenchmarkBTreeMapPut(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		bt := NewBTreeMap[int, int](DefaultBTreeDegree, lessInt)
+		for key := 0; key < 10000; key++ {
+			bt.Put(key, key)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package stl
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCloneWith(t *testing.T) {
+	stack := NewStack[[]int]()
+	stack.Push([]int{1, 2})
+	deep := stack.CloneWith(slices.Clone[[]int])
+	shallow := stack.Clone()
+	top, _ := stack.Peek()
+	top[0] = 9
+	if got, _ := deep.Peek(); got[0] != 1 {
+		t.Errorf("Expected the deep copy to keep [1 2], got %v", got)
+	}
+	if got, _ := shallow.Peek(); got[0] != 9 {
+		t.Errorf("Expected the shallow copy to share the slice, got %v", got)
+	}
+
+	type point struct{ x int }
+	clonePoint := func(p *point) *point {
+		c := *p
+		return &c
+	}
+	p := &point{1}
+	list := NewLinkedListFromSlice([]*point{p})
+	deque := NewDequeFromSlice([]*point{p})
+	set := NewSetFromSlice([]*point{p})
+	copies := []*point{
+		list.CloneWith(clonePoint).Front().Value,
+		deque.CloneWith(clonePoint).ToSlice()[0],
+		set.CloneWith(clonePoint).ToSlice()[0],
+	}
+	p.x = 5
+	for i, c := range copies {
+		if c == p || c.x != 1 {
+			t.Errorf("Copy %d: Expected a separate point with x=1, got %v", i, *c)
+		}
+	}
+
+	avl := NewAVLTreeFromSlice([]int{3, 1, 2}, lessInt)
+	doubled := avl.CloneWith(func(x int) int { return x * 2 })
+	if !slices.Equal(doubled.InOrder(), []int{2, 4, 6}) || !slices.Equal(avl.InOrder(), []int{1, 2, 3}) {
+		t.Errorf("Expected [2 4 6] and [1 2 3], got %v and %v", doubled.InOrder(), avl.InOrder())
+	}
+}
+
+func TestMapCloneWith(t *testing.T) {
+	tm := NewTreeMap[int, []string](lessInt)
+	tm.Put(1, []string{"a"})
+	lhm := NewLinkedHashMap[int, []string](false)
+	lhm.Put(1, []string{"a"})
+	mm := NewMultiMap[int, []string]()
+	mm.Put(1, []string{"a"})
+
+	// A nil key function copies keys as is
+	tmCopy := tm.CloneWith(nil, slices.Clone[[]string])
+	lhmCopy := lhm.CloneWith(nil, slices.Clone[[]string])
+	mmCopy := mm.CloneWith(nil, slices.Clone[[]string])
+
+	value, _ := tm.Get(1)
+	value[0] = "z"
+	value, _ = lhm.Get(1)
+	value[0] = "z"
+	mm.Get(1)[0][0] = "z"
+
+	a, _ := tmCopy.Get(1)
+	b, _ := lhmCopy.Get(1)
+	c := mmCopy.Get(1)[0]
+	if a[0] != "a" || b[0] != "a" || c[0] != "a" {
+		t.Errorf("Expected the copies to keep [a], got %v, %v, and %v", a, b, c)
+	}
+}
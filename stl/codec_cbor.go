@@ -0,0 +1,603 @@
+package stl
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+)
+
+// Containers implement the Marshaler and Unmarshaler hooks of the common CBOR libraries
+// (MarshalCBOR / UnmarshalCBOR, as in github.com/fxamacker/cbor), so no CBOR dependency is
+// needed. The value is the container's payload written natively, as described in
+// codec_native.go: a Set is an array of its elements, a TreeMap a map in key order, and a
+// RingBuffer a map of its capacity, policy, and elements. Decoding accepts indefinite
+// lengths and skips tags.
+
+// CBOR major types, in the top three bits of the initial byte.
+const (
+	cborUint        = 0 << 5
+	cborNegative    = 1 << 5
+	cborByteString  = 2 << 5
+	cborTextString  = 3 << 5
+	cborArray       = 4 << 5
+	cborMap         = 5 << 5
+	cborTag         = 6 << 5
+	cborSimple      = 7 << 5
+	cborIndefinite  = 31
+	cborBreak       = cborSimple | cborIndefinite
+	cborFalse       = cborSimple | 20
+	cborTrue        = cborSimple | 21
+	cborNull        = cborSimple | 22
+	cborUndefined   = cborSimple | 23
+	cborFloat16     = cborSimple | 25
+	cborFloat32     = cborSimple | 26
+	cborFloat64     = cborSimple | 27
+	cborMaxArgument = 27
+)
+
+// cborMarshaler and cborUnmarshaler are the CBOR hooks, used for nested values.
+type (
+	cborMarshaler   interface{ MarshalCBOR() ([]byte, error) }
+	cborUnmarshaler interface{ UnmarshalCBOR([]byte) error }
+)
+
+// cborFormat is the CBOR nativeFormat.
+type cborFormat struct{}
+
+// marshalCBOR encodes the payload c writes with WriteTo as CBOR.
+func marshalCBOR(c io.WriterTo) ([]byte, error) {
+	return marshalNative(cborFormat{}, c)
+}
+
+// unmarshalCBOR decodes a CBOR value and reads it into c with ReadFrom.
+func unmarshalCBOR(c io.ReaderFrom, data []byte) error {
+	return unmarshalNative(cborFormat{}, c, data)
+}
+
+// appendCBORHead appends the initial byte of major type major with argument n.
+func appendCBORHead(b []byte, major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return append(b, major|byte(n))
+	case n <= math.MaxUint8:
+		return append(b, major|24, byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, major|25), uint16(n))
+	case n <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, major|26), uint32(n))
+	}
+	return binary.BigEndian.AppendUint64(append(b, major|27), n)
+}
+
+func (cborFormat) appendNil(b []byte) []byte { return append(b, cborNull) }
+
+func (cborFormat) appendBool(b []byte, v bool) []byte {
+	if v {
+		return append(b, cborTrue)
+	}
+	return append(b, cborFalse)
+}
+
+func (cborFormat) appendInt(b []byte, v int64) []byte {
+	if v >= 0 {
+		return appendCBORHead(b, cborUint, uint64(v))
+	}
+	return appendCBORHead(b, cborNegative, uint64(-1-v))
+}
+
+func (cborFormat) appendUint(b []byte, v uint64) []byte {
+	return appendCBORHead(b, cborUint, v)
+}
+
+func (cborFormat) appendFloat32(b []byte, v float32) []byte {
+	return binary.BigEndian.AppendUint32(append(b, cborFloat32), math.Float32bits(v))
+}
+
+func (cborFormat) appendFloat64(b []byte, v float64) []byte {
+	return binary.BigEndian.AppendUint64(append(b, cborFloat64), math.Float64bits(v))
+}
+
+func (cborFormat) appendString(b []byte, s string) []byte {
+	return append(appendCBORHead(b, cborTextString, uint64(len(s))), s...)
+}
+
+func (cborFormat) appendBytes(b []byte, p []byte) []byte {
+	return append(appendCBORHead(b, cborByteString, uint64(len(p))), p...)
+}
+
+func (cborFormat) appendArrayHeader(b []byte, n int) []byte {
+	return appendCBORHead(b, cborArray, uint64(n))
+}
+
+func (cborFormat) appendMapHeader(b []byte, n int) []byte {
+	return appendCBORHead(b, cborMap, uint64(n))
+}
+
+func (f cborFormat) parse(data []byte, depth int) (nativeValue, []byte, error) {
+	if depth > nativeMaxDepth {
+		return nativeValue{}, nil, fmt.Errorf("%w: CBOR value nested too deeply", ErrInvalidEncoding)
+	}
+	if len(data) == 0 {
+		return nativeValue{}, nil, errNativeTruncated
+	}
+
+	initial, rest := data[0], data[1:]
+	major, info := initial&0xe0, initial&0x1f
+
+	// The argument is the value, length, or count, in the low bits or the bytes that follow
+	var arg uint64
+	switch {
+	case info < 24:
+		arg = uint64(info)
+	case info <= cborMaxArgument:
+		width := 1 << (info - 24)
+		if len(rest) < width {
+			return nativeValue{}, nil, errNativeTruncated
+		}
+		for _, b := range rest[:width] {
+			arg = arg<<8 | uint64(b)
+		}
+		rest = rest[width:]
+	case info == cborIndefinite && major >= cborByteString && major <= cborMap:
+	default:
+		return nativeValue{}, nil, fmt.Errorf("%w: unsupported CBOR initial byte %#x", ErrInvalidEncoding, initial)
+	}
+	indefinite := info == cborIndefinite
+
+	var v nativeValue
+	var err error
+	switch major {
+	case cborUint:
+		v = nativeValue{kind: nativeUint, u: arg}
+	case cborNegative:
+		if arg > math.MaxInt64 {
+			return nativeValue{}, nil, fmt.Errorf("%w: CBOR integer overflows int64", ErrInvalidEncoding)
+		}
+		v = nativeValue{kind: nativeInt, i: -1 - int64(arg)}
+	case cborByteString, cborTextString:
+		v.kind = nativeBytes
+		if major == cborTextString {
+			v.kind = nativeString
+		}
+		if !indefinite {
+			if uint64(len(rest)) < arg {
+				return nativeValue{}, nil, errNativeTruncated
+			}
+			v.s, rest = rest[:arg], rest[arg:]
+			break
+		}
+		// An indefinite string is a series of definite chunks of the same type
+		v.s = []byte{}
+		for {
+			if len(rest) == 0 {
+				return nativeValue{}, nil, errNativeTruncated
+			}
+			if rest[0] == cborBreak {
+				rest = rest[1:]
+				break
+			}
+			if rest[0]&0xe0 != major || rest[0]&0x1f == cborIndefinite {
+				return nativeValue{}, nil, fmt.Errorf("%w: bad CBOR string chunk", ErrInvalidEncoding)
+			}
+			var chunk nativeValue
+			if chunk, rest, err = f.parse(rest, depth+1); err != nil {
+				return nativeValue{}, nil, err
+			}
+			v.s = append(v.s, chunk.s...)
+		}
+	case cborArray, cborMap:
+		v.kind = nativeArray
+		per := uint64(1)
+		if major == cborMap {
+			v.kind, per = nativeMap, 2
+		}
+		if !indefinite {
+			if arg > math.MaxUint64/2 {
+				return nativeValue{}, nil, errNativeTruncated
+			}
+			rest, err = parseItems(f, &v, rest, per*arg, depth)
+			break
+		}
+		v.items = []nativeValue{}
+		for {
+			if len(rest) == 0 {
+				return nativeValue{}, nil, errNativeTruncated
+			}
+			if rest[0] == cborBreak {
+				rest = rest[1:]
+				break
+			}
+			var item nativeValue
+			if item, rest, err = f.parse(rest, depth+1); err != nil {
+				return nativeValue{}, nil, err
+			}
+			v.items = append(v.items, item)
+		}
+		if uint64(len(v.items))%per != 0 {
+			return nativeValue{}, nil, fmt.Errorf("%w: CBOR map without a value for its last key", ErrInvalidEncoding)
+		}
+	case cborTag:
+		// Tags only annotate the value that follows, which is decoded as is
+		if v, rest, err = f.parse(rest, depth+1); err != nil {
+			return nativeValue{}, nil, err
+		}
+	case cborSimple:
+		switch initial {
+		case cborFalse, cborTrue:
+			v = nativeValue{kind: nativeBool, b: initial == cborTrue}
+		case cborNull, cborUndefined:
+			v.kind = nativeNil
+		case cborFloat16:
+			v = nativeValue{kind: nativeFloat, f: float16ToFloat64(uint16(arg))}
+		case cborFloat32:
+			v = nativeValue{kind: nativeFloat, f: float64(math.Float32frombits(uint32(arg)))}
+		case cborFloat64:
+			v = nativeValue{kind: nativeFloat, f: math.Float64frombits(arg)}
+		default:
+			return nativeValue{}, nil, fmt.Errorf("%w: unsupported CBOR simple value %#x", ErrInvalidEncoding, initial)
+		}
+	}
+	if err != nil {
+		return nativeValue{}, nil, err
+	}
+	v.raw = data[:len(data)-len(rest)]
+	return v, rest, nil
+}
+
+// float16ToFloat64 converts an IEEE 754 half-precision value.
+func float16ToFloat64(h uint16) float64 {
+	sign := 1.0
+	if h&0x8000 != 0 {
+		sign = -1
+	}
+	exp, mantissa := int(h>>10&0x1f), float64(h&0x3ff)
+	switch exp {
+	case 0:
+		return sign * math.Ldexp(mantissa, -24)
+	case 0x1f:
+		if mantissa == 0 {
+			return math.Inf(int(sign))
+		}
+		return math.NaN()
+	}
+	return sign * math.Ldexp(mantissa+0x400, exp-25)
+}
+
+func (cborFormat) marshal(v reflect.Value) ([]byte, bool, error) {
+	m, ok := hookOf[cborMarshaler](v)
+	if !ok {
+		return nil, false, nil
+	}
+	data, err := m.MarshalCBOR()
+	return data, true, err
+}
+
+func (cborFormat) unmarshal(v reflect.Value, raw []byte) (bool, error) {
+	u, ok := hookOf[cborUnmarshaler](v)
+	if !ok {
+		return false, nil
+	}
+	return true, u.UnmarshalCBOR(raw)
+}
+
+// MarshalCBOR encodes the container as CBOR.
+func (s *Set[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(s) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (s *Set[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(s, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (ms *MultiSet[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(ms) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (ms *MultiSet[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(ms, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (mm *MultiMap[K, V]) MarshalCBOR() ([]byte, error) { return marshalCBOR(mm) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (mm *MultiMap[K, V]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(mm, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (s *Stack[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(s) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (s *Stack[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(s, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (q *Queue[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(q) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (q *Queue[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(q, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (d *Deque[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(d) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (d *Deque[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(d, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (l *LinkedList[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(l) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (l *LinkedList[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(l, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (pq *PriorityQueue[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(pq) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (pq *PriorityQueue[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(pq, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (tm *TreeMap[K, V]) MarshalCBOR() ([]byte, error) { return marshalCBOR(tm) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (tm *TreeMap[K, V]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(tm, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (ts *TreeSet[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(ts) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (ts *TreeSet[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(ts, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (m *LinkedHashMap[K, V]) MarshalCBOR() ([]byte, error) { return marshalCBOR(m) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (m *LinkedHashMap[K, V]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(m, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (g *Graph[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(g) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (g *Graph[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(g, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (bst *BST[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(bst) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (bst *BST[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(bst, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (t *Trie) MarshalCBOR() ([]byte, error) { return marshalCBOR(t) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (t *Trie) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(t, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (t *AVLTree[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(t) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (t *AVLTree[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(t, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (sl *SortedList[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(sl) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (sl *SortedList[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(sl, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (bt *BTreeMap[K, V]) MarshalCBOR() ([]byte, error) { return marshalCBOR(bt) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (bt *BTreeMap[K, V]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(bt, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (s *OrderedSet[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(s) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (s *OrderedSet[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(s, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (rb *RingBuffer[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(rb) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (rb *RingBuffer[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(rb, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (bs *BitSet) MarshalCBOR() ([]byte, error) { return marshalCBOR(bs) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (bs *BitSet) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(bs, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (s *SparseSet) MarshalCBOR() ([]byte, error) { return marshalCBOR(s) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (s *SparseSet) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(s, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (ds *DisjointSet[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(ds) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (ds *DisjointSet[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(ds, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (pq *IndexedPriorityQueue[K, P]) MarshalCBOR() ([]byte, error) { return marshalCBOR(pq) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (pq *IndexedPriorityQueue[K, P]) UnmarshalCBOR(data []byte) error {
+	return unmarshalCBOR(pq, data)
+}
+
+// MarshalCBOR encodes the container as CBOR.
+func (h *PairingHeap[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(h) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (h *PairingHeap[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(h, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (mg *MultiGraph[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(mg) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (mg *MultiGraph[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(mg, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (dg *DenseGraph[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(dg) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (dg *DenseGraph[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(dg, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (rt *RadixTree[V]) MarshalCBOR() ([]byte, error) { return marshalCBOR(rt) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (rt *RadixTree[V]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(rt, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (m *ImmutableMap[K, V]) MarshalCBOR() ([]byte, error) { return marshalCBOR(m) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (m *ImmutableMap[K, V]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(m, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (pv *PersistentVector[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(pv) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (pv *PersistentVector[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(pv, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (fl *ForwardList[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(fl) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (fl *ForwardList[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(fl, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (t *Treap[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(t) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (t *Treap[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(t, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (st *SplayTree[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(st) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (st *SplayTree[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(st, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (ms *TreeMultiSet[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(ms) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (ms *TreeMultiSet[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(ms, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (mm *TreeMultiMap[K, V]) MarshalCBOR() ([]byte, error) { return marshalCBOR(mm) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (mm *TreeMultiMap[K, V]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(mm, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (mm *SetMultiMap[K, V]) MarshalCBOR() ([]byte, error) { return marshalCBOR(mm) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (mm *SetMultiMap[K, V]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(mm, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (s *ImmutableSet[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(s) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (s *ImmutableSet[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(s, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (fs *FrozenSet[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(fs) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (fs *FrozenSet[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(fs, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (m *HashMap[K, V]) MarshalCBOR() ([]byte, error) { return marshalCBOR(m) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (m *HashMap[K, V]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(m, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (s *HashSet[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(s) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (s *HashSet[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(s, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (s *EnumSet[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(s) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (s *EnumSet[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(s, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (rs *RangeSet[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(rs) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (rs *RangeSet[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(rs, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (s *MinStack[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(s) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (s *MinStack[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(s, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (mq *MonotonicQueue[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(mq) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (mq *MonotonicQueue[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(mq, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (ms *MonotonicStack[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(ms) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (ms *MonotonicStack[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(ms, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (pq *BoundedPriorityQueue[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(pq) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (pq *BoundedPriorityQueue[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(pq, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (q *ValuePriorityQueue[V, P]) MarshalCBOR() ([]byte, error) { return marshalCBOR(q) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (q *ValuePriorityQueue[V, P]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(q, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (tk *TopK[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(tk) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (tk *TopK[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(tk, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (mh *MedianHeap[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(mh) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (mh *MedianHeap[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(mh, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (ds *RollbackDisjointSet[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(ds) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (ds *RollbackDisjointSet[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(ds, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (d *DAWG) MarshalCBOR() ([]byte, error) { return marshalCBOR(d) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (d *DAWG) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(d, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (m *Matrix[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(m) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (m *Matrix[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(m, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (wc *WeightedChooser[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(wc) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (wc *WeightedChooser[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(wc, data) }
+
+// MarshalCBOR encodes the container as CBOR.
+func (dc *DynamicWeightedChooser[T]) MarshalCBOR() ([]byte, error) { return marshalCBOR(dc) }
+
+// UnmarshalCBOR replaces the contents of the container with a CBOR value.
+func (dc *DynamicWeightedChooser[T]) UnmarshalCBOR(data []byte) error { return unmarshalCBOR(dc, data) }
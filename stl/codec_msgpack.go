@@ -0,0 +1,592 @@
+package stl
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+)
+
+// Containers implement the Marshaler and Unmarshaler hooks of the common MessagePack
+// libraries (MarshalMsgpack / UnmarshalMsgpack, as in github.com/vmihailenco/msgpack), so no
+// MessagePack dependency is needed. The value is the container's payload written natively, as
+// described in codec_native.go: a Set is an array of its elements, a TreeMap a map in key
+// order, and a RingBuffer a map of its capacity, policy, and elements. Extension types are
+// not supported.
+
+// MessagePack format markers.
+const (
+	msgpackNil     = 0xc0
+	msgpackFalse   = 0xc2
+	msgpackTrue    = 0xc3
+	msgpackBin8    = 0xc4
+	msgpackBin16   = 0xc5
+	msgpackBin32   = 0xc6
+	msgpackFloat32 = 0xca
+	msgpackFloat64 = 0xcb
+	msgpackUint8   = 0xcc
+	msgpackUint16  = 0xcd
+	msgpackUint32  = 0xce
+	msgpackUint64  = 0xcf
+	msgpackInt8    = 0xd0
+	msgpackInt16   = 0xd1
+	msgpackInt32   = 0xd2
+	msgpackInt64   = 0xd3
+	msgpackStr8    = 0xd9
+	msgpackStr16   = 0xda
+	msgpackStr32   = 0xdb
+	msgpackArray16 = 0xdc
+	msgpackArray32 = 0xdd
+	msgpackMap16   = 0xde
+	msgpackMap32   = 0xdf
+)
+
+// msgpackMarshaler and msgpackUnmarshaler are the MessagePack hooks, used for nested values.
+type (
+	msgpackMarshaler   interface{ MarshalMsgpack() ([]byte, error) }
+	msgpackUnmarshaler interface{ UnmarshalMsgpack([]byte) error }
+)
+
+// msgpackFormat is the MessagePack nativeFormat.
+type msgpackFormat struct{}
+
+// marshalMsgpack encodes the payload c writes with WriteTo as MessagePack.
+func marshalMsgpack(c io.WriterTo) ([]byte, error) {
+	return marshalNative(msgpackFormat{}, c)
+}
+
+// unmarshalMsgpack decodes a MessagePack value and reads it into c with ReadFrom.
+func unmarshalMsgpack(c io.ReaderFrom, data []byte) error {
+	return unmarshalNative(msgpackFormat{}, c, data)
+}
+
+// appendMsgpackHeader appends the marker for a length n, using fix when n fits in its low
+// bits and the 8-, 16-, or 32-bit marker otherwise. A zero marker means that width is absent.
+func appendMsgpackHeader(b []byte, n int, fix byte, fixMax int, m8, m16, m32 byte) []byte {
+	switch {
+	case n <= fixMax:
+		return append(b, fix|byte(n))
+	case n <= 0xff && m8 != 0:
+		return append(b, m8, byte(n))
+	case n <= 0xffff:
+		return binary.BigEndian.AppendUint16(append(b, m16), uint16(n))
+	}
+	return binary.BigEndian.AppendUint32(append(b, m32), uint32(n))
+}
+
+func (msgpackFormat) appendNil(b []byte) []byte { return append(b, msgpackNil) }
+
+func (msgpackFormat) appendBool(b []byte, v bool) []byte {
+	if v {
+		return append(b, msgpackTrue)
+	}
+	return append(b, msgpackFalse)
+}
+
+func (f msgpackFormat) appendInt(b []byte, v int64) []byte {
+	switch {
+	case v >= 0:
+		return f.appendUint(b, uint64(v))
+	case v >= -32:
+		return append(b, byte(v))
+	case v >= math.MinInt8:
+		return append(b, msgpackInt8, byte(v))
+	case v >= math.MinInt16:
+		return binary.BigEndian.AppendUint16(append(b, msgpackInt16), uint16(v))
+	case v >= math.MinInt32:
+		return binary.BigEndian.AppendUint32(append(b, msgpackInt32), uint32(v))
+	}
+	return binary.BigEndian.AppendUint64(append(b, msgpackInt64), uint64(v))
+}
+
+func (msgpackFormat) appendUint(b []byte, v uint64) []byte {
+	switch {
+	case v <= 0x7f:
+		return append(b, byte(v))
+	case v <= math.MaxUint8:
+		return append(b, msgpackUint8, byte(v))
+	case v <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, msgpackUint16), uint16(v))
+	case v <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, msgpackUint32), uint32(v))
+	}
+	return binary.BigEndian.AppendUint64(append(b, msgpackUint64), v)
+}
+
+func (msgpackFormat) appendFloat32(b []byte, v float32) []byte {
+	return binary.BigEndian.AppendUint32(append(b, msgpackFloat32), math.Float32bits(v))
+}
+
+func (msgpackFormat) appendFloat64(b []byte, v float64) []byte {
+	return binary.BigEndian.AppendUint64(append(b, msgpackFloat64), math.Float64bits(v))
+}
+
+func (msgpackFormat) appendString(b []byte, s string) []byte {
+	b = appendMsgpackHeader(b, len(s), 0xa0, 31, msgpackStr8, msgpackStr16, msgpackStr32)
+	return append(b, s...)
+}
+
+func (msgpackFormat) appendBytes(b []byte, p []byte) []byte {
+	b = appendMsgpackHeader(b, len(p), msgpackBin8, -1, msgpackBin8, msgpackBin16, msgpackBin32)
+	return append(b, p...)
+}
+
+func (msgpackFormat) appendArrayHeader(b []byte, n int) []byte {
+	return appendMsgpackHeader(b, n, 0x90, 15, 0, msgpackArray16, msgpackArray32)
+}
+
+func (msgpackFormat) appendMapHeader(b []byte, n int) []byte {
+	return appendMsgpackHeader(b, n, 0x80, 15, 0, msgpackMap16, msgpackMap32)
+}
+
+func (f msgpackFormat) parse(data []byte, depth int) (nativeValue, []byte, error) {
+	if depth > nativeMaxDepth {
+		return nativeValue{}, nil, fmt.Errorf("%w: msgpack value nested too deeply", ErrInvalidEncoding)
+	}
+	if len(data) == 0 {
+		return nativeValue{}, nil, errNativeTruncated
+	}
+
+	// Fixed-width integers, floats, and lengths follow the marker big-endian
+	marker, rest := data[0], data[1:]
+	next := func(width int) uint64 {
+		if len(rest) < width {
+			rest = nil
+			return 0
+		}
+		var n uint64
+		for _, b := range rest[:width] {
+			n = n<<8 | uint64(b)
+		}
+		rest = rest[width:]
+		return n
+	}
+
+	var v nativeValue
+	var length uint64 // of a string, byte string, array, or map
+	switch {
+	case marker <= 0x7f:
+		v = nativeValue{kind: nativeUint, u: uint64(marker)}
+	case marker >= 0xe0:
+		v = nativeValue{kind: nativeInt, i: int64(int8(marker))}
+	case marker >= 0xa0 && marker <= 0xbf:
+		v.kind, length = nativeString, uint64(marker&0x1f)
+	case marker >= 0x90 && marker <= 0x9f:
+		v.kind, length = nativeArray, uint64(marker&0x0f)
+	case marker >= 0x80 && marker <= 0x8f:
+		v.kind, length = nativeMap, uint64(marker&0x0f)
+	default:
+		switch marker {
+		case msgpackNil:
+			v.kind = nativeNil
+		case msgpackFalse, msgpackTrue:
+			v = nativeValue{kind: nativeBool, b: marker == msgpackTrue}
+		case msgpackUint8, msgpackUint16, msgpackUint32, msgpackUint64:
+			width := 1 << (marker - msgpackUint8)
+			if len(rest) < width {
+				return nativeValue{}, nil, errNativeTruncated
+			}
+			v = nativeValue{kind: nativeUint, u: next(width)}
+		case msgpackInt8, msgpackInt16, msgpackInt32, msgpackInt64:
+			width := 1 << (marker - msgpackInt8)
+			if len(rest) < width {
+				return nativeValue{}, nil, errNativeTruncated
+			}
+			// Sign-extend from the encoded width
+			shift := 64 - 8*width
+			i := int64(next(width)<<shift) >> shift
+			if i >= 0 {
+				v = nativeValue{kind: nativeUint, u: uint64(i)}
+			} else {
+				v = nativeValue{kind: nativeInt, i: i}
+			}
+		case msgpackFloat32:
+			if len(rest) < 4 {
+				return nativeValue{}, nil, errNativeTruncated
+			}
+			v = nativeValue{kind: nativeFloat, f: float64(math.Float32frombits(uint32(next(4))))}
+		case msgpackFloat64:
+			if len(rest) < 8 {
+				return nativeValue{}, nil, errNativeTruncated
+			}
+			v = nativeValue{kind: nativeFloat, f: math.Float64frombits(next(8))}
+		case msgpackStr8, msgpackStr16, msgpackStr32:
+			v.kind, length = nativeString, next(1<<(marker-msgpackStr8))
+		case msgpackBin8, msgpackBin16, msgpackBin32:
+			v.kind, length = nativeBytes, next(1<<(marker-msgpackBin8))
+		case msgpackArray16, msgpackArray32:
+			v.kind, length = nativeArray, next(2<<(marker-msgpackArray16))
+		case msgpackMap16, msgpackMap32:
+			v.kind, length = nativeMap, next(2<<(marker-msgpackMap16))
+		default:
+			return nativeValue{}, nil, fmt.Errorf("%w: unsupported msgpack type %#x", ErrInvalidEncoding, marker)
+		}
+		if rest == nil && v.kind >= nativeString {
+			return nativeValue{}, nil, errNativeTruncated
+		}
+	}
+
+	var err error
+	switch v.kind {
+	case nativeString, nativeBytes:
+		if uint64(len(rest)) < length {
+			return nativeValue{}, nil, errNativeTruncated
+		}
+		v.s, rest = rest[:length], rest[length:]
+	case nativeArray:
+		rest, err = parseItems(f, &v, rest, length, depth)
+	case nativeMap:
+		rest, err = parseItems(f, &v, rest, 2*length, depth)
+	}
+	if err != nil {
+		return nativeValue{}, nil, err
+	}
+	v.raw = data[:len(data)-len(rest)]
+	return v, rest, nil
+}
+
+func (msgpackFormat) marshal(v reflect.Value) ([]byte, bool, error) {
+	m, ok := hookOf[msgpackMarshaler](v)
+	if !ok {
+		return nil, false, nil
+	}
+	data, err := m.MarshalMsgpack()
+	return data, true, err
+}
+
+func (msgpackFormat) unmarshal(v reflect.Value, raw []byte) (bool, error) {
+	u, ok := hookOf[msgpackUnmarshaler](v)
+	if !ok {
+		return false, nil
+	}
+	return true, u.UnmarshalMsgpack(raw)
+}
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (s *Set[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(s) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (s *Set[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(s, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (ms *MultiSet[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(ms) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (ms *MultiSet[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(ms, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (mm *MultiMap[K, V]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(mm) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (mm *MultiMap[K, V]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(mm, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (s *Stack[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(s) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (s *Stack[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(s, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (q *Queue[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(q) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (q *Queue[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(q, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (d *Deque[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(d) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (d *Deque[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(d, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (l *LinkedList[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(l) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (l *LinkedList[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(l, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (pq *PriorityQueue[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(pq) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (pq *PriorityQueue[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(pq, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (tm *TreeMap[K, V]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(tm) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (tm *TreeMap[K, V]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(tm, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (ts *TreeSet[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(ts) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (ts *TreeSet[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(ts, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (m *LinkedHashMap[K, V]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(m) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (m *LinkedHashMap[K, V]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(m, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (g *Graph[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(g) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (g *Graph[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(g, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (bst *BST[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(bst) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (bst *BST[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(bst, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (t *Trie) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(t) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (t *Trie) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(t, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (t *AVLTree[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(t) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (t *AVLTree[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(t, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (sl *SortedList[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(sl) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (sl *SortedList[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(sl, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (bt *BTreeMap[K, V]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(bt) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (bt *BTreeMap[K, V]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(bt, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (s *OrderedSet[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(s) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (s *OrderedSet[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(s, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (rb *RingBuffer[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(rb) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (rb *RingBuffer[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(rb, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (bs *BitSet) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(bs) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (bs *BitSet) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(bs, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (s *SparseSet) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(s) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (s *SparseSet) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(s, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (ds *DisjointSet[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(ds) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (ds *DisjointSet[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(ds, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (pq *IndexedPriorityQueue[K, P]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(pq) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (pq *IndexedPriorityQueue[K, P]) UnmarshalMsgpack(data []byte) error {
+	return unmarshalMsgpack(pq, data)
+}
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (h *PairingHeap[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(h) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (h *PairingHeap[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(h, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (mg *MultiGraph[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(mg) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (mg *MultiGraph[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(mg, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (dg *DenseGraph[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(dg) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (dg *DenseGraph[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(dg, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (rt *RadixTree[V]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(rt) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (rt *RadixTree[V]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(rt, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (m *ImmutableMap[K, V]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(m) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (m *ImmutableMap[K, V]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(m, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (pv *PersistentVector[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(pv) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (pv *PersistentVector[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(pv, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (fl *ForwardList[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(fl) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (fl *ForwardList[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(fl, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (t *Treap[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(t) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (t *Treap[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(t, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (st *SplayTree[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(st) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (st *SplayTree[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(st, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (ms *TreeMultiSet[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(ms) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (ms *TreeMultiSet[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(ms, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (mm *TreeMultiMap[K, V]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(mm) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (mm *TreeMultiMap[K, V]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(mm, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (mm *SetMultiMap[K, V]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(mm) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (mm *SetMultiMap[K, V]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(mm, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (s *ImmutableSet[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(s) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (s *ImmutableSet[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(s, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (fs *FrozenSet[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(fs) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (fs *FrozenSet[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(fs, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (m *HashMap[K, V]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(m) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (m *HashMap[K, V]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(m, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (s *HashSet[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(s) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (s *HashSet[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(s, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (s *EnumSet[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(s) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (s *EnumSet[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(s, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (rs *RangeSet[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(rs) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (rs *RangeSet[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(rs, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (s *MinStack[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(s) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (s *MinStack[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(s, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (mq *MonotonicQueue[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(mq) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (mq *MonotonicQueue[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(mq, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (ms *MonotonicStack[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(ms) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (ms *MonotonicStack[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(ms, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (pq *BoundedPriorityQueue[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(pq) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (pq *BoundedPriorityQueue[T]) UnmarshalMsgpack(data []byte) error {
+	return unmarshalMsgpack(pq, data)
+}
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (q *ValuePriorityQueue[V, P]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(q) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (q *ValuePriorityQueue[V, P]) UnmarshalMsgpack(data []byte) error {
+	return unmarshalMsgpack(q, data)
+}
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (tk *TopK[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(tk) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (tk *TopK[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(tk, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (mh *MedianHeap[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(mh) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (mh *MedianHeap[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(mh, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (ds *RollbackDisjointSet[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(ds) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (ds *RollbackDisjointSet[T]) UnmarshalMsgpack(data []byte) error {
+	return unmarshalMsgpack(ds, data)
+}
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (d *DAWG) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(d) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (d *DAWG) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(d, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (m *Matrix[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(m) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (m *Matrix[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(m, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (wc *WeightedChooser[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(wc) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (wc *WeightedChooser[T]) UnmarshalMsgpack(data []byte) error { return unmarshalMsgpack(wc, data) }
+
+// MarshalMsgpack encodes the container as MessagePack.
+func (dc *DynamicWeightedChooser[T]) MarshalMsgpack() ([]byte, error) { return marshalMsgpack(dc) }
+
+// UnmarshalMsgpack replaces the contents of the container with a MessagePack value.
+func (dc *DynamicWeightedChooser[T]) UnmarshalMsgpack(data []byte) error {
+	return unmarshalMsgpack(dc, data)
+}
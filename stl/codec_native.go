@@ -0,0 +1,558 @@
+package stl
+
+import (
+	"bytes"
+	"encoding"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// Besides the binary format, container payloads can be written natively in a self-describing
+// format such as MessagePack or CBOR. WriteTo and ReadFrom stay format-agnostic: marshalNative
+// hands WriteTo a nativeWriter, and writeContainer then encodes the payload in that format
+// instead of framing it as gob. unmarshalNative does the reverse with a nativeReader.
+//
+// Payloads map onto the format structurally:
+//
+//   - bools, integers, floats, and strings become the matching scalars
+//   - []byte and [N]byte become byte strings, other slices and arrays become arrays
+//   - Go maps, and slices of key/value entries such as []Entry, become maps
+//   - structs become maps keyed by field name, or by the name in a json tag
+//   - nil pointers and interfaces become nil, others are written as what they point to
+//
+// Values with their own hook for the format, such as nested containers, are written with
+// it, and other encoding.BinaryMarshaler values become byte strings. Interface values decode
+// as nil, bool, int64, uint64, float64, string, []byte, []any, or map[any]any.
+
+// nativeMaxDepth bounds the nesting of a parsed value, so hostile input cannot exhaust the
+// stack.
+const nativeMaxDepth = 1000
+
+// nativeFormat writes and parses one self-describing format.
+type nativeFormat interface {
+	appendNil(b []byte) []byte
+	appendBool(b []byte, v bool) []byte
+	appendInt(b []byte, v int64) []byte
+	appendUint(b []byte, v uint64) []byte
+	appendFloat32(b []byte, v float32) []byte
+	appendFloat64(b []byte, v float64) []byte
+	appendString(b []byte, s string) []byte
+	appendBytes(b []byte, p []byte) []byte
+	appendArrayHeader(b []byte, n int) []byte
+	appendMapHeader(b []byte, n int) []byte
+
+	// parse reads one value from the front of data and returns it with the rest of data.
+	parse(data []byte, depth int) (nativeValue, []byte, error)
+
+	// marshal encodes v with the format's own hook, and reports whether v has one.
+	marshal(v reflect.Value) ([]byte, bool, error)
+	// unmarshal decodes raw into v with the format's own hook, and reports whether v has one.
+	unmarshal(v reflect.Value, raw []byte) (bool, error)
+}
+
+// nativeKind is the type of a parsed value.
+type nativeKind uint8
+
+const (
+	nativeNil nativeKind = iota
+	nativeBool
+	nativeInt  // negative integers, in i
+	nativeUint // non-negative integers, in u
+	nativeFloat
+	nativeString
+	nativeBytes
+	nativeArray
+	nativeMap // items alternate keys and values
+)
+
+var nativeKindNames = [...]string{"nil", "bool", "int", "uint", "float", "string", "bytes", "array", "map"}
+
+func (k nativeKind) String() string {
+	return nativeKindNames[k]
+}
+
+// nativeValue is one parsed value of a self-describing format.
+type nativeValue struct {
+	kind  nativeKind
+	b     bool
+	i     int64
+	u     uint64
+	f     float64
+	s     []byte // contents of a string or byte string
+	items []nativeValue
+	raw   []byte // the encoded value, for hooks of nested values
+}
+
+// nativeWriter is handed to WriteTo by marshalNative to collect the payload in its format.
+type nativeWriter struct {
+	format nativeFormat
+	data   []byte
+}
+
+// Write rejects raw bytes; containers write through writeContainer, which encodes natively.
+func (nw *nativeWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("stl: container cannot be encoded natively")
+}
+
+// writePayload encodes payload in the writer's format.
+func (nw *nativeWriter) writePayload(payload any) (int64, error) {
+	data, err := appendNative(nw.format, nw.data, reflect.ValueOf(payload))
+	if err != nil {
+		return 0, err
+	}
+	n := len(data) - len(nw.data)
+	nw.data = data
+	return int64(n), nil
+}
+
+// nativeReader is handed to ReadFrom by unmarshalNative to decode one parsed value.
+type nativeReader struct {
+	format nativeFormat
+	value  nativeValue
+	size   int
+}
+
+// Read rejects raw reads; containers read through readContainer, which decodes natively.
+func (nr *nativeReader) Read(p []byte) (int, error) {
+	return 0, errors.New("stl: container cannot be decoded natively")
+}
+
+// readPayload decodes the parsed value into payload, which must be a pointer.
+func (nr *nativeReader) readPayload(payload any) (int64, error) {
+	if err := decodeNative(nr.format, nr.value, reflect.ValueOf(payload).Elem()); err != nil {
+		return 0, err
+	}
+	return int64(nr.size), nil
+}
+
+// marshalNative returns the payload c writes with WriteTo, encoded in format.
+func marshalNative(format nativeFormat, c io.WriterTo) ([]byte, error) {
+	nw := &nativeWriter{format: format}
+	if _, err := c.WriteTo(nw); err != nil {
+		return nil, err
+	}
+	return nw.data, nil
+}
+
+// unmarshalNative parses data in format and reads it into c with ReadFrom.
+func unmarshalNative(format nativeFormat, c io.ReaderFrom, data []byte) error {
+	value, rest, err := format.parse(data, 0)
+	if err != nil {
+		return err
+	}
+	if len(rest) > 0 {
+		return fmt.Errorf("%w: %d trailing bytes", ErrInvalidEncoding, len(rest))
+	}
+	_, err = c.ReadFrom(&nativeReader{format: format, value: value, size: len(data)})
+	return err
+}
+
+// hookOf returns v, or its address, as an I if it implements it.
+func hookOf[I any](v reflect.Value) (I, bool) {
+	hook := reflect.TypeFor[I]()
+	if v.Type().Implements(hook) && v.CanInterface() {
+		return v.Interface().(I), true
+	}
+	if v.CanAddr() && reflect.PointerTo(v.Type()).Implements(hook) && v.Addr().CanInterface() {
+		return v.Addr().Interface().(I), true
+	}
+	var zero I
+	return zero, false
+}
+
+// isEntryType reports whether t is a key/value entry, whose slices are written as maps.
+func isEntryType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.NumField() == 2 &&
+		t.Field(0).Name == "Key" && t.Field(1).Name == "Value"
+}
+
+// fieldName returns the name a struct field is written under, or false if it is skipped.
+func fieldName(field reflect.StructField) (string, bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return "", false
+	case "":
+		return field.Name, true
+	}
+	return name, true
+}
+
+// appendNative appends v to b in format.
+func appendNative(f nativeFormat, b []byte, v reflect.Value) ([]byte, error) {
+	if !v.IsValid() {
+		return f.appendNil(b), nil
+	}
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return f.appendNil(b), nil
+		}
+		return appendNative(f, b, v.Elem())
+	case reflect.Pointer:
+		if v.IsNil() {
+			return f.appendNil(b), nil
+		}
+	}
+
+	if data, ok, err := f.marshal(v); ok {
+		if err != nil {
+			return nil, err
+		}
+		return append(b, data...), nil
+	}
+	if m, ok := hookOf[encoding.BinaryMarshaler](v); ok {
+		data, err := m.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		return f.appendBytes(b, data), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		return appendNative(f, b, v.Elem())
+	case reflect.Bool:
+		return f.appendBool(b, v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return f.appendInt(b, v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return f.appendUint(b, v.Uint()), nil
+	case reflect.Float32:
+		return f.appendFloat32(b, float32(v.Float())), nil
+	case reflect.Float64:
+		return f.appendFloat64(b, v.Float()), nil
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		b = f.appendArrayHeader(b, 2)
+		b = f.appendFloat64(b, real(c))
+		return f.appendFloat64(b, imag(c)), nil
+	case reflect.String:
+		return f.appendString(b, v.String()), nil
+	case reflect.Slice, reflect.Array:
+		elem := v.Type().Elem()
+		if elem.Kind() == reflect.Uint8 {
+			if v.Kind() == reflect.Slice {
+				return f.appendBytes(b, v.Bytes()), nil
+			}
+			data := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(data), v)
+			return f.appendBytes(b, data), nil
+		}
+		if isEntryType(elem) {
+			b = f.appendMapHeader(b, v.Len())
+			for i := 0; i < v.Len(); i++ {
+				var err error
+				if b, err = appendNative(f, b, v.Index(i).Field(0)); err != nil {
+					return nil, err
+				}
+				if b, err = appendNative(f, b, v.Index(i).Field(1)); err != nil {
+					return nil, err
+				}
+			}
+			return b, nil
+		}
+		b = f.appendArrayHeader(b, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			var err error
+			if b, err = appendNative(f, b, v.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	case reflect.Map:
+		b = f.appendMapHeader(b, v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			var err error
+			if b, err = appendNative(f, b, iter.Key()); err != nil {
+				return nil, err
+			}
+			if b, err = appendNative(f, b, iter.Value()); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	case reflect.Struct:
+		t := v.Type()
+		fields := make([]int, 0, t.NumField())
+		for i := 0; i < t.NumField(); i++ {
+			if _, ok := fieldName(t.Field(i)); ok {
+				fields = append(fields, i)
+			}
+		}
+		b = f.appendMapHeader(b, len(fields))
+		for _, i := range fields {
+			name, _ := fieldName(t.Field(i))
+			b = f.appendString(b, name)
+			var err error
+			if b, err = appendNative(f, b, v.Field(i)); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("stl: cannot encode %s", v.Type())
+}
+
+// decodeNative decodes nv into v, which must be settable.
+func decodeNative(f nativeFormat, nv nativeValue, v reflect.Value) error {
+	if nv.kind == nativeNil {
+		v.SetZero()
+		return nil
+	}
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decodeNative(f, nv, v.Elem())
+	}
+
+	if ok, err := f.unmarshal(v, nv.raw); ok {
+		return err
+	}
+	if nv.kind == nativeBytes {
+		if u, ok := hookOf[encoding.BinaryUnmarshaler](v); ok {
+			return u.UnmarshalBinary(bytes.Clone(nv.s))
+		}
+	}
+
+	mismatch := func() error {
+		return fmt.Errorf("%w: cannot decode %s into %s", ErrInvalidEncoding, nv.kind, v.Type())
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		if nv.kind != nativeBool {
+			return mismatch()
+		}
+		v.SetBool(nv.b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		switch {
+		case nv.kind == nativeInt:
+			i = nv.i
+		case nv.kind == nativeUint && nv.u <= math.MaxInt64:
+			i = int64(nv.u)
+		default:
+			return mismatch()
+		}
+		if v.OverflowInt(i) {
+			return fmt.Errorf("%w: %d overflows %s", ErrInvalidEncoding, i, v.Type())
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if nv.kind != nativeUint {
+			return mismatch()
+		}
+		if v.OverflowUint(nv.u) {
+			return fmt.Errorf("%w: %d overflows %s", ErrInvalidEncoding, nv.u, v.Type())
+		}
+		v.SetUint(nv.u)
+	case reflect.Float32, reflect.Float64:
+		x, ok := nv.number()
+		if !ok {
+			return mismatch()
+		}
+		v.SetFloat(x)
+	case reflect.Complex64, reflect.Complex128:
+		if nv.kind != nativeArray || len(nv.items) != 2 {
+			return mismatch()
+		}
+		re, ok1 := nv.items[0].number()
+		im, ok2 := nv.items[1].number()
+		if !ok1 || !ok2 {
+			return mismatch()
+		}
+		v.SetComplex(complex(re, im))
+	case reflect.String:
+		if nv.kind != nativeString && nv.kind != nativeBytes {
+			return mismatch()
+		}
+		v.SetString(string(nv.s))
+	case reflect.Slice:
+		elem := v.Type().Elem()
+		switch {
+		case nv.kind == nativeBytes && elem.Kind() == reflect.Uint8:
+			v.Set(reflect.MakeSlice(v.Type(), len(nv.s), len(nv.s)))
+			reflect.Copy(v, reflect.ValueOf(nv.s))
+		case nv.kind == nativeArray:
+			v.Set(reflect.MakeSlice(v.Type(), len(nv.items), len(nv.items)))
+			for i, item := range nv.items {
+				if err := decodeNative(f, item, v.Index(i)); err != nil {
+					return err
+				}
+			}
+		case nv.kind == nativeMap && isEntryType(elem):
+			n := len(nv.items) / 2
+			v.Set(reflect.MakeSlice(v.Type(), n, n))
+			for i := 0; i < n; i++ {
+				if err := decodeNative(f, nv.items[2*i], v.Index(i).Field(0)); err != nil {
+					return err
+				}
+				if err := decodeNative(f, nv.items[2*i+1], v.Index(i).Field(1)); err != nil {
+					return err
+				}
+			}
+		default:
+			return mismatch()
+		}
+	case reflect.Array:
+		switch {
+		case nv.kind == nativeBytes && v.Type().Elem().Kind() == reflect.Uint8 && len(nv.s) == v.Len():
+			reflect.Copy(v, reflect.ValueOf(nv.s))
+		case nv.kind == nativeArray && len(nv.items) == v.Len():
+			for i, item := range nv.items {
+				if err := decodeNative(f, item, v.Index(i)); err != nil {
+					return err
+				}
+			}
+		default:
+			return mismatch()
+		}
+	case reflect.Map:
+		if nv.kind != nativeMap {
+			return mismatch()
+		}
+		t := v.Type()
+		m := reflect.MakeMapWithSize(t, len(nv.items)/2)
+		for i := 0; i < len(nv.items); i += 2 {
+			key := reflect.New(t.Key()).Elem()
+			if err := decodeNative(f, nv.items[i], key); err != nil {
+				return err
+			}
+			if !key.Comparable() {
+				return fmt.Errorf("%w: unhashable map key", ErrInvalidEncoding)
+			}
+			value := reflect.New(t.Elem()).Elem()
+			if err := decodeNative(f, nv.items[i+1], value); err != nil {
+				return err
+			}
+			m.SetMapIndex(key, value)
+		}
+		v.Set(m)
+	case reflect.Struct:
+		if nv.kind != nativeMap {
+			return mismatch()
+		}
+		t := v.Type()
+		fields := make(map[string]int, t.NumField())
+		for i := 0; i < t.NumField(); i++ {
+			if name, ok := fieldName(t.Field(i)); ok {
+				fields[name] = i
+			}
+		}
+		// Unknown fields are skipped, as gob does
+		for i := 0; i < len(nv.items); i += 2 {
+			key := nv.items[i]
+			if key.kind != nativeString && key.kind != nativeBytes {
+				return fmt.Errorf("%w: %s field name", ErrInvalidEncoding, key.kind)
+			}
+			if field, ok := fields[string(key.s)]; ok {
+				if err := decodeNative(f, nv.items[i+1], v.Field(field)); err != nil {
+					return err
+				}
+			}
+		}
+	case reflect.Interface:
+		if v.NumMethod() != 0 {
+			return mismatch()
+		}
+		value, err := nv.generic()
+		if err != nil {
+			return err
+		}
+		if value == nil {
+			v.SetZero()
+		} else {
+			v.Set(reflect.ValueOf(value))
+		}
+	default:
+		return mismatch()
+	}
+	return nil
+}
+
+// number returns nv as a float64 if it is numeric.
+func (nv nativeValue) number() (float64, bool) {
+	switch nv.kind {
+	case nativeFloat:
+		return nv.f, true
+	case nativeInt:
+		return float64(nv.i), true
+	case nativeUint:
+		return float64(nv.u), true
+	}
+	return 0, false
+}
+
+// generic returns nv as the value an empty interface decodes to.
+func (nv nativeValue) generic() (any, error) {
+	switch nv.kind {
+	case nativeBool:
+		return nv.b, nil
+	case nativeInt:
+		return nv.i, nil
+	case nativeUint:
+		if nv.u <= math.MaxInt64 {
+			return int64(nv.u), nil
+		}
+		return nv.u, nil
+	case nativeFloat:
+		return nv.f, nil
+	case nativeString:
+		return string(nv.s), nil
+	case nativeBytes:
+		return bytes.Clone(nv.s), nil
+	case nativeArray:
+		items := make([]any, len(nv.items))
+		for i, item := range nv.items {
+			var err error
+			if items[i], err = item.generic(); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	case nativeMap:
+		m := make(map[any]any, len(nv.items)/2)
+		for i := 0; i < len(nv.items); i += 2 {
+			key, err := nv.items[i].generic()
+			if err != nil {
+				return nil, err
+			}
+			if key != nil && !reflect.ValueOf(key).Comparable() {
+				return nil, fmt.Errorf("%w: unhashable map key", ErrInvalidEncoding)
+			}
+			if m[key], err = nv.items[i+1].generic(); err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	}
+	return nil, nil
+}
+
+// parseItems parses the count values following an array or map header into v.items, with
+// count already doubled for maps.
+func parseItems(f nativeFormat, v *nativeValue, rest []byte, count uint64, depth int) ([]byte, error) {
+	// Every value takes at least a byte, so a count beyond the input is truncated
+	if count > uint64(len(rest)) {
+		return nil, errNativeTruncated
+	}
+	v.items = make([]nativeValue, count)
+	for i := range v.items {
+		var err error
+		if v.items[i], rest, err = f.parse(rest, depth+1); err != nil {
+			return nil, err
+		}
+	}
+	return rest, nil
+}
+
+// errNativeTruncated is returned for input that ends inside a value.
+var errNativeTruncated = fmt.Errorf("%w: truncated value", ErrInvalidEncoding)
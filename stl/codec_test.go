@@ -0,0 +1,644 @@
+package stl
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestCodecRoundTripThroughStream(t *testing.T) {
+	set := NewSetFromSlice([]int{3, 1, 2})
+	stack := NewStack[string]()
+	stack.PushAll([]string{"a", "b"})
+	deque := NewDequeFromSlice([]int{1, 2, 3})
+	deque.PopFront()
+	deque.PushBack(4)
+	tm := NewTreeMap[int, string](lessInt)
+	tm.Put(2, "two")
+	tm.Put(1, "one")
+	empty := NewQueue[int]()
+
+	// Containers written back to back can be read back one at a time
+	var buf bytes.Buffer
+	written := int64(0)
+	for _, write := range []func() (int64, error){
+		func() (int64, error) { return set.WriteTo(&buf) },
+		func() (int64, error) { return stack.WriteTo(&buf) },
+		func() (int64, error) { return deque.WriteTo(&buf) },
+		func() (int64, error) { return tm.WriteTo(&buf) },
+		func() (int64, error) { return empty.WriteTo(&buf) },
+	} {
+		n, err := write()
+		if err != nil {
+			t.Fatalf("WriteTo failed: %v", err)
+		}
+		written += n
+	}
+	if written != int64(buf.Len()) {
+		t.Errorf("Expected WriteTo to report %d bytes, got %d", buf.Len(), written)
+	}
+
+	gotSet := NewSet[int]()
+	gotStack := NewStack[string]()
+	gotDeque := NewDeque[int](0)
+	gotTM := NewTreeMap[int, string](lessInt)
+	gotQueue := NewQueue[int]()
+	gotQueue.Enqueue(99)
+	for _, read := range []func() (int64, error){
+		func() (int64, error) { return gotSet.ReadFrom(&buf) },
+		func() (int64, error) { return gotStack.ReadFrom(&buf) },
+		func() (int64, error) { return gotDeque.ReadFrom(&buf) },
+		func() (int64, error) { return gotTM.ReadFrom(&buf) },
+		func() (int64, error) { return gotQueue.ReadFrom(&buf) },
+	} {
+		if _, err := read(); err != nil {
+			t.Fatalf("ReadFrom failed: %v", err)
+		}
+	}
+
+	if !gotSet.Equals(set) {
+		t.Errorf("Expected %v, got %v", set, gotSet)
+	}
+	if top, _ := gotStack.Peek(); top != "b" || gotStack.Size() != 2 {
+		t.Errorf("Expected stack [a b], got %v", gotStack)
+	}
+	if !slices.Equal(gotDeque.ToSlice(), []int{2, 3, 4}) {
+		t.Errorf("Expected deque [2 3 4], got %v", gotDeque)
+	}
+	if !gotTM.Equals(tm) || !gotTM.IsBalanced() {
+		t.Errorf("Expected %v, got %v", tm, gotTM)
+	}
+	if !gotQueue.IsEmpty() {
+		t.Errorf("Expected ReadFrom to replace the queue contents, got %v", gotQueue)
+	}
+}
+
+func TestCodecGob(t *testing.T) {
+	type snapshot struct {
+		Tags   *Set[string]
+		Counts *MultiSet[string]
+		Index  *MultiMap[string, int]
+		Recent *LinkedHashMap[string, int]
+		Jobs   *PriorityQueue[int]
+		Order  *TreeSet[int]
+		Path   *LinkedList[int]
+		Graph  *Graph[string]
+	}
+
+	in := snapshot{
+		Tags:   NewSetFromSlice([]string{"x", "y"}),
+		Counts: NewMultiSetFromSlice([]string{"a", "a", "b"}),
+		Index:  NewMultiMap[string, int](),
+		Recent: NewLinkedHashMap[string, int](true),
+		Jobs:   NewPriorityQueue(lessInt),
+		Order:  NewTreeSetFromSlice([]int{5, 1, 3}, lessInt),
+		Path:   NewLinkedListFromSlice([]int{1, 2, 3}),
+		Graph:  NewGraph[string](true),
+	}
+	in.Index.PutAll("k", []int{1, 2})
+	in.Recent.Put("a", 1)
+	in.Recent.Put("b", 2)
+	in.Recent.Get("a")
+	in.Jobs.Enqueue(3)
+	in.Jobs.Enqueue(1)
+	in.Graph.AddWeightedEdge("a", "b", 2.5)
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+
+	// Ordered containers need their comparator before decoding
+	out := snapshot{
+		Jobs:  NewPriorityQueue(lessInt),
+		Order: NewTreeSet(lessInt),
+	}
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+
+	if !out.Tags.Equals(in.Tags) || out.Counts.Count("a") != 2 || !out.Index.Equals(in.Index) {
+		t.Errorf("Expected unordered containers to round-trip, got %v %v %v", out.Tags, out.Counts, out.Index)
+	}
+	if !slices.Equal(out.Recent.Keys(), []string{"b", "a"}) || !out.Recent.IsAccessOrder() {
+		t.Errorf("Expected LinkedHashMap order [b a] in access mode, got %v", out.Recent.Keys())
+	}
+	if top, _ := out.Jobs.Peek(); top != 1 || out.Jobs.Size() != 2 {
+		t.Errorf("Expected priority queue to peek 1, got %v", out.Jobs)
+	}
+	if !slices.Equal(out.Order.ToSlice(), []int{1, 3, 5}) || !slices.Equal(out.Path.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("Expected ordered contents to round-trip, got %v and %v", out.Order, out.Path)
+	}
+	if w, ok := out.Graph.GetWeight("a", "b"); !ok || w != 2.5 || !out.Graph.IsDirected() {
+		t.Errorf("Expected directed edge a->b with weight 2.5, got %v", out.Graph)
+	}
+}
+
+func TestCodecErrors(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := NewSetFromSlice([]int{1}).WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	if _, err := NewStack[int]().ReadFrom(bytes.NewReader(data)); !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("Expected ErrInvalidEncoding when reading a set as a stack, got %v", err)
+	}
+	if _, err := NewSet[int]().ReadFrom(bytes.NewReader(data[:len(data)-1])); err == nil {
+		t.Error("Expected an error for truncated input")
+	}
+	if err := new(TreeMap[int, int]).GobDecode(data); !errors.Is(err, ErrMissingComparator) {
+		t.Errorf("Expected ErrMissingComparator, got %v", err)
+	}
+}
+
+func TestCodecMsgpackAndCBOR(t *testing.T) {
+	RegisterComparator(func(a, b string) bool { return a < b })
+
+	tm := NewTreeMap[string, int](func(a, b string) bool { return a < b })
+	for i := 0; i < 100; i++ {
+		tm.Put(strconv.Itoa(i), i)
+	}
+	small := NewStack[int]()
+	small.Push(1)
+
+	// Map containers are written as native maps
+	msgpack, err := tm.MarshalMsgpack()
+	if err != nil || msgpack[0] != msgpackMap16 {
+		t.Fatalf("Expected a map16 msgpack value, got %x (%v)", msgpack[:1], err)
+	}
+	cbor, err := tm.MarshalCBOR()
+	if err != nil || cbor[0] != cborMap|24 || cbor[1] != 100 {
+		t.Fatalf("Expected a CBOR map of 100 entries, got %x (%v)", cbor[:2], err)
+	}
+
+	// Decoders allocate fresh containers, so the registered comparator supplies the ordering
+	fromMsgpack := new(TreeMap[string, int])
+	if err := fromMsgpack.UnmarshalMsgpack(msgpack); err != nil || !fromMsgpack.Equals(tm) {
+		t.Errorf("Expected msgpack round trip, got %v (%v)", fromMsgpack.Size(), err)
+	}
+	fromCBOR := new(TreeMap[string, int])
+	if err := fromCBOR.UnmarshalCBOR(cbor); err != nil || !fromCBOR.Equals(tm) {
+		t.Errorf("Expected CBOR round trip, got %v (%v)", fromCBOR.Size(), err)
+	}
+	if k, _, _ := fromCBOR.Min(); k != "0" {
+		t.Errorf("Expected smallest key \"0\", got %q", k)
+	}
+
+	words := NewBSTFromSlice([]string{"m", "c", "x"}, func(a, b string) bool { return a < b })
+	wordsCBOR, _ := words.MarshalCBOR()
+	fromWords := new(BST[string])
+	if err := fromWords.UnmarshalCBOR(wordsCBOR); err != nil || !slices.Equal(fromWords.PreOrder(), []string{"m", "c", "x"}) {
+		t.Errorf("Expected CBOR round trip of a BST, got %v (%v)", fromWords, err)
+	}
+
+	data, _ := small.MarshalMsgpack()
+	got := NewStack[int]()
+	if !bytes.Equal(data, []byte{0x91, 0x01}) || got.UnmarshalMsgpack(data) != nil || got.Size() != 1 {
+		t.Errorf("Expected a one-element msgpack array, got %x and %v", data, got)
+	}
+	if data, _ := small.MarshalCBOR(); !bytes.Equal(data, []byte{0x81, 0x01}) {
+		t.Errorf("Expected a one-element CBOR array, got %x", data)
+	}
+	if err := got.UnmarshalCBOR(data); !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("Expected msgpack data to be rejected as CBOR, got %v", err)
+	}
+}
+
+func TestCodecNativeStructure(t *testing.T) {
+	// Structs are maps keyed by field name
+	topK := NewTopK(2, lessInt)
+	topK.Offer(5)
+	data, err := topK.MarshalMsgpack()
+	want := []byte{0x82, 0xa1, 'K', 0x02, 0xa6, 'V', 'a', 'l', 'u', 'e', 's', 0x91, 0x05}
+	if err != nil || !bytes.Equal(data, want) {
+		t.Errorf("Expected %x, got %x (%v)", want, data, err)
+	}
+
+	// Values from other encoders: an indefinite CBOR array holding a half float and a tagged integer
+	set := NewSet[float64]()
+	if err := set.UnmarshalCBOR([]byte{0x9f, 0xf9, 0x3c, 0x00, 0xc1, 0x02, 0xff}); err != nil || !set.Equals(NewSetFromSlice([]float64{1, 2})) {
+		t.Errorf("Expected {1 2}, got %v (%v)", set, err)
+	}
+	negatives := NewStack[int8]()
+	if err := negatives.UnmarshalMsgpack([]byte{0x93, 0xff, 0xd0, 0x80, 0x7f}); err != nil || !slices.Equal(negatives.ToSlice(), []int8{-1, -128, 127}) {
+		t.Errorf("Expected [-1 -128 127], got %v (%v)", negatives, err)
+	}
+
+	// Nested containers use their own hooks
+	nested := NewTreeMap[string, *Set[int]](func(a, b string) bool { return a < b })
+	nested.Put("odd", NewSetFromSlice([]int{1, 3}))
+	nested.Put("none", nil)
+	for _, format := range []struct {
+		marshal   func() ([]byte, error)
+		unmarshal func(*TreeMap[string, *Set[int]], []byte) error
+	}{
+		{nested.MarshalMsgpack, (*TreeMap[string, *Set[int]]).UnmarshalMsgpack},
+		{nested.MarshalCBOR, (*TreeMap[string, *Set[int]]).UnmarshalCBOR},
+	} {
+		data, err := format.marshal()
+		got := NewTreeMap[string, *Set[int]](func(a, b string) bool { return a < b })
+		if err == nil {
+			err = format.unmarshal(got, data)
+		}
+		odd, _ := got.Get("odd")
+		none, ok := got.Get("none")
+		if err != nil || odd == nil || !odd.Equals(NewSetFromSlice([]int{1, 3})) || !ok || none != nil {
+			t.Errorf("Expected nested sets to round trip, got %v (%v)", got, err)
+		}
+	}
+
+	// Interface values come back as generic types
+	trie := NewTrie()
+	trie.InsertWithValue("n", 3)
+	trie.InsertWithValue("s", "x")
+	data, _ = trie.MarshalCBOR()
+	gotTrie := NewTrie()
+	if err := gotTrie.UnmarshalCBOR(data); err != nil {
+		t.Fatalf("UnmarshalCBOR failed: %v", err)
+	}
+	if value, _ := gotTrie.SearchWithValue("n"); value != int64(3) {
+		t.Errorf("Expected int64(3), got %#v", value)
+	}
+	if value, _ := gotTrie.SearchWithValue("s"); value != "x" {
+		t.Errorf("Expected \"x\", got %#v", value)
+	}
+
+	approx := NewApproxMedianHeap[float64](50)
+	for i := 1; i <= 9; i++ {
+		approx.Add(float64(i))
+	}
+	data, _ = approx.MarshalMsgpack()
+	gotHeap := NewMedianHeap[float64]()
+	if err := gotHeap.UnmarshalMsgpack(data); err != nil || !bytes.Equal(mustWrite(t, gotHeap), mustWrite(t, approx)) {
+		t.Errorf("Expected the t-digest to round trip, got %v", err)
+	}
+}
+
+func TestCodecNativeErrors(t *testing.T) {
+	stack := NewStack[int]()
+	for _, c := range []struct {
+		name string
+		err  error
+	}{
+		{"trailing bytes", stack.UnmarshalMsgpack([]byte{0x90, 0x00})},
+		{"truncated array", stack.UnmarshalMsgpack([]byte{0x92, 0x01})},
+		{"huge count", stack.UnmarshalCBOR([]byte{0x9b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})},
+		{"wrong element type", stack.UnmarshalMsgpack([]byte{0x91, 0xa1, 'x'})},
+		{"overflow", NewStack[uint8]().UnmarshalCBOR([]byte{0x81, 0x19, 0x01, 0x00})},
+		{"unsupported type", stack.UnmarshalMsgpack([]byte{0xd4, 0x01, 0x00})},
+		{"unterminated", stack.UnmarshalCBOR([]byte{0x9f, 0x01})},
+	} {
+		if !errors.Is(c.err, ErrInvalidEncoding) {
+			t.Errorf("Expected ErrInvalidEncoding for %s, got %v", c.name, c.err)
+		}
+	}
+
+	deep := append(bytes.Repeat([]byte{0x91}, nativeMaxDepth+1), 0x01)
+	if err := NewStack[any]().UnmarshalMsgpack(deep); !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("Expected deeply nested input to be rejected, got %v", err)
+	}
+}
+
+func TestCodecBSTAndTrie(t *testing.T) {
+	bst := NewBSTFromSlice([]int{5, 2, 8, 1, 3}, lessInt)
+	trie := NewTrie()
+	trie.Insert("go")
+	trie.InsertWithValue("gob", 3)
+	trie.InsertWithValue("stl", "lib")
+
+	type snapshot struct {
+		Tree  *BST[int]
+		Words *Trie
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(snapshot{Tree: bst, Words: trie}); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	out := snapshot{Tree: NewBST(lessInt)}
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	gotBST, gotTrie := out.Tree, out.Words
+
+	// Pre-order encoding keeps the shape, not just the contents
+	if !slices.Equal(gotBST.PreOrder(), bst.PreOrder()) || gotBST.Size != 5 {
+		t.Errorf("Expected pre-order %v, got %v", bst.PreOrder(), gotBST.PreOrder())
+	}
+	if !gotTrie.Equals(trie) || gotTrie.Size() != 3 {
+		t.Errorf("Expected %v, got %v", trie, gotTrie)
+	}
+	if value, ok := gotTrie.SearchWithValue("gob"); !ok || value != 3 {
+		t.Errorf("Expected value 3 for gob, got %v", value)
+	}
+	if value, ok := gotTrie.SearchWithValue("go"); !ok || value != nil {
+		t.Errorf("Expected nil value for go, got %v", value)
+	}
+
+	if err := new(BST[float64]).GobDecode(nil); !errors.Is(err, ErrMissingComparator) {
+		t.Errorf("Expected ErrMissingComparator, got %v", err)
+	}
+}
+
+// reencode writes src, reads it into dst, and returns both encodings so callers can check
+// that dst holds what src did.
+func reencode(t *testing.T, src io.WriterTo, dst io.ReaderFrom) ([]byte, []byte) {
+	t.Helper()
+	var buf bytes.Buffer
+	if _, err := src.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo %T failed: %v", src, err)
+	}
+	want := slices.Clone(buf.Bytes())
+	if _, err := dst.ReadFrom(&buf); err != nil {
+		t.Fatalf("ReadFrom %T failed: %v", dst, err)
+	}
+	buf.Reset()
+	if _, err := dst.(io.WriterTo).WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo %T failed: %v", dst, err)
+	}
+	return want, buf.Bytes()
+}
+
+func TestCodecOrderedContainers(t *testing.T) {
+	avl := NewAVLTree(lessInt)
+	sorted := NewSortedList(lessInt)
+	btree := NewBTreeMap[int, string](3, lessInt)
+	treap := NewTreap(lessInt)
+	splay := NewSplayTree(lessInt)
+	multiset := NewTreeMultiSet(lessInt)
+	multimap := NewTreeMultiMap[int, string](lessInt)
+	minStack := NewMinStack(lessInt)
+	monoQueue := NewMonotonicQueue(lessInt)
+	monoStack := NewMonotonicStack(lessInt)
+	bounded := NewBoundedPriorityQueueWithPolicy(4, lessInt, RejectNew)
+	topK := NewTopK(3, lessInt)
+	pairing := NewPairingHeap(lessInt)
+	for _, v := range []int{5, 3, 8, 1, 3, 9, 2} {
+		avl.Insert(v)
+		sorted.Add(v)
+		btree.Put(v, strconv.Itoa(v))
+		treap.Insert(v)
+		splay.Insert(v)
+		multiset.Add(v)
+		multimap.Put(v%3, strconv.Itoa(v))
+		minStack.Push(v)
+		monoQueue.Push(v)
+		monoStack.Push(v)
+		bounded.Enqueue(v)
+		topK.Offer(v)
+		pairing.Insert(v)
+	}
+
+	cases := []struct {
+		src, dst interface {
+			io.WriterTo
+			io.ReaderFrom
+		}
+	}{
+		{avl, NewAVLTree(lessInt)},
+		{sorted, NewSortedList(lessInt)},
+		{btree, NewBTreeMap[int, string](8, lessInt)},
+		{treap, NewTreap(lessInt)},
+		{splay, NewSplayTree(lessInt)},
+		{multiset, NewTreeMultiSet(lessInt)},
+		{multimap, NewTreeMultiMap[int, string](lessInt)},
+		{minStack, NewMinStack(lessInt)},
+		{monoQueue, NewMonotonicQueue(lessInt)},
+		{monoStack, NewMonotonicStack(lessInt)},
+		{bounded, NewBoundedPriorityQueue(10, lessInt)},
+		{topK, NewTopK(1, lessInt)},
+	}
+	for _, c := range cases {
+		if want, got := reencode(t, c.src, c.dst); !bytes.Equal(want, got) {
+			t.Errorf("Expected %T to round trip, got %v", c.src, c.dst)
+		}
+	}
+
+	// Derived state is rebuilt rather than trusted
+	if got, _ := cases[7].dst.(*MinStack[int]).Min(); got != 1 {
+		t.Errorf("Expected min 1 after decoding, got %v", got)
+	}
+	if got := cases[10].dst.(*BoundedPriorityQueue[int]); got.Capacity() != 4 || got.Size() != 4 {
+		t.Errorf("Expected capacity and size 4, got %d and %d", got.Capacity(), got.Size())
+	}
+	// A pairing heap's shape depends on its history, so compare what it pops
+	gotHeap := NewPairingHeap(lessInt)
+	if _, err := gotHeap.ReadFrom(bytes.NewReader(mustWrite(t, pairing))); err != nil {
+		t.Fatalf("ReadFrom failed: %v", err)
+	}
+	for !pairing.IsEmpty() {
+		want, _ := pairing.Pop()
+		if got, _ := gotHeap.Pop(); got != want {
+			t.Errorf("Expected %v from the decoded heap, got %v", want, got)
+		}
+	}
+	if _, err := new(Treap[float64]).ReadFrom(bytes.NewReader(nil)); !errors.Is(err, ErrMissingComparator) {
+		t.Errorf("Expected ErrMissingComparator, got %v", err)
+	}
+}
+
+func TestCodecSequencesAndSets(t *testing.T) {
+	ring := NewRingBuffer[int](3, OverflowOverwrite)
+	fl := NewForwardList[int]()
+	bits := NewBitSet(10)
+	sparse := NewSparseSet(10)
+	ordered := NewOrderedSet[string]()
+	vector := NewPersistentVector[int]()
+	enum := NewEnumSet[int]()
+	ranges := NewRangeSet[int]()
+	immutable := NewImmutableMap[string, int]()
+	for i := 1; i <= 5; i++ {
+		ring.Push(i)
+		fl.PushFront(i)
+		bits.Set(i * 7)
+		sparse.Add(i * 2)
+		ordered.Add(strconv.Itoa(6 - i))
+		vector = vector.Append(i)
+		enum.Add(i * 20)
+		ranges.Add(i*10, i*10+5)
+		immutable = immutable.Put(strconv.Itoa(i), i)
+	}
+	radix := NewRadixTree[int]()
+	radix.Insert("team", 1)
+	radix.Insert("tea", 2)
+	radix.Insert("ten", 3)
+	dawg := NewDAWG([]string{"tap", "taps", "top", "tops"})
+	matrix := NewMatrix[float64](2, 3)
+	matrix.Set(1, 2, 4.5)
+	vpq := NewMaxValuePriorityQueue[string, int]()
+	vpq.Enqueue("low", 1)
+	vpq.Enqueue("high", 9)
+	vpq.Enqueue("also-high", 9)
+	exact := NewMedianHeap[int]()
+	approx := NewApproxMedianHeap[float64](50)
+	for _, v := range []int{4, 1, 7, 3} {
+		exact.Add(v)
+		approx.Add(float64(v))
+	}
+	chooser, _ := NewWeightedChooser([]string{"a", "b"}, []float64{1, 3})
+	dynamic := NewDynamicWeightedChooser[string]()
+	dynamic.Add("x", 2)
+	dynamic.Add("y", 0.5)
+
+	cases := []struct {
+		src, dst interface {
+			io.WriterTo
+			io.ReaderFrom
+		}
+	}{
+		{ring, NewRingBuffer[int](1, OverflowFail)},
+		{fl, NewForwardList[int]()},
+		{bits, NewBitSet(0)},
+		{sparse, NewSparseSet(1)},
+		{ordered, NewOrderedSet[string]()},
+		{vector, NewPersistentVector[int]()},
+		{enum, NewEnumSet[int]()},
+		{ranges, NewRangeSet[int]()},
+		{radix, NewRadixTree[int]()},
+		{dawg, NewDAWG(nil)},
+		{matrix, NewMatrix[float64](0, 0)},
+		{vpq, NewValuePriorityQueue[string, int]()},
+		{exact, NewApproxMedianHeap[int](10)},
+		{approx, NewMedianHeap[float64]()},
+		{chooser, new(WeightedChooser[string])},
+		{dynamic, NewDynamicWeightedChooser[string]()},
+	}
+	for _, c := range cases {
+		if want, got := reencode(t, c.src, c.dst); !bytes.Equal(want, got) {
+			t.Errorf("Expected %T to round trip, got %v", c.src, c.dst)
+		}
+	}
+
+	if got := cases[0].dst.(*RingBuffer[int]); got.Dropped() != 2 || !slices.Equal(got.ToSlice(), []int{3, 4, 5}) {
+		t.Errorf("Expected [3 4 5] with 2 dropped, got %v with %d dropped", got.ToSlice(), got.Dropped())
+	}
+	if value, _, _ := cases[11].dst.(*ValuePriorityQueue[string, int]).Dequeue(); value != "high" {
+		t.Errorf("Expected the decoded queue to stay a max queue, got %q", value)
+	}
+	if median, _ := cases[12].dst.(*MedianHeap[int]).Median(); median != 3.5 {
+		t.Errorf("Expected median 3.5, got %v", median)
+	}
+
+	// Immutable maps are compared by contents, since their hash tries depend on history
+	gotMap := NewImmutableMap[string, int]()
+	if _, err := gotMap.ReadFrom(bytes.NewReader(mustWrite(t, immutable))); err != nil || !maps.Equal(gotMap.ToMap(), immutable.ToMap()) {
+		t.Errorf("Expected %v, got %v (%v)", immutable, gotMap, err)
+	}
+}
+
+func TestCodecHashedContainers(t *testing.T) {
+	hashInt := func(v int) uint64 { return uint64(v) * 0x9e3779b97f4a7c15 }
+	equalInt := func(a, b int) bool { return a == b }
+
+	hm := NewMapWithHasher[int, string](hashInt, equalInt)
+	hs := NewSetWithHasher(hashInt, equalInt)
+	smm := NewSetMultiMap[string, int]()
+	frozen := NewFrozenSetFromSlice([]int{1, 2, 3})
+	immutable := NewImmutableSetFromSlice([]int{4, 5})
+	for i := 0; i < 20; i++ {
+		hm.Put(i, strconv.Itoa(i))
+		hs.Add(i)
+		smm.Put(strconv.Itoa(i%4), i)
+	}
+
+	gotMap := NewMapWithHasher[int, string](hashInt, equalInt)
+	gotSet := NewSetWithHasher(hashInt, equalInt)
+	gotMulti := NewSetMultiMap[string, int]()
+	gotFrozen := new(FrozenSet[int])
+	gotImmutable := NewImmutableSet[int]()
+	for _, c := range []struct {
+		src io.WriterTo
+		dst io.ReaderFrom
+	}{{hm, gotMap}, {hs, gotSet}, {smm, gotMulti}, {frozen, gotFrozen}, {immutable, gotImmutable}} {
+		if _, err := c.dst.ReadFrom(bytes.NewReader(mustWrite(t, c.src))); err != nil {
+			t.Fatalf("ReadFrom %T failed: %v", c.dst, err)
+		}
+	}
+
+	if value, ok := gotMap.Get(7); gotMap.Size() != 20 || !ok || value != "7" {
+		t.Errorf("Expected 20 entries with 7 -> \"7\", got %d entries and %q", gotMap.Size(), value)
+	}
+	if gotSet.Size() != 20 || !gotSet.Contains(19) {
+		t.Errorf("Expected 20 elements including 19, got %d", gotSet.Size())
+	}
+	if gotMulti.Size() != 20 || len(gotMulti.Get("3")) != 5 {
+		t.Errorf("Expected 20 values with 5 under \"3\", got %d and %v", gotMulti.Size(), gotMulti.Get("3"))
+	}
+	if !gotFrozen.Equals(frozen) || gotFrozen.Hash() != frozen.Hash() {
+		t.Errorf("Expected %v, got %v", frozen, gotFrozen)
+	}
+	if !gotImmutable.Equals(immutable) {
+		t.Errorf("Expected %v, got %v", immutable, gotImmutable)
+	}
+
+	if _, err := new(HashMap[int, string]).ReadFrom(bytes.NewReader(mustWrite(t, hm))); !errors.Is(err, ErrMissingHasher) {
+		t.Errorf("Expected ErrMissingHasher, got %v", err)
+	}
+}
+
+func TestCodecGraphsAndDisjointSets(t *testing.T) {
+	mg := NewMultiGraph[string](true)
+	mg.AddEdge("a", "b")
+	mg.AddEdge("a", "b")
+	mg.AddEdge("b", "b")
+	dg := NewDenseGraph[string](false)
+	dg.AddEdge("a", "b")
+	dg.AddEdge("c", "c")
+	ds := NewDisjointSet[int]()
+	ds.Union(1, 2)
+	ds.Union(3, 4)
+	ds.MakeSet(5)
+	rds := NewRollbackDisjointSet[int]()
+	rds.Union(1, 2)
+	checkpoint := rds.Checkpoint()
+	rds.Union(2, 3)
+	ipq := NewIndexedPriorityQueue[string, int](lessInt)
+	ipq.Push("b", 2)
+	ipq.Push("a", 1)
+
+	gotMG := NewMultiGraph[string](false)
+	gotDG := NewDenseGraph[string](true)
+	gotDS := NewDisjointSet[int]()
+	gotRDS := NewRollbackDisjointSet[int]()
+	gotIPQ := NewIndexedPriorityQueue[string, int](lessInt)
+	for _, c := range []struct {
+		src io.WriterTo
+		dst io.ReaderFrom
+	}{{mg, gotMG}, {dg, gotDG}, {ds, gotDS}, {rds, gotRDS}, {ipq, gotIPQ}} {
+		if _, err := c.dst.ReadFrom(bytes.NewReader(mustWrite(t, c.src))); err != nil {
+			t.Fatalf("ReadFrom %T failed: %v", c.dst, err)
+		}
+	}
+
+	if gotMG.EdgeCount() != 3 || !gotMG.HasEdge("a", "b") || gotMG.HasEdge("b", "a") {
+		t.Errorf("Expected the directed multigraph %v, got %v", mg, gotMG)
+	}
+	if gotDG.EdgeCount() != 2 || !gotDG.HasEdge("b", "a") || !gotDG.HasEdge("c", "c") {
+		t.Errorf("Expected the undirected graph %v, got %v", dg, gotDG)
+	}
+	if gotDS.SetCount() != 3 || !gotDS.Connected(3, 4) || gotDS.Connected(2, 3) {
+		t.Errorf("Expected %v, got %v", ds, gotDS)
+	}
+	// The log is replayed, so checkpoints taken before encoding still work
+	if !gotRDS.Connected(1, 3) || !gotRDS.Rollback(checkpoint) || gotRDS.Connected(1, 3) || !gotRDS.Connected(1, 2) {
+		t.Errorf("Expected rollback to undo the second union, got %v", gotRDS)
+	}
+	if key, _, _ := gotIPQ.Pop(); key != "a" || gotIPQ.Size() != 1 {
+		t.Errorf("Expected \"a\" first, got %q", key)
+	}
+}
+
+// mustWrite returns what c writes with WriteTo.
+func mustWrite(t *testing.T, c io.WriterTo) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if _, err := c.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo %T failed: %v", c, err)
+	}
+	return buf.Bytes()
+}
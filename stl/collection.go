@@ -0,0 +1,71 @@
+package stl
+
+// Collection is the set of operations shared by the element containers, so code can accept
+// any of them. Contains compares elements the way the container does: by equality for
+// hashed and sequence containers, and by the comparator for sorted ones.
+type Collection[T any] interface {
+	// Size returns the number of elements.
+	Size() int
+	// IsEmpty reports whether the collection has no elements.
+	IsEmpty() bool
+	// Clear removes all elements.
+	Clear()
+	// Contains reports whether the collection holds an element.
+	Contains(element T) bool
+	// ToSlice returns the elements in the container's iteration order.
+	ToSlice() []T
+	// ForEach calls fn for each element in the container's iteration order.
+	ForEach(fn func(T))
+}
+
+// Map is the set of operations shared by the mutable key-value containers.
+type Map[K, V any] interface {
+	// Get returns the value associated with a key.
+	Get(key K) (V, bool)
+	// Put associates a value with a key, replacing any previous value.
+	Put(key K, value V)
+	// Remove deletes a key and reports whether it was present.
+	Remove(key K) bool
+	// ContainsKey reports whether a key is present.
+	ContainsKey(key K) bool
+	// Size returns the number of entries.
+	Size() int
+	// IsEmpty reports whether the map has no entries.
+	IsEmpty() bool
+	// Clear removes all entries.
+	Clear()
+	// Keys returns the keys in the map's iteration order.
+	Keys() []K
+	// Values returns the values in the map's iteration order. For ordered maps this matches
+	// Keys.
+	Values() []V
+	// ForEach calls fn for each entry in the map's iteration order.
+	ForEach(fn func(K, V))
+}
+
+var (
+	_ Collection[int]    = (*Set[int])(nil)
+	_ Collection[int]    = (*MultiSet[int])(nil)
+	_ Collection[int]    = (*OrderedSet[int])(nil)
+	_ Collection[int]    = (*TreeSet[int])(nil)
+	_ Collection[int]    = (*SortedList[int])(nil)
+	_ Collection[int]    = (*TreeMultiSet[int])(nil)
+	_ Collection[int]    = (*Stack[int])(nil)
+	_ Collection[int]    = (*Queue[int])(nil)
+	_ Collection[int]    = (*Deque[int])(nil)
+	_ Collection[int]    = (*LinkedList[int])(nil)
+	_ Collection[int]    = (*ForwardList[int])(nil)
+	_ Collection[int]    = (*RingBuffer[int])(nil)
+	_ Collection[int]    = (*AVLTree[int])(nil)
+	_ Collection[int]    = (*SplayTree[int])(nil)
+	_ Collection[int]    = (*Treap[int])(nil)
+	_ Collection[int]    = (*EnumSet[int])(nil)
+	_ Collection[int]    = (*SparseSet)(nil)
+	_ Collection[string] = (*Trie)(nil)
+	_ Collection[[]int]  = (*HashSet[[]int])(nil)
+
+	_ Map[int, int]   = (*TreeMap[int, int])(nil)
+	_ Map[int, int]   = (*LinkedHashMap[int, int])(nil)
+	_ Map[int, int]   = (*BTreeMap[int, int])(nil)
+	_ Map[[]int, int] = (*HashMap[[]int, int])(nil)
+)
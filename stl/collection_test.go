@@ -0,0 +1,82 @@
+package stl
+
+import (
+	"slices"
+	"testing"
+)
+
+// sumCollection is a generic algorithm written against Collection alone.
+func sumCollection(c Collection[int]) int {
+	total := 0
+	c.ForEach(func(value int) {
+		total += value
+	})
+	return total
+}
+
+func TestCollection(t *testing.T) {
+	stack := NewStack[int]()
+	stack.PushAll([]int{1, 2, 3})
+	avl := NewAVLTree(lessInt)
+	for _, value := range []int{2, 3, 1} {
+		avl.Insert(value)
+	}
+	ring := NewRingBuffer[int](4, OverflowOverwrite)
+	for _, value := range []int{1, 2, 3} {
+		ring.Push(value)
+	}
+	trie := NewTrieFromSlice([]string{"go", "stl"})
+
+	collections := map[string]Collection[int]{
+		"Set":        NewSetFromSlice([]int{1, 2, 3}),
+		"TreeSet":    NewTreeSetFromSlice([]int{3, 1, 2}, lessInt),
+		"Stack":      stack,
+		"Deque":      NewDequeFromSlice([]int{1, 2, 3}),
+		"LinkedList": NewLinkedListFromSlice([]int{1, 2, 3}),
+		"RingBuffer": ring,
+		"AVLTree":    avl,
+	}
+	for name, c := range collections {
+		if c.Size() != 3 || c.IsEmpty() || sumCollection(c) != 6 {
+			t.Errorf("%s: expected three elements summing to 6, got %v", name, c.ToSlice())
+		}
+		if !c.Contains(2) || c.Contains(4) {
+			t.Errorf("%s: expected to contain 2 but not 4", name)
+		}
+		c.Clear()
+		if !c.IsEmpty() || len(c.ToSlice()) != 0 {
+			t.Errorf("%s: expected to be empty after Clear, got %v", name, c.ToSlice())
+		}
+	}
+
+	var words Collection[string] = trie
+	if !words.Contains("go") || words.Contains("g") || words.Size() != 2 {
+		t.Errorf("Expected trie to contain exactly go and stl, got %v", words.ToSlice())
+	}
+}
+
+func TestMapInterface(t *testing.T) {
+	maps := map[string]Map[int, string]{
+		"TreeMap":       NewTreeMap[int, string](lessInt),
+		"LinkedHashMap": NewLinkedHashMap[int, string](false),
+		"BTreeMap":      NewBTreeMap[int, string](3, lessInt),
+	}
+	for name, m := range maps {
+		m.Put(1, "one")
+		m.Put(2, "two")
+		m.Put(1, "uno")
+		if value, ok := m.Get(1); !ok || value != "uno" || m.Size() != 2 {
+			t.Errorf("%s: expected 1 to map to uno in a map of two, got %v", name, value)
+		}
+		if !slices.Equal(m.Keys(), []int{1, 2}) || !slices.Equal(m.Values(), []string{"uno", "two"}) {
+			t.Errorf("%s: expected keys [1 2] and values [uno two], got %v and %v", name, m.Keys(), m.Values())
+		}
+		if !m.Remove(2) || m.Remove(2) || m.ContainsKey(2) {
+			t.Errorf("%s: expected 2 to be removed exactly once", name)
+		}
+		m.Clear()
+		if !m.IsEmpty() {
+			t.Errorf("%s: expected to be empty after Clear", name)
+		}
+	}
+}
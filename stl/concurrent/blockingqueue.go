@@ -0,0 +1,166 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/dev-sujan/go-stl/stl"
+)
+
+// ErrQueueClosed is returned by BlockingQueue operations after Close.
+var ErrQueueClosed = errors.New("concurrent: queue closed")
+
+// BlockingQueue is a FIFO queue whose Put waits while the queue is full and whose Take waits
+// while it is empty. Both respect context cancellation and deadlines, making the queue the
+// hand-off point between producers and a worker pool.
+type BlockingQueue[T any] struct {
+	mu       sync.Mutex
+	items    *stl.Deque[T]
+	capacity int
+	closed   bool
+	// changed is closed and replaced whenever the queue changes, waking every waiter
+	changed chan struct{}
+}
+
+// NewBlockingQueue creates a blocking queue holding at most capacity items. A capacity of
+// zero or less makes the queue unbounded, so Put never waits.
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	return &BlockingQueue[T]{
+		items:    stl.NewDeque[T](max(capacity, 0)),
+		capacity: max(capacity, 0),
+		changed:  make(chan struct{}),
+	}
+}
+
+// Put adds an item, waiting for space if the queue is full. It returns the context's error
+// if ctx ends first, or ErrQueueClosed if the queue is closed.
+func (q *BlockingQueue[T]) Put(ctx context.Context, item T) error {
+	for {
+		q.mu.Lock()
+		if q.closed {
+			q.mu.Unlock()
+			return ErrQueueClosed
+		}
+		if !q.full() {
+			q.items.PushBack(item)
+			q.notify()
+			q.mu.Unlock()
+			return nil
+		}
+		changed := q.changed
+		q.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Take removes and returns the front item, waiting for one if the queue is empty. It returns
+// the context's error if ctx ends first. After Close, Take keeps returning queued items and
+// then ErrQueueClosed.
+func (q *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	for {
+		q.mu.Lock()
+		if item, ok := q.items.PopFront(); ok {
+			q.notify()
+			q.mu.Unlock()
+			return item, nil
+		}
+		if q.closed {
+			q.mu.Unlock()
+			var zero T
+			return zero, ErrQueueClosed
+		}
+		changed := q.changed
+		q.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// TryPut adds an item without waiting and returns false if the queue is full or closed.
+func (q *BlockingQueue[T]) TryPut(item T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed || q.full() {
+		return false
+	}
+	q.items.PushBack(item)
+	q.notify()
+	return true
+}
+
+// TryTake removes and returns the front item without waiting and returns false if the queue
+// is empty.
+func (q *BlockingQueue[T]) TryTake() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	item, ok := q.items.PopFront()
+	if ok {
+		q.notify()
+	}
+	return item, ok
+}
+
+// Close stops the queue from accepting items and wakes every waiter. Items already queued
+// can still be taken. Closing twice has no effect.
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.closed {
+		q.closed = true
+		q.notify()
+	}
+}
+
+// IsClosed returns true if Close has been called.
+func (q *BlockingQueue[T]) IsClosed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.closed
+}
+
+// Size returns the number of queued items.
+func (q *BlockingQueue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.Size()
+}
+
+// IsEmpty returns true if no items are queued.
+func (q *BlockingQueue[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// Capacity returns the maximum number of queued items, or 0 if the queue is unbounded.
+func (q *BlockingQueue[T]) Capacity() int {
+	return q.capacity
+}
+
+// String returns a string representation of the queue.
+func (q *BlockingQueue[T]) String() string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return fmt.Sprintf("BlockingQueue%v", q.items.ToSlice())
+}
+
+// full reports whether a bounded queue has no free space. The caller must hold the lock.
+func (q *BlockingQueue[T]) full() bool {
+	return q.capacity > 0 && q.items.Size() >= q.capacity
+}
+
+// notify wakes every waiter. The caller must hold the lock.
+func (q *BlockingQueue[T]) notify() {
+	close(q.changed)
+	q.changed = make(chan struct{})
+}
@@ -0,0 +1,86 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBlockingQueueProducerConsumer(t *testing.T) {
+	q := NewBlockingQueue[int](4)
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	sum := 0
+
+	for w := 0; w < 3; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				item, err := q.Take(ctx)
+				if errors.Is(err, ErrQueueClosed) {
+					return
+				}
+				mu.Lock()
+				sum += item
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for i := 1; i <= 100; i++ {
+		if err := q.Put(ctx, i); err != nil {
+			t.Fatalf("Unexpected Put error %v", err)
+		}
+	}
+	q.Close()
+	wg.Wait()
+
+	if sum != 5050 {
+		t.Errorf("Expected sum 5050, got %d", sum)
+	}
+	if err := q.Put(ctx, 1); !errors.Is(err, ErrQueueClosed) {
+		t.Errorf("Expected ErrQueueClosed, got %v", err)
+	}
+}
+
+func TestBlockingQueueContext(t *testing.T) {
+	q := NewBlockingQueue[string](1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := q.Take(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected DeadlineExceeded from Take, got %v", err)
+	}
+
+	if !q.TryPut("a") || q.TryPut("b") {
+		t.Error("Expected TryPut to succeed once on a queue of capacity 1")
+	}
+	cancelled, cancelNow := context.WithCancel(context.Background())
+	cancelNow()
+	if err := q.Put(cancelled, "b"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected Canceled from Put, got %v", err)
+	}
+
+	// A blocked Put completes once a consumer makes room
+	done := make(chan error)
+	go func() { done <- q.Put(context.Background(), "c") }()
+	time.Sleep(5 * time.Millisecond)
+	if item, ok := q.TryTake(); !ok || item != "a" {
+		t.Errorf("Expected a, got %q", item)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("Unexpected Put error %v", err)
+	}
+
+	q.Close()
+	if item, err := q.Take(context.Background()); err != nil || item != "c" {
+		t.Errorf("Expected queued item c after Close, got %q (%v)", item, err)
+	}
+	if _, ok := q.TryTake(); ok || !q.IsClosed() {
+		t.Error("Closed queue should be drained")
+	}
+}
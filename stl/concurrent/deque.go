@@ -0,0 +1,127 @@
+package concurrent
+
+import (
+	"sync"
+
+	"github.com/dev-sujan/go-stl/stl"
+)
+
+// SafeDeque is a Deque that is safe for concurrent use.
+type SafeDeque[T any] struct {
+	mu    sync.RWMutex
+	deque *stl.Deque[T]
+}
+
+// NewSafeDeque creates a new empty concurrent deque with initial capacity.
+func NewSafeDeque[T any](initialCapacity int) *SafeDeque[T] {
+	return &SafeDeque[T]{deque: stl.NewDeque[T](initialCapacity)}
+}
+
+// PushFront adds an element to the front of the deque.
+func (d *SafeDeque[T]) PushFront(element T) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.deque.PushFront(element)
+}
+
+// PushBack adds an element to the back of the deque.
+func (d *SafeDeque[T]) PushBack(element T) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.deque.PushBack(element)
+}
+
+// PopFront removes and returns the element from the front of the deque.
+func (d *SafeDeque[T]) PopFront() (T, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.deque.PopFront()
+}
+
+// PopBack removes and returns the element from the back of the deque.
+func (d *SafeDeque[T]) PopBack() (T, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.deque.PopBack()
+}
+
+// PopAll atomically removes every element and returns them from front to back.
+func (d *SafeDeque[T]) PopAll() []T {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	items := d.deque.ToSlice()
+	d.deque.Clear()
+	return items
+}
+
+// Front returns the element at the front of the deque without removing it.
+func (d *SafeDeque[T]) Front() (T, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.deque.Front()
+}
+
+// Back returns the element at the back of the deque without removing it.
+func (d *SafeDeque[T]) Back() (T, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.deque.Back()
+}
+
+// At returns the element at the specified index.
+func (d *SafeDeque[T]) At(index int) (T, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.deque.At(index)
+}
+
+// Size returns the number of elements in the deque.
+func (d *SafeDeque[T]) Size() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.deque.Size()
+}
+
+// IsEmpty returns true if the deque is empty.
+func (d *SafeDeque[T]) IsEmpty() bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.deque.IsEmpty()
+}
+
+// Clear removes all elements from the deque.
+func (d *SafeDeque[T]) Clear() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.deque.Clear()
+}
+
+// ToSlice returns a copy of the deque as a slice, front to back.
+func (d *SafeDeque[T]) ToSlice() []T {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.deque.ToSlice()
+}
+
+// View calls fn with the wrapped deque while holding the read lock. fn must not modify the
+// deque or retain it after returning.
+func (d *SafeDeque[T]) View(fn func(*stl.Deque[T])) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	fn(d.deque)
+}
+
+// Update calls fn with the wrapped deque while holding the write lock. fn must not retain
+// the deque after returning.
+func (d *SafeDeque[T]) Update(fn func(*stl.Deque[T])) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	fn(d.deque)
+}
+
+// String returns a string representation of the deque.
+func (d *SafeDeque[T]) String() string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return "Safe" + d.deque.String()
+}
@@ -0,0 +1,40 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSafeDeque(t *testing.T) {
+	d := NewSafeDeque[int](4)
+	var wg sync.WaitGroup
+
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(front bool) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				if front {
+					d.PushFront(i)
+				} else {
+					d.PushBack(i)
+				}
+			}
+		}(w%2 == 0)
+	}
+	wg.Wait()
+
+	if d.Size() != 800 {
+		t.Errorf("Expected size 800, got %d", d.Size())
+	}
+
+	d.Clear()
+	d.PushBack(2)
+	d.PushFront(1)
+	if value, _ := d.At(1); value != 2 {
+		t.Errorf("Expected 2 at index 1, got %d", value)
+	}
+	if items := d.PopAll(); len(items) != 2 || items[0] != 1 || !d.IsEmpty() {
+		t.Errorf("Expected [1 2], got %v", items)
+	}
+}
@@ -0,0 +1,5 @@
+// Package concurrent provides mutex-protected wrappers around the stl containers. Every
+// method is safe for concurrent use, and compound operations such as GetOrAdd and PopAll
+// run atomically under a single lock. View and Update run arbitrary code against the
+// wrapped container while holding the read or write lock.
+package concurrent
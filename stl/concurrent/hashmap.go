@@ -0,0 +1,123 @@
+package concurrent
+
+import (
+	"sync"
+
+	"github.com/dev-sujan/go-stl/stl"
+)
+
+// SafeHashMap is a HashMap that is safe for concurrent use, for keys that are not comparable.
+type SafeHashMap[K, V any] struct {
+	mu sync.RWMutex
+	m  *stl.HashMap[K, V]
+}
+
+// NewSafeHashMap creates a new empty concurrent map that uses hash and equals for its keys,
+// with the options of stl.NewMapWithHasher.
+func NewSafeHashMap[K, V any](hash func(K) uint64, equals func(K, K) bool, opts ...stl.Option) *SafeHashMap[K, V] {
+	return &SafeHashMap[K, V]{m: stl.NewMapWithHasher[K, V](hash, equals, opts...)}
+}
+
+// Put associates a value with a key, replacing any previous value.
+func (m *SafeHashMap[K, V]) Put(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m.Put(key, value)
+}
+
+// Get returns the value associated with a key.
+func (m *SafeHashMap[K, V]) Get(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Get(key)
+}
+
+// GetOrAdd returns the existing value for a key, or stores and returns value if the key is
+// absent. The boolean is true if the value was already present.
+func (m *SafeHashMap[K, V]) GetOrAdd(key K, value V) (V, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if existing, exists := m.m.Get(key); exists {
+		return existing, true
+	}
+	m.m.Put(key, value)
+	return value, false
+}
+
+// Remove deletes a key and returns true if it was present.
+func (m *SafeHashMap[K, V]) Remove(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.Remove(key)
+}
+
+// ContainsKey checks if a key exists in the map.
+func (m *SafeHashMap[K, V]) ContainsKey(key K) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.ContainsKey(key)
+}
+
+// Size returns the number of entries in the map.
+func (m *SafeHashMap[K, V]) Size() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Size()
+}
+
+// IsEmpty returns true if the map is empty.
+func (m *SafeHashMap[K, V]) IsEmpty() bool {
+	return m.Size() == 0
+}
+
+// Clear removes all entries from the map.
+func (m *SafeHashMap[K, V]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m.Clear()
+}
+
+// Keys returns all keys in unspecified order.
+func (m *SafeHashMap[K, V]) Keys() []K {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Keys()
+}
+
+// Values returns all values in unspecified order.
+func (m *SafeHashMap[K, V]) Values() []V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Values()
+}
+
+// ForEach calls fn for each key-value pair while holding the read lock. fn must not modify
+// the map.
+func (m *SafeHashMap[K, V]) ForEach(fn func(K, V)) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	m.m.ForEach(fn)
+}
+
+// View calls fn with the wrapped map while holding the read lock. fn must not modify the
+// map or retain it after returning.
+func (m *SafeHashMap[K, V]) View(fn func(*stl.HashMap[K, V])) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	fn(m.m)
+}
+
+// Update calls fn with the wrapped map while holding the write lock, making any sequence of
+// operations atomic. fn must not retain the map after returning.
+func (m *SafeHashMap[K, V]) Update(fn func(*stl.HashMap[K, V])) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	fn(m.m)
+}
+
+// String returns a string representation of the map.
+func (m *SafeHashMap[K, V]) String() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return "Safe" + m.m.String()
+}
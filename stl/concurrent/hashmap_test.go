@@ -0,0 +1,59 @@
+package concurrent
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/dev-sujan/go-stl/stl"
+)
+
+// sumHash is a deliberately weak hash so that distinct keys collide.
+func sumHash(key []int) uint64 {
+	var sum uint64
+	for _, x := range key {
+		sum += uint64(x)
+	}
+	return sum
+}
+
+func TestSafeHashMapAndSet(t *testing.T) {
+	m := NewSafeHashMap[[]int, int](sumHash, slices.Equal[[]int])
+	set := NewSafeHashSet(sumHash, slices.Equal[[]int])
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				key := []int{i, j}
+				m.Put(key, j)
+				set.Add(key)
+				m.Get(key)
+				m.ForEach(func([]int, int) {})
+			}
+		}(i)
+	}
+	wg.Wait()
+	if m.Size() != 800 || set.Size() != 800 {
+		t.Errorf("Expected 800 entries, got %d and %d", m.Size(), set.Size())
+	}
+
+	if value, loaded := m.GetOrAdd([]int{0, 5}, -1); !loaded || value != 5 {
+		t.Errorf("Expected existing value 5, got %d", value)
+	}
+	if set.TryAdd([]int{1, 2}) || !set.TryAdd([]int{9, 9}) {
+		t.Error("Expected TryAdd to add only the new key")
+	}
+
+	// Update makes a read-modify-write sequence atomic
+	m.Update(func(inner *stl.HashMap[[]int, int]) {
+		for _, key := range inner.Keys() {
+			inner.Remove(key)
+		}
+	})
+	if !m.IsEmpty() {
+		t.Errorf("Expected the map to be emptied, got %d entries", m.Size())
+	}
+}
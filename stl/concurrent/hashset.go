@@ -0,0 +1,100 @@
+package concurrent
+
+import (
+	"sync"
+
+	"github.com/dev-sujan/go-stl/stl"
+)
+
+// SafeHashSet is a HashSet that is safe for concurrent use, for elements that are not
+// comparable.
+type SafeHashSet[T any] struct {
+	mu  sync.RWMutex
+	set *stl.HashSet[T]
+}
+
+// NewSafeHashSet creates a new empty concurrent set that uses hash and equals for its
+// elements, with the options of stl.NewSetWithHasher.
+func NewSafeHashSet[T any](hash func(T) uint64, equals func(T, T) bool, opts ...stl.Option) *SafeHashSet[T] {
+	return &SafeHashSet[T]{set: stl.NewSetWithHasher(hash, equals, opts...)}
+}
+
+// Add adds an element to the set.
+func (s *SafeHashSet[T]) Add(element T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Add(element)
+}
+
+// TryAdd adds an element and returns true if it was not already present.
+func (s *SafeHashSet[T]) TryAdd(element T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.set.Contains(element) {
+		return false
+	}
+	s.set.Add(element)
+	return true
+}
+
+// Remove removes an element from the set.
+func (s *SafeHashSet[T]) Remove(element T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Remove(element)
+}
+
+// Contains checks if an element exists in the set.
+func (s *SafeHashSet[T]) Contains(element T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Contains(element)
+}
+
+// Size returns the number of elements in the set.
+func (s *SafeHashSet[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Size()
+}
+
+// IsEmpty returns true if the set is empty.
+func (s *SafeHashSet[T]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Clear removes all elements from the set.
+func (s *SafeHashSet[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Clear()
+}
+
+// ToSlice returns a slice containing all elements in the set.
+func (s *SafeHashSet[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.ToSlice()
+}
+
+// ForEach calls fn for each element while holding the read lock. fn must not modify the set.
+func (s *SafeHashSet[T]) ForEach(fn func(T)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.set.ForEach(fn)
+}
+
+// Update calls fn with the wrapped set while holding the write lock, making any sequence
+// of operations atomic. fn must not retain the set after returning.
+func (s *SafeHashSet[T]) Update(fn func(*stl.HashSet[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(s.set)
+}
+
+// String returns a string representation of the set.
+func (s *SafeHashSet[T]) String() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return "Safe" + s.set.String()
+}
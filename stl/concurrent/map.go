@@ -0,0 +1,167 @@
+package concurrent
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// SafeMap is a hash map that is safe for concurrent use.
+type SafeMap[K comparable, V any] struct {
+	mu   sync.RWMutex
+	data map[K]V
+}
+
+// NewSafeMap creates a new empty concurrent map.
+func NewSafeMap[K comparable, V any]() *SafeMap[K, V] {
+	return &SafeMap[K, V]{data: make(map[K]V)}
+}
+
+// NewSafeMapFromMap creates a concurrent map holding a copy of the given map.
+func NewSafeMapFromMap[K comparable, V any](m map[K]V) *SafeMap[K, V] {
+	data := make(map[K]V, len(m))
+	for key, value := range m {
+		data[key] = value
+	}
+	return &SafeMap[K, V]{data: data}
+}
+
+// Put associates a value with a key.
+func (m *SafeMap[K, V]) Put(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.data[key] = value
+}
+
+// Get returns the value associated with a key.
+func (m *SafeMap[K, V]) Get(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	value, exists := m.data[key]
+	return value, exists
+}
+
+// GetOrAdd returns the existing value for a key, or stores and returns value if the key is
+// absent. The boolean is true if the value was already present.
+func (m *SafeMap[K, V]) GetOrAdd(key K, value V) (V, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if existing, exists := m.data[key]; exists {
+		return existing, true
+	}
+	m.data[key] = value
+	return value, false
+}
+
+// GetOrCompute returns the existing value for a key, or stores and returns the result of
+// compute if the key is absent. compute runs under the write lock at most once per call.
+func (m *SafeMap[K, V]) GetOrCompute(key K, compute func() V) V {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if existing, exists := m.data[key]; exists {
+		return existing
+	}
+	value := compute()
+	m.data[key] = value
+	return value
+}
+
+// Compute atomically replaces the value of a key with the result of fn, which receives the
+// current value and whether it exists. If fn returns false the key is removed.
+func (m *SafeMap[K, V]) Compute(key K, fn func(V, bool) (V, bool)) (V, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	current, exists := m.data[key]
+	value, keep := fn(current, exists)
+	if !keep {
+		delete(m.data, key)
+		var zero V
+		return zero, false
+	}
+	m.data[key] = value
+	return value, true
+}
+
+// Remove deletes a key and returns its value.
+func (m *SafeMap[K, V]) Remove(key K) (V, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	value, exists := m.data[key]
+	delete(m.data, key)
+	return value, exists
+}
+
+// ContainsKey checks if a key exists in the map.
+func (m *SafeMap[K, V]) ContainsKey(key K) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, exists := m.data[key]
+	return exists
+}
+
+// Size returns the number of keys in the map.
+func (m *SafeMap[K, V]) Size() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.data)
+}
+
+// IsEmpty returns true if the map is empty.
+func (m *SafeMap[K, V]) IsEmpty() bool {
+	return m.Size() == 0
+}
+
+// Clear removes all keys from the map.
+func (m *SafeMap[K, V]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	clear(m.data)
+}
+
+// Keys returns all keys in unspecified order.
+func (m *SafeMap[K, V]) Keys() []K {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	keys := make([]K, 0, len(m.data))
+	for key := range m.data {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Values returns all values in unspecified order.
+func (m *SafeMap[K, V]) Values() []V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	values := make([]V, 0, len(m.data))
+	for _, value := range m.data {
+		values = append(values, value)
+	}
+	return values
+}
+
+// ToMap returns an unsynchronized copy of the map.
+func (m *SafeMap[K, V]) ToMap() map[K]V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	result := make(map[K]V, len(m.data))
+	for key, value := range m.data {
+		result[key] = value
+	}
+	return result
+}
+
+// ForEach calls fn for each key-value pair while holding the read lock. fn must not modify
+// the map.
+func (m *SafeMap[K, V]) ForEach(fn func(K, V)) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for key, value := range m.data {
+		fn(key, value)
+	}
+}
+
+// String returns a string representation of the map.
+func (m *SafeMap[K, V]) String() string {
+	return "SafeMap" + strings.TrimPrefix(fmt.Sprint(m.ToMap()), "map")
+}
@@ -0,0 +1,62 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSafeMapGetOrAdd(t *testing.T) {
+	m := NewSafeMap[string, int]()
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	winners := 0
+
+	for w := 0; w < 16; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			if _, loaded := m.GetOrAdd("key", w); !loaded {
+				mu.Lock()
+				winners++
+				mu.Unlock()
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if winners != 1 || m.Size() != 1 {
+		t.Errorf("Expected exactly one writer to win, got %d", winners)
+	}
+}
+
+func TestSafeMapCompute(t *testing.T) {
+	m := NewSafeMapFromMap(map[string]int{"a": 1})
+	var wg sync.WaitGroup
+
+	for w := 0; w < 10; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				m.Compute("counter", func(current int, _ bool) (int, bool) { return current + 1, true })
+			}
+		}()
+	}
+	wg.Wait()
+
+	if value, _ := m.Get("counter"); value != 1000 {
+		t.Errorf("Expected counter 1000, got %d", value)
+	}
+	if m.GetOrCompute("b", func() int { return 2 }) != 2 || m.GetOrCompute("b", func() int { return 3 }) != 2 {
+		t.Error("GetOrCompute should store the first computed value")
+	}
+	if _, kept := m.Compute("a", func(int, bool) (int, bool) { return 0, false }); kept || m.ContainsKey("a") {
+		t.Error("Compute returning false should remove the key")
+	}
+	if value, ok := m.Remove("b"); !ok || value != 2 {
+		t.Errorf("Expected to remove b:2, got %d", value)
+	}
+	if m.String() != "SafeMap[counter:1000]" {
+		t.Errorf("Unexpected map %s", m.String())
+	}
+}
@@ -0,0 +1,152 @@
+package concurrent
+
+import (
+	"sync"
+
+	"github.com/dev-sujan/go-stl/stl"
+)
+
+// SafeMultiMap is a MultiMap that is safe for concurrent use.
+type SafeMultiMap[K comparable, V any] struct {
+	mu sync.RWMutex
+	mm *stl.MultiMap[K, V]
+}
+
+// NewSafeMultiMap creates a new empty concurrent multimap.
+func NewSafeMultiMap[K comparable, V any]() *SafeMultiMap[K, V] {
+	return &SafeMultiMap[K, V]{mm: stl.NewMultiMap[K, V]()}
+}
+
+// Put adds a value to the key.
+func (m *SafeMultiMap[K, V]) Put(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.mm.Put(key, value)
+}
+
+// PutAll adds multiple values to the key atomically.
+func (m *SafeMultiMap[K, V]) PutAll(key K, values []V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.mm.PutAll(key, values)
+}
+
+// PutIfAbsent adds a value only if the key has no values yet and returns true if it did.
+func (m *SafeMultiMap[K, V]) PutIfAbsent(key K, value V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.mm.ContainsKey(key) {
+		return false
+	}
+	m.mm.Put(key, value)
+	return true
+}
+
+// Get returns a copy of the values associated with the key.
+func (m *SafeMultiMap[K, V]) Get(key K) []V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.mm.Get(key)
+}
+
+// TakeAll atomically removes the key and returns the values it held.
+func (m *SafeMultiMap[K, V]) TakeAll(key K) []V {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	values := m.mm.Get(key)
+	m.mm.RemoveAll(key)
+	return values
+}
+
+// Remove removes one occurrence of a value from the key.
+func (m *SafeMultiMap[K, V]) Remove(key K, value V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.mm.Remove(key, value)
+}
+
+// RemoveAll removes the key and all its values.
+func (m *SafeMultiMap[K, V]) RemoveAll(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.mm.RemoveAll(key)
+}
+
+// ContainsKey checks if the key has any values.
+func (m *SafeMultiMap[K, V]) ContainsKey(key K) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.mm.ContainsKey(key)
+}
+
+// Size returns the total number of key-value pairs.
+func (m *SafeMultiMap[K, V]) Size() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.mm.Size()
+}
+
+// KeySize returns the number of distinct keys.
+func (m *SafeMultiMap[K, V]) KeySize() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.mm.KeySize()
+}
+
+// IsEmpty returns true if the multimap is empty.
+func (m *SafeMultiMap[K, V]) IsEmpty() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.mm.IsEmpty()
+}
+
+// Clear removes all keys and values.
+func (m *SafeMultiMap[K, V]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.mm.Clear()
+}
+
+// Keys returns all keys.
+func (m *SafeMultiMap[K, V]) Keys() []K {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.mm.Keys()
+}
+
+// Entries returns all key-value pairs.
+func (m *SafeMultiMap[K, V]) Entries() []stl.Entry[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.mm.Entries()
+}
+
+// Snapshot returns an unsynchronized copy of the multimap.
+func (m *SafeMultiMap[K, V]) Snapshot() *stl.MultiMap[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.mm.Clone()
+}
+
+// View calls fn with the wrapped multimap while holding the read lock. fn must not modify
+// the multimap or retain it after returning.
+func (m *SafeMultiMap[K, V]) View(fn func(*stl.MultiMap[K, V])) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	fn(m.mm)
+}
+
+// Update calls fn with the wrapped multimap while holding the write lock. fn must not
+// retain the multimap after returning.
+func (m *SafeMultiMap[K, V]) Update(fn func(*stl.MultiMap[K, V])) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	fn(m.mm)
+}
+
+// String returns a string representation of the multimap.
+func (m *SafeMultiMap[K, V]) String() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return "Safe" + m.mm.String()
+}
@@ -0,0 +1,38 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSafeMultiMap(t *testing.T) {
+	m := NewSafeMultiMap[string, int]()
+	var wg sync.WaitGroup
+
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				m.Put("shared", i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if m.Size() != 400 || m.KeySize() != 1 {
+		t.Errorf("Expected 400 values under one key, got %d under %d", m.Size(), m.KeySize())
+	}
+	if values := m.TakeAll("shared"); len(values) != 400 || m.ContainsKey("shared") {
+		t.Error("TakeAll should return and remove every value")
+	}
+
+	if !m.PutIfAbsent("a", 1) || m.PutIfAbsent("a", 2) {
+		t.Error("Expected PutIfAbsent to succeed once")
+	}
+	snapshot := m.Snapshot()
+	m.Put("a", 3)
+	if len(snapshot.Get("a")) != 1 || len(m.Get("a")) != 2 {
+		t.Error("Snapshot should be independent of the multimap")
+	}
+}
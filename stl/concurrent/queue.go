@@ -0,0 +1,106 @@
+package concurrent
+
+import (
+	"sync"
+
+	"github.com/dev-sujan/go-stl/stl"
+)
+
+// SafeQueue is a Queue that is safe for concurrent use.
+type SafeQueue[T any] struct {
+	mu    sync.RWMutex
+	queue *stl.Queue[T]
+}
+
+// NewSafeQueue creates a new empty concurrent queue.
+func NewSafeQueue[T any]() *SafeQueue[T] {
+	return &SafeQueue[T]{queue: stl.NewQueue[T]()}
+}
+
+// Enqueue adds an element to the back of the queue.
+func (q *SafeQueue[T]) Enqueue(item T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.queue.Enqueue(item)
+}
+
+// EnqueueAll adds multiple elements atomically, keeping them adjacent.
+func (q *SafeQueue[T]) EnqueueAll(items []T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.queue.EnqueueAll(items)
+}
+
+// Dequeue removes and returns the front element from the queue.
+func (q *SafeQueue[T]) Dequeue() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queue.Dequeue()
+}
+
+// DequeueAll atomically removes every element and returns them from front to back.
+func (q *SafeQueue[T]) DequeueAll() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	items := q.queue.ToSlice()
+	q.queue.Clear()
+	return items
+}
+
+// Peek returns the front element without removing it.
+func (q *SafeQueue[T]) Peek() (T, bool) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.queue.Peek()
+}
+
+// Size returns the number of elements in the queue.
+func (q *SafeQueue[T]) Size() int {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.queue.Size()
+}
+
+// IsEmpty returns true if the queue is empty.
+func (q *SafeQueue[T]) IsEmpty() bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.queue.IsEmpty()
+}
+
+// Clear removes all elements from the queue.
+func (q *SafeQueue[T]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.queue.Clear()
+}
+
+// ToSlice returns a copy of the queue as a slice, front to back.
+func (q *SafeQueue[T]) ToSlice() []T {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.queue.ToSlice()
+}
+
+// View calls fn with the wrapped queue while holding the read lock. fn must not modify the
+// queue or retain it after returning.
+func (q *SafeQueue[T]) View(fn func(*stl.Queue[T])) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	fn(q.queue)
+}
+
+// Update calls fn with the wrapped queue while holding the write lock. fn must not retain
+// the queue after returning.
+func (q *SafeQueue[T]) Update(fn func(*stl.Queue[T])) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	fn(q.queue)
+}
+
+// String returns a string representation of the queue.
+func (q *SafeQueue[T]) String() string {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return "Safe" + q.queue.String()
+}
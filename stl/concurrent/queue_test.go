@@ -0,0 +1,42 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSafeQueue(t *testing.T) {
+	q := NewSafeQueue[int]()
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	seen := make(map[int]bool)
+
+	for i := 0; i < 500; i++ {
+		q.Enqueue(i)
+	}
+	for w := 0; w < 5; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				item, ok := q.Dequeue()
+				if !ok {
+					return
+				}
+				mu.Lock()
+				seen[item] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(seen) != 500 {
+		t.Errorf("Expected every item dequeued once, got %d", len(seen))
+	}
+
+	q.EnqueueAll([]int{7, 8})
+	if items := q.DequeueAll(); len(items) != 2 || items[0] != 7 || !q.IsEmpty() {
+		t.Errorf("Expected [7 8], got %v", items)
+	}
+}
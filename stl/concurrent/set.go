@@ -0,0 +1,164 @@
+package concurrent
+
+import (
+	"sync"
+
+	"github.com/dev-sujan/go-stl/stl"
+)
+
+// SafeSet is a Set that is safe for concurrent use.
+type SafeSet[T comparable] struct {
+	mu  sync.RWMutex
+	set *stl.Set[T]
+}
+
+// NewSafeSet creates a new empty concurrent set.
+func NewSafeSet[T comparable]() *SafeSet[T] {
+	return &SafeSet[T]{set: stl.NewSet[T]()}
+}
+
+// NewSafeSetFromSlice creates a concurrent set from a slice.
+func NewSafeSetFromSlice[T comparable](slice []T) *SafeSet[T] {
+	return &SafeSet[T]{set: stl.NewSetFromSlice(slice)}
+}
+
+// Add adds an element to the set.
+func (s *SafeSet[T]) Add(element T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Add(element)
+}
+
+// TryAdd adds an element and returns true if it was not already present.
+func (s *SafeSet[T]) TryAdd(element T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.set.Contains(element) {
+		return false
+	}
+	s.set.Add(element)
+	return true
+}
+
+// Remove removes an element from the set.
+func (s *SafeSet[T]) Remove(element T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Remove(element)
+}
+
+// TryRemove removes an element and returns true if it was present.
+func (s *SafeSet[T]) TryRemove(element T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.set.Contains(element) {
+		return false
+	}
+	s.set.Remove(element)
+	return true
+}
+
+// Contains checks if an element exists in the set.
+func (s *SafeSet[T]) Contains(element T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Contains(element)
+}
+
+// Size returns the number of elements in the set.
+func (s *SafeSet[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Size()
+}
+
+// IsEmpty returns true if the set is empty.
+func (s *SafeSet[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsEmpty()
+}
+
+// Clear removes all elements from the set.
+func (s *SafeSet[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Clear()
+}
+
+// ToSlice returns a slice containing all elements in the set.
+func (s *SafeSet[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.ToSlice()
+}
+
+// Snapshot returns an unsynchronized copy of the set.
+func (s *SafeSet[T]) Snapshot() *stl.Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Clone()
+}
+
+// Union returns a new concurrent set containing elements from both sets. Each set is read
+// under its own lock, so the result reflects one consistent state of each.
+func (s *SafeSet[T]) Union(other *SafeSet[T]) *SafeSet[T] {
+	snapshot := other.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &SafeSet[T]{set: s.set.Union(snapshot)}
+}
+
+// Intersection returns a new concurrent set containing elements present in both sets.
+func (s *SafeSet[T]) Intersection(other *SafeSet[T]) *SafeSet[T] {
+	snapshot := other.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &SafeSet[T]{set: s.set.Intersection(snapshot)}
+}
+
+// Difference returns a new concurrent set containing elements in this set but not in other.
+func (s *SafeSet[T]) Difference(other *SafeSet[T]) *SafeSet[T] {
+	snapshot := other.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &SafeSet[T]{set: s.set.Difference(snapshot)}
+}
+
+// Equals checks if two sets contain the same elements.
+func (s *SafeSet[T]) Equals(other *SafeSet[T]) bool {
+	snapshot := other.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Equals(snapshot)
+}
+
+// ForEach calls fn for each element while holding the read lock. fn must not modify the set.
+func (s *SafeSet[T]) ForEach(fn func(T)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.set.ForEach(fn)
+}
+
+// View calls fn with the wrapped set while holding the read lock. fn must not modify the
+// set or retain it after returning.
+func (s *SafeSet[T]) View(fn func(*stl.Set[T])) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn(s.set)
+}
+
+// Update calls fn with the wrapped set while holding the write lock, making any sequence
+// of operations atomic. fn must not retain the set after returning.
+func (s *SafeSet[T]) Update(fn func(*stl.Set[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(s.set)
+}
+
+// String returns a string representation of the set.
+func (s *SafeSet[T]) String() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return "Safe" + s.set.String()
+}
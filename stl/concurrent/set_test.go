@@ -0,0 +1,62 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/dev-sujan/go-stl/stl"
+)
+
+func TestSafeSetConcurrentAdds(t *testing.T) {
+	s := NewSafeSet[int]()
+	var wg sync.WaitGroup
+	added := make([]int, 8)
+
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				if s.TryAdd(i) {
+					added[w]++
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	total := 0
+	for _, n := range added {
+		total += n
+	}
+	if total != 1000 || s.Size() != 1000 {
+		t.Errorf("Expected each element to be added exactly once, got %d adds and size %d", total, s.Size())
+	}
+}
+
+func TestSafeSetOperations(t *testing.T) {
+	a := NewSafeSetFromSlice([]int{1, 2, 3})
+	b := NewSafeSetFromSlice([]int{2, 3, 4})
+
+	if a.Union(b).Size() != 4 || a.Intersection(b).Size() != 2 || a.Difference(b).Size() != 1 {
+		t.Error("Set algebra returned an unexpected result")
+	}
+	if !a.TryRemove(1) || a.TryRemove(1) || a.Contains(1) {
+		t.Error("Expected TryRemove to succeed once")
+	}
+
+	a.Update(func(set *stl.Set[int]) {
+		if !set.Contains(4) {
+			set.Add(4)
+		}
+	})
+	if !a.Equals(b) {
+		t.Errorf("Expected %s to equal %s", a, b)
+	}
+
+	snapshot := a.Snapshot()
+	a.Clear()
+	if snapshot.Size() != 3 || !a.IsEmpty() {
+		t.Error("Snapshot should be independent of the set")
+	}
+}
@@ -0,0 +1,382 @@
+package concurrent
+
+import (
+	"fmt"
+	"math/bits"
+	"math/rand"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// skipListMaxLevel bounds the height of a SkipListMap tower.
+const skipListMaxLevel = 32
+
+// skipListNode is a tower in the skip list. Links are atomic so readers never lock; writers
+// lock the predecessors they change. A node is present once linked at level 0 and until it
+// is marked for removal.
+type skipListNode[K, V any] struct {
+	key         K
+	value       atomic.Pointer[V]
+	next        []atomic.Pointer[skipListNode[K, V]]
+	mu          sync.Mutex
+	marked      atomic.Bool
+	fullyLinked atomic.Bool
+}
+
+// SkipListMap is an ordered map that is safe for concurrent use. It is a lazy lock-based
+// skip list: Get, Floor, Ceiling, and iteration never block, even while other goroutines
+// insert or remove keys, and writers only lock the few nodes around the key they change.
+type SkipListMap[K, V any] struct {
+	less func(K, K) bool
+	head *skipListNode[K, V]
+	size atomic.Int64
+}
+
+// NewSkipListMap creates a new empty concurrent ordered map sorted by less.
+func NewSkipListMap[K, V any](less func(K, K) bool) *SkipListMap[K, V] {
+	head := &skipListNode[K, V]{next: make([]atomic.Pointer[skipListNode[K, V]], skipListMaxLevel)}
+	head.fullyLinked.Store(true)
+	return &SkipListMap[K, V]{less: less, head: head}
+}
+
+// randomLevel returns a tower height with P(level > h) = 2^-h.
+func randomLevel() int {
+	return min(bits.TrailingZeros64(rand.Uint64()|1<<(skipListMaxLevel-1))+1, skipListMaxLevel)
+}
+
+// equal reports whether two keys are equivalent under less.
+func (m *SkipListMap[K, V]) equal(a, b K) bool {
+	return !m.less(a, b) && !m.less(b, a)
+}
+
+// find fills preds and succs with the nodes around key at every level and returns the
+// highest level at which key was found, or -1.
+func (m *SkipListMap[K, V]) find(key K, preds, succs *[skipListMaxLevel]*skipListNode[K, V]) int {
+	found := -1
+	pred := m.head
+	for level := skipListMaxLevel - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && m.less(curr.key, key) {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+		if found == -1 && curr != nil && !m.less(key, curr.key) {
+			found = level
+		}
+		preds[level] = pred
+		succs[level] = curr
+	}
+	return found
+}
+
+// Put associates a value with a key and returns true if the key was newly added.
+func (m *SkipListMap[K, V]) Put(key K, value V) bool {
+	topLevel := randomLevel()
+	var preds, succs [skipListMaxLevel]*skipListNode[K, V]
+
+	for {
+		if found := m.find(key, &preds, &succs); found != -1 {
+			existing := succs[found]
+			if !existing.marked.Load() {
+				existing.value.Store(&value)
+				return false
+			}
+			// The key is being removed; retry once it is unlinked
+			runtime.Gosched()
+			continue
+		}
+
+		locked, valid := m.lockPreds(&preds, &succs, topLevel, nil)
+		if !valid {
+			unlockAll(locked)
+			continue
+		}
+
+		node := &skipListNode[K, V]{key: key, next: make([]atomic.Pointer[skipListNode[K, V]], topLevel)}
+		node.value.Store(&value)
+		for level := 0; level < topLevel; level++ {
+			node.next[level].Store(succs[level])
+		}
+		for level := 0; level < topLevel; level++ {
+			preds[level].next[level].Store(node)
+		}
+		node.fullyLinked.Store(true)
+		m.size.Add(1)
+		unlockAll(locked)
+		return true
+	}
+}
+
+// Get returns the value associated with a key.
+func (m *SkipListMap[K, V]) Get(key K) (V, bool) {
+	node := m.ceilingNode(key)
+	if node == nil || !m.equal(key, node.key) {
+		var zero V
+		return zero, false
+	}
+	return *node.value.Load(), true
+}
+
+// ContainsKey checks if a key exists in the map.
+func (m *SkipListMap[K, V]) ContainsKey(key K) bool {
+	_, exists := m.Get(key)
+	return exists
+}
+
+// Remove deletes a key and returns its value.
+func (m *SkipListMap[K, V]) Remove(key K) (V, bool) {
+	var preds, succs [skipListMaxLevel]*skipListNode[K, V]
+	var victim *skipListNode[K, V]
+
+	for {
+		found := m.find(key, &preds, &succs)
+		if victim == nil {
+			if found == -1 {
+				var zero V
+				return zero, false
+			}
+			candidate := succs[found]
+			if !candidate.fullyLinked.Load() || len(candidate.next)-1 != found {
+				// Still being inserted; it counts as present, so wait for it
+				runtime.Gosched()
+				continue
+			}
+
+			candidate.mu.Lock()
+			if candidate.marked.Load() {
+				candidate.mu.Unlock()
+				var zero V
+				return zero, false
+			}
+			candidate.marked.Store(true)
+			victim = candidate
+		}
+
+		locked, valid := m.lockPreds(&preds, &succs, len(victim.next), victim)
+		if !valid {
+			unlockAll(locked)
+			continue
+		}
+
+		for level := len(victim.next) - 1; level >= 0; level-- {
+			preds[level].next[level].Store(victim.next[level].Load())
+		}
+		m.size.Add(-1)
+		victim.mu.Unlock()
+		unlockAll(locked)
+		return *victim.value.Load(), true
+	}
+}
+
+// lockPreds locks the distinct predecessors for levels below height, bottom up, and checks
+// that each is unmarked and still points at its successor (victim when removing). It
+// returns the locked nodes, which the caller must unlock either way.
+func (m *SkipListMap[K, V]) lockPreds(preds, succs *[skipListMaxLevel]*skipListNode[K, V], height int, victim *skipListNode[K, V]) ([]*skipListNode[K, V], bool) {
+	var locked []*skipListNode[K, V]
+	var previous *skipListNode[K, V]
+
+	for level := 0; level < height; level++ {
+		pred := preds[level]
+		if pred != previous {
+			pred.mu.Lock()
+			locked = append(locked, pred)
+			previous = pred
+		}
+
+		if pred.marked.Load() {
+			return locked, false
+		}
+		if victim != nil {
+			if pred.next[level].Load() != victim {
+				return locked, false
+			}
+			continue
+		}
+		succ := succs[level]
+		if (succ != nil && succ.marked.Load()) || pred.next[level].Load() != succ {
+			return locked, false
+		}
+	}
+	return locked, true
+}
+
+// unlockAll releases nodes locked by lockPreds.
+func unlockAll[K, V any](nodes []*skipListNode[K, V]) {
+	for _, node := range nodes {
+		node.mu.Unlock()
+	}
+}
+
+// ceilingNode returns the first present node whose key is not less than key, or nil.
+func (m *SkipListMap[K, V]) ceilingNode(key K) *skipListNode[K, V] {
+	pred := m.head
+	var curr *skipListNode[K, V]
+	for level := skipListMaxLevel - 1; level >= 0; level-- {
+		curr = pred.next[level].Load()
+		for curr != nil && m.less(curr.key, key) {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+	}
+
+	// Keep the level 0 successor found above: reloading pred.next[0] could return a key
+	// inserted since, which may be less than key. Marked nodes keep their forward links, so
+	// skipping them stays on the list.
+	for curr != nil && (curr.marked.Load() || m.less(curr.key, key)) {
+		curr = curr.next[0].Load()
+	}
+	return curr
+}
+
+// floorNode returns the last present node whose key is not greater than key, or nil. With
+// inclusive false it returns the last node strictly less than key.
+func (m *SkipListMap[K, V]) floorNode(key K, inclusive bool) *skipListNode[K, V] {
+	before := func(node *skipListNode[K, V]) bool {
+		if inclusive {
+			return !m.less(key, node.key)
+		}
+		return m.less(node.key, key)
+	}
+
+	for {
+		pred := m.head
+		for level := skipListMaxLevel - 1; level >= 0; level-- {
+			curr := pred.next[level].Load()
+			for curr != nil && before(curr) {
+				pred = curr
+				curr = pred.next[level].Load()
+			}
+		}
+
+		if pred == m.head {
+			return nil
+		}
+		if !pred.marked.Load() {
+			return pred
+		}
+		// The candidate is being removed and links cannot be followed backwards; retry
+		// once it is unlinked
+		runtime.Gosched()
+	}
+}
+
+// Floor returns the greatest key less than or equal to the given key.
+func (m *SkipListMap[K, V]) Floor(key K) (K, V, bool) {
+	return m.entry(m.floorNode(key, true))
+}
+
+// Ceiling returns the smallest key greater than or equal to the given key.
+func (m *SkipListMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return m.entry(m.ceilingNode(key))
+}
+
+// Lower returns the greatest key strictly less than the given key.
+func (m *SkipListMap[K, V]) Lower(key K) (K, V, bool) {
+	return m.entry(m.floorNode(key, false))
+}
+
+// Higher returns the smallest key strictly greater than the given key.
+func (m *SkipListMap[K, V]) Higher(key K) (K, V, bool) {
+	node := m.ceilingNode(key)
+	for node != nil && (node.marked.Load() || m.equal(node.key, key)) {
+		node = node.next[0].Load()
+	}
+	return m.entry(node)
+}
+
+// Min returns the smallest key in the map.
+func (m *SkipListMap[K, V]) Min() (K, V, bool) {
+	node := m.head.next[0].Load()
+	for node != nil && node.marked.Load() {
+		node = node.next[0].Load()
+	}
+	return m.entry(node)
+}
+
+// Max returns the greatest key in the map.
+func (m *SkipListMap[K, V]) Max() (K, V, bool) {
+	for {
+		pred := m.head
+		for level := skipListMaxLevel - 1; level >= 0; level-- {
+			for curr := pred.next[level].Load(); curr != nil; curr = pred.next[level].Load() {
+				pred = curr
+			}
+		}
+		if pred == m.head || !pred.marked.Load() {
+			return m.entry(pred)
+		}
+		runtime.Gosched()
+	}
+}
+
+// entry unpacks a node, treating nil and the head sentinel as absent.
+func (m *SkipListMap[K, V]) entry(node *skipListNode[K, V]) (K, V, bool) {
+	if node == nil || node == m.head {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	return node.key, *node.value.Load(), true
+}
+
+// Range calls fn for each key between min and max (inclusive) in ascending order until fn
+// returns false. Iteration is weakly consistent: it never blocks writers and reflects some
+// of the changes made while it runs.
+func (m *SkipListMap[K, V]) Range(min, max K, fn func(K, V) bool) {
+	for node := m.ceilingNode(min); node != nil && !m.less(max, node.key); node = node.next[0].Load() {
+		if node.marked.Load() {
+			continue
+		}
+		if !fn(node.key, *node.value.Load()) {
+			return
+		}
+	}
+}
+
+// ForEach calls fn for each key-value pair in ascending key order, with the same weak
+// consistency as Range.
+func (m *SkipListMap[K, V]) ForEach(fn func(K, V)) {
+	for node := m.head.next[0].Load(); node != nil; node = node.next[0].Load() {
+		if !node.marked.Load() {
+			fn(node.key, *node.value.Load())
+		}
+	}
+}
+
+// Keys returns the keys in ascending order.
+func (m *SkipListMap[K, V]) Keys() []K {
+	var keys []K
+	m.ForEach(func(key K, _ V) {
+		keys = append(keys, key)
+	})
+	return keys
+}
+
+// Size returns the number of keys in the map.
+func (m *SkipListMap[K, V]) Size() int {
+	return int(m.size.Load())
+}
+
+// IsEmpty returns true if the map is empty.
+func (m *SkipListMap[K, V]) IsEmpty() bool {
+	return m.Size() == 0
+}
+
+// Clear removes every key present when it starts. It is not atomic with respect to
+// concurrent writers.
+func (m *SkipListMap[K, V]) Clear() {
+	for _, key := range m.Keys() {
+		m.Remove(key)
+	}
+}
+
+// String returns a string representation of the map in key order.
+func (m *SkipListMap[K, V]) String() string {
+	var parts []string
+	m.ForEach(func(key K, value V) {
+		parts = append(parts, fmt.Sprintf("%v:%v", key, value))
+	})
+	return "SkipListMap[" + strings.Join(parts, " ") + "]"
+}
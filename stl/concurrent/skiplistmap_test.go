@@ -0,0 +1,201 @@
+package concurrent
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+func lessInt(a, b int) bool { return a < b }
+
+func TestSkipListMapOrderedOperations(t *testing.T) {
+	m := NewSkipListMap[int, string](lessInt)
+	for _, key := range []int{50, 10, 40, 20, 30} {
+		m.Put(key, "v")
+	}
+	if m.Put(30, "updated") {
+		t.Error("Put on an existing key should report an update")
+	}
+	if value, _ := m.Get(30); value != "updated" {
+		t.Errorf("Expected updated value, got %s", value)
+	}
+
+	if key, _, _ := m.Floor(35); key != 30 {
+		t.Errorf("Expected floor 30, got %d", key)
+	}
+	if key, _, _ := m.Ceiling(35); key != 40 {
+		t.Errorf("Expected ceiling 40, got %d", key)
+	}
+	if key, _, _ := m.Lower(30); key != 20 {
+		t.Errorf("Expected lower 20, got %d", key)
+	}
+	if key, _, _ := m.Higher(30); key != 40 {
+		t.Errorf("Expected higher 40, got %d", key)
+	}
+	if _, _, ok := m.Floor(5); ok {
+		t.Error("Floor below the minimum should fail")
+	}
+	if key, _, _ := m.Max(); key != 50 {
+		t.Errorf("Expected max 50, got %d", key)
+	}
+
+	var ranged []int
+	m.Range(15, 45, func(key int, _ string) bool {
+		ranged = append(ranged, key)
+		return true
+	})
+	if len(ranged) != 3 || ranged[0] != 20 || ranged[2] != 40 {
+		t.Errorf("Expected range [20 30 40], got %v", ranged)
+	}
+
+	if value, ok := m.Remove(10); !ok || value != "v" {
+		t.Error("Expected to remove 10")
+	}
+	if _, ok := m.Remove(10); ok {
+		t.Error("Removing a missing key should fail")
+	}
+	if key, _, _ := m.Min(); key != 20 || m.Size() != 4 {
+		t.Errorf("Expected min 20 and size 4, got %d and %d", key, m.Size())
+	}
+	if m.String() != "SkipListMap[20:v 30:updated 40:v 50:v]" {
+		t.Errorf("Unexpected map %s", m.String())
+	}
+
+	m.Clear()
+	if !m.IsEmpty() {
+		t.Error("Map should be empty after Clear")
+	}
+}
+
+func TestSkipListMapConcurrentWriters(t *testing.T) {
+	m := NewSkipListMap[int, int](lessInt)
+	var wg sync.WaitGroup
+	const workers, perWorker = 8, 500
+
+	// Writers insert disjoint ranges and remove their odd keys while readers scan
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				m.Put(w*perWorker+i, w)
+			}
+			for i := 1; i < perWorker; i += 2 {
+				if _, ok := m.Remove(w*perWorker + i); !ok {
+					t.Errorf("Expected to remove %d", w*perWorker+i)
+				}
+			}
+		}(w)
+	}
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				previous := -1
+				m.ForEach(func(key, _ int) {
+					if key <= previous {
+						t.Errorf("Iteration out of order: %d after %d", key, previous)
+					}
+					previous = key
+				})
+				m.Floor(i * 10)
+			}
+		}()
+	}
+	wg.Wait()
+
+	keys := m.Keys()
+	if len(keys) != workers*perWorker/2 || m.Size() != len(keys) {
+		t.Fatalf("Expected %d keys, got %d (size %d)", workers*perWorker/2, len(keys), m.Size())
+	}
+	for i, key := range keys {
+		if key != 2*i {
+			t.Fatalf("Expected key %d at position %d, got %d", 2*i, i, key)
+		}
+	}
+}
+
+func TestSkipListMapConcurrentSameKeys(t *testing.T) {
+	m := NewSkipListMap[int, int](lessInt)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	added, removed := 0, 0
+
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 2000; i++ {
+				key := (i * 7) % 64
+				if (i+w)%2 == 0 {
+					if m.Put(key, w) {
+						mu.Lock()
+						added++
+						mu.Unlock()
+					}
+				} else if _, ok := m.Remove(key); ok {
+					mu.Lock()
+					removed++
+					mu.Unlock()
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if added-removed != m.Size() || len(m.Keys()) != m.Size() {
+		t.Errorf("Expected size %d, got %d with %d keys", added-removed, m.Size(), len(m.Keys()))
+	}
+}
+
+func TestSkipListMapConcurrentReadsSeeOwnWrites(t *testing.T) {
+	m := NewSkipListMap[int, int](lessInt)
+	var wg sync.WaitGroup
+	const workers, keysPerWorker, rounds = 8, 64, 20000
+
+	// Each writer owns the keys congruent to its index, so other writers keep inserting and
+	// removing keys in the gaps around the ones it reads back
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			expected := make(map[int]int)
+			r := rand.New(rand.NewSource(int64(w)))
+			for i := 0; i < rounds; i++ {
+				key := r.Intn(keysPerWorker)*workers + w
+				switch r.Intn(3) {
+				case 0:
+					m.Put(key, i)
+					expected[key] = i
+				case 1:
+					_, wantOK := expected[key]
+					if _, ok := m.Remove(key); ok != wantOK {
+						t.Errorf("Remove(%d): expected %v, got %v", key, wantOK, ok)
+						return
+					}
+					delete(expected, key)
+				}
+
+				want, wantOK := expected[key]
+				if value, ok := m.Get(key); ok != wantOK || value != want {
+					t.Errorf("Get(%d): expected %d, %v, got %d, %v", key, want, wantOK, value, ok)
+					return
+				}
+				if got, _, ok := m.Ceiling(key); !ok && wantOK || ok && got < key || wantOK && got != key {
+					t.Errorf("Ceiling(%d): expected a key >= %d (present %v), got %d, %v", key, key, wantOK, got, ok)
+					return
+				}
+				if got, _, ok := m.Higher(key); ok && got <= key {
+					t.Errorf("Higher(%d): expected a key > %d, got %d", key, key, got)
+					return
+				}
+				if got, _, ok := m.Floor(key); ok && got > key || wantOK && got != key {
+					t.Errorf("Floor(%d): expected a key <= %d (present %v), got %d, %v", key, key, wantOK, got, ok)
+					return
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+}
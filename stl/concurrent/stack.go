@@ -0,0 +1,109 @@
+package concurrent
+
+import (
+	"sync"
+
+	"github.com/dev-sujan/go-stl/stl"
+)
+
+// SafeStack is a Stack that is safe for concurrent use.
+type SafeStack[T any] struct {
+	mu    sync.RWMutex
+	stack *stl.Stack[T]
+}
+
+// NewSafeStack creates a new empty concurrent stack.
+func NewSafeStack[T any]() *SafeStack[T] {
+	return &SafeStack[T]{stack: stl.NewStack[T]()}
+}
+
+// Push adds an element to the top of the stack.
+func (s *SafeStack[T]) Push(item T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.Push(item)
+}
+
+// PushAll adds multiple elements atomically, so the last element becomes the top.
+func (s *SafeStack[T]) PushAll(items []T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.PushAll(items)
+}
+
+// Pop removes and returns the top element from the stack.
+func (s *SafeStack[T]) Pop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Pop()
+}
+
+// PopAll atomically removes every element and returns them from top to bottom.
+func (s *SafeStack[T]) PopAll() []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	items := make([]T, 0, s.stack.Size())
+	for !s.stack.IsEmpty() {
+		item, _ := s.stack.Pop()
+		items = append(items, item)
+	}
+	return items
+}
+
+// Peek returns the top element without removing it.
+func (s *SafeStack[T]) Peek() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.Peek()
+}
+
+// Size returns the number of elements in the stack.
+func (s *SafeStack[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.Size()
+}
+
+// IsEmpty returns true if the stack is empty.
+func (s *SafeStack[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.IsEmpty()
+}
+
+// Clear removes all elements from the stack.
+func (s *SafeStack[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.Clear()
+}
+
+// ToSlice returns a copy of the stack as a slice, bottom to top.
+func (s *SafeStack[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.ToSlice()
+}
+
+// View calls fn with the wrapped stack while holding the read lock. fn must not modify the
+// stack or retain it after returning.
+func (s *SafeStack[T]) View(fn func(*stl.Stack[T])) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn(s.stack)
+}
+
+// Update calls fn with the wrapped stack while holding the write lock. fn must not retain
+// the stack after returning.
+func (s *SafeStack[T]) Update(fn func(*stl.Stack[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(s.stack)
+}
+
+// String returns a string representation of the stack.
+func (s *SafeStack[T]) String() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return "Safe" + s.stack.String()
+}
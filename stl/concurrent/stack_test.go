@@ -0,0 +1,38 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSafeStack(t *testing.T) {
+	s := NewSafeStack[int]()
+	var wg sync.WaitGroup
+
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 250; i++ {
+				s.Push(w*250 + i)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if s.Size() != 1000 {
+		t.Errorf("Expected size 1000, got %d", s.Size())
+	}
+
+	s.Clear()
+	s.PushAll([]int{1, 2, 3})
+	if top, _ := s.Peek(); top != 3 {
+		t.Errorf("Expected top 3, got %d", top)
+	}
+	if items := s.PopAll(); len(items) != 3 || items[0] != 3 || items[2] != 1 {
+		t.Errorf("Expected [3 2 1], got %v", items)
+	}
+	if !s.IsEmpty() {
+		t.Error("Stack should be empty after PopAll")
+	}
+}
@@ -0,0 +1,256 @@
+package stl
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// dawgEdge represents a labeled transition between DAWG nodes.
+type dawgEdge struct {
+	label byte
+	node  *dawgNode
+}
+
+// dawgNode represents a state of a DAWG. Nodes may be shared by many words.
+type dawgNode struct {
+	id    int
+	edges []dawgEdge
+	final bool
+	count int // number of words accepted from this node
+}
+
+// next follows the edge labeled b.
+func (n *dawgNode) next(b byte) *dawgNode {
+	i := sort.Search(len(n.edges), func(k int) bool {
+		return n.edges[k].label >= b
+	})
+	if i < len(n.edges) && n.edges[i].label == b {
+		return n.edges[i].node
+	}
+	return nil
+}
+
+// signature identifies a node by its finality and outgoing edges, so equivalent nodes can be merged.
+func (n *dawgNode) signature() string {
+	var sb strings.Builder
+	if n.final {
+		sb.WriteByte('1')
+	} else {
+		sb.WriteByte('0')
+	}
+	for _, edge := range n.edges {
+		sb.WriteByte(edge.label)
+		sb.WriteString(strconv.Itoa(edge.node.id))
+		sb.WriteByte(',')
+	}
+	return sb.String()
+}
+
+// DAWG represents an immutable directed acyclic word graph: a minimal automaton accepting a
+// fixed set of words. Words share both prefixes and suffixes, so large dictionaries need a
+// fraction of the memory of a Trie. Every word has a dense index in lexicographic order.
+type DAWG struct {
+	root  *dawgNode
+	nodes int
+}
+
+// dawgUnchecked records an edge whose target has not yet been minimized.
+type dawgUnchecked struct {
+	parent *dawgNode
+	label  byte
+	child  *dawgNode
+}
+
+// NewDAWG builds a DAWG from a word list. Sorted input is used directly; unsorted input is
+// sorted first. Duplicates are ignored.
+func NewDAWG(words []string) *DAWG {
+	if !sort.StringsAreSorted(words) {
+		words = append([]string(nil), words...)
+		sort.Strings(words)
+	}
+
+	d := &DAWG{root: &dawgNode{}}
+	nextID := 1
+	register := make(map[string]*dawgNode)
+	var unchecked []dawgUnchecked
+	previous := ""
+
+	// Replace the unchecked nodes below depth with equivalent registered ones
+	minimize := func(depth int) {
+		for i := len(unchecked) - 1; i >= depth; i-- {
+			entry := unchecked[i]
+			signature := entry.child.signature()
+			if existing, ok := register[signature]; ok {
+				last := len(entry.parent.edges) - 1
+				entry.parent.edges[last].node = existing
+			} else {
+				register[signature] = entry.child
+			}
+		}
+		unchecked = unchecked[:depth]
+	}
+
+	for i, word := range words {
+		if i > 0 && word == previous {
+			continue
+		}
+
+		common := commonPrefixLength(word, previous)
+		minimize(common)
+
+		node := d.root
+		if len(unchecked) > 0 {
+			node = unchecked[len(unchecked)-1].child
+		}
+		for k := common; k < len(word); k++ {
+			child := &dawgNode{id: nextID}
+			nextID++
+			node.edges = append(node.edges, dawgEdge{label: word[k], node: child})
+			unchecked = append(unchecked, dawgUnchecked{parent: node, label: word[k], child: child})
+			node = child
+		}
+		node.final = true
+		previous = word
+	}
+	minimize(0)
+
+	d.nodes = len(register) + 1
+	dawgCount(d.root)
+	return d
+}
+
+// dawgCount fills in the word count of every node reachable from node.
+func dawgCount(node *dawgNode) int {
+	if node.count > 0 || (len(node.edges) == 0 && !node.final) {
+		return node.count
+	}
+	if node.final {
+		node.count = 1
+	}
+	for _, edge := range node.edges {
+		node.count += dawgCount(edge.node)
+	}
+	return node.count
+}
+
+// walk follows s from the root and returns the node reached, if any.
+func (d *DAWG) walk(s string) *dawgNode {
+	node := d.root
+	for i := 0; i < len(s) && node != nil; i++ {
+		node = node.next(s[i])
+	}
+	return node
+}
+
+// Contains checks if a word is in the DAWG.
+func (d *DAWG) Contains(word string) bool {
+	node := d.walk(word)
+	return node != nil && node.final
+}
+
+// StartsWith checks if any word in the DAWG starts with the given prefix.
+func (d *DAWG) StartsWith(prefix string) bool {
+	node := d.walk(prefix)
+	return node != nil && node.count > 0
+}
+
+// WordsWithPrefix returns all words that start with the given prefix, in lexicographic order.
+func (d *DAWG) WordsWithPrefix(prefix string) []string {
+	words := []string{}
+	if node := d.walk(prefix); node != nil {
+		d.collect(node, []byte(prefix), &words)
+	}
+	return words
+}
+
+// collect appends every word accepted from node, in order.
+func (d *DAWG) collect(node *dawgNode, prefix []byte, words *[]string) {
+	if node.final {
+		*words = append(*words, string(prefix))
+	}
+	for _, edge := range node.edges {
+		d.collect(edge.node, append(prefix, edge.label), words)
+	}
+}
+
+// Words returns all words in lexicographic order.
+func (d *DAWG) Words() []string {
+	return d.WordsWithPrefix("")
+}
+
+// Index returns the position of a word in the lexicographic order of all words.
+func (d *DAWG) Index(word string) (int, bool) {
+	index := 0
+	node := d.root
+	for i := 0; i < len(word); i++ {
+		if node.final {
+			index++
+		}
+		var next *dawgNode
+		for _, edge := range node.edges {
+			if edge.label >= word[i] {
+				if edge.label == word[i] {
+					next = edge.node
+				}
+				break
+			}
+			index += edge.node.count
+		}
+		if next == nil {
+			return 0, false
+		}
+		node = next
+	}
+	if !node.final {
+		return 0, false
+	}
+	return index, true
+}
+
+// Word returns the word at the given position of the lexicographic order.
+func (d *DAWG) Word(index int) (string, bool) {
+	if index < 0 || index >= d.Size() {
+		return "", false
+	}
+
+	var word []byte
+	node := d.root
+	for {
+		if node.final {
+			if index == 0 {
+				return string(word), true
+			}
+			index--
+		}
+		for _, edge := range node.edges {
+			if index < edge.node.count {
+				word = append(word, edge.label)
+				node = edge.node
+				break
+			}
+			index -= edge.node.count
+		}
+	}
+}
+
+// Size returns the number of words in the DAWG.
+func (d *DAWG) Size() int {
+	return d.root.count
+}
+
+// IsEmpty checks if the DAWG contains no words.
+func (d *DAWG) IsEmpty() bool {
+	return d.root.count == 0
+}
+
+// NodeCount returns the number of distinct nodes in the DAWG, including the root.
+func (d *DAWG) NodeCount() int {
+	return d.nodes
+}
+
+// String returns a string representation of the DAWG.
+func (d *DAWG) String() string {
+	return fmt.Sprintf("DAWG{Words: %d, Nodes: %d}", d.Size(), d.nodes)
+}
@@ -0,0 +1,85 @@
+package stl
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+)
+
+func TestDAWGBasicOperations(t *testing.T) {
+	words := []string{"tap", "taps", "top", "tops", "cap", "caps", "top"}
+	d := NewDAWG(words)
+
+	if d.Size() != 6 {
+		t.Errorf("Expected 6 words, got %d", d.Size())
+	}
+	if !d.Contains("taps") || d.Contains("ta") || d.Contains("tapss") {
+		t.Error("Contains returned an unexpected result")
+	}
+	if !d.StartsWith("to") || d.StartsWith("tx") {
+		t.Error("StartsWith returned an unexpected result")
+	}
+	if prefixed := d.WordsWithPrefix("t"); fmt.Sprint(prefixed) != "[tap taps top tops]" {
+		t.Errorf("Unexpected words %v", prefixed)
+	}
+
+	// root, c, t, a/o shared, p, s
+	if d.NodeCount() != 6 {
+		t.Errorf("Expected 6 nodes after suffix sharing, got %d", d.NodeCount())
+	}
+
+	all := d.Words()
+	for i, word := range all {
+		if index, ok := d.Index(word); !ok || index != i {
+			t.Errorf("Expected index %d for %q, got %d", i, word, index)
+		}
+		if got, _ := d.Word(i); got != word {
+			t.Errorf("Expected %q at index %d, got %q", word, i, got)
+		}
+	}
+	if _, ok := d.Index("to"); ok {
+		t.Error("Expected no index for a missing word")
+	}
+	if _, ok := d.Word(6); ok {
+		t.Error("Expected no word past the end")
+	}
+}
+
+func TestDAWGEmpty(t *testing.T) {
+	d := NewDAWG(nil)
+	if !d.IsEmpty() || d.Contains("") || len(d.Words()) != 0 {
+		t.Error("Expected an empty DAWG")
+	}
+
+	d = NewDAWG([]string{"", "a"})
+	if !d.Contains("") || d.Size() != 2 {
+		t.Error("Expected the empty word to be stored")
+	}
+	if index, _ := d.Index("a"); index != 1 {
+		t.Errorf("Expected index 1, got %d", index)
+	}
+}
+
+func TestDAWGMatchesTrie(t *testing.T) {
+	var words []string
+	for i := 0; i < 2000; i++ {
+		words = append(words, fmt.Sprintf("w%dx%d", i%97, i%13))
+	}
+	d := NewDAWG(words)
+	trie := NewTrieFromSlice(words)
+
+	expected := trie.GetAllWords()
+	sort.Strings(expected)
+	actual := d.Words()
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %d words, got %d", len(expected), len(actual))
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("Expected %q at %d, got %q", expected[i], i, actual[i])
+		}
+	}
+	if d.NodeCount() >= len(expected) {
+		t.Errorf("Expected shared suffixes to keep nodes below %d, got %d", len(expected), d.NodeCount())
+	}
+}
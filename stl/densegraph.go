@@ -0,0 +1,353 @@
+package stl
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// wordBits is the number of bits stored per matrix word.
+const wordBits = 64
+
+// DenseGraph represents a graph backed by an adjacency bit matrix.
+// HasEdge, AddEdge, and RemoveEdge are O(1), and whole-graph operations such as
+// Complement and TransitiveClosure work a machine word at a time. Memory use is
+// O(V²) bits, so it suits dense graphs with a moderate number of nodes.
+type DenseGraph[T comparable] struct {
+	index    map[T]int
+	nodes    []T
+	matrix   [][]uint64
+	edges    int
+	directed bool
+}
+
+// NewDenseGraph creates a new empty dense graph.
+func NewDenseGraph[T comparable](directed bool) *DenseGraph[T] {
+	return &DenseGraph[T]{
+		index:    make(map[T]int),
+		directed: directed,
+	}
+}
+
+// NewDenseGraphFromGraph creates a dense graph with the same nodes and edges as g.
+// Edge weights are not preserved.
+func NewDenseGraphFromGraph[T comparable](g *Graph[T]) *DenseGraph[T] {
+	dg := NewDenseGraph[T](g.directed)
+	for node := range g.adjacency {
+		dg.AddNode(node)
+	}
+	for from, neighbors := range g.adjacency {
+		for _, to := range neighbors {
+			dg.AddEdge(from, to)
+		}
+	}
+	return dg
+}
+
+// words returns the number of words needed per matrix row.
+func (dg *DenseGraph[T]) words() int {
+	return (len(dg.nodes) + wordBits - 1) / wordBits
+}
+
+// hasBit reports whether the matrix cell (i, j) is set.
+func (dg *DenseGraph[T]) hasBit(i, j int) bool {
+	return dg.matrix[i][j/wordBits]&(1<<(uint(j)%wordBits)) != 0
+}
+
+// setBit sets the matrix cell (i, j).
+func (dg *DenseGraph[T]) setBit(i, j int) {
+	dg.matrix[i][j/wordBits] |= 1 << (uint(j) % wordBits)
+}
+
+// clearBit clears the matrix cell (i, j).
+func (dg *DenseGraph[T]) clearBit(i, j int) {
+	dg.matrix[i][j/wordBits] &^= 1 << (uint(j) % wordBits)
+}
+
+// AddNode adds a node to the graph.
+func (dg *DenseGraph[T]) AddNode(node T) {
+	if _, exists := dg.index[node]; exists {
+		return
+	}
+
+	dg.index[node] = len(dg.nodes)
+	dg.nodes = append(dg.nodes, node)
+
+	// Widen every row when the new column does not fit
+	words := dg.words()
+	if len(dg.matrix) > 0 {
+		if len(dg.matrix[0]) < words {
+			for i := range dg.matrix {
+				dg.matrix[i] = append(dg.matrix[i], 0)
+			}
+		}
+		words = len(dg.matrix[0])
+	}
+	dg.matrix = append(dg.matrix, make([]uint64, words))
+}
+
+// AddEdge adds an edge between two nodes. Adding an existing edge has no effect.
+func (dg *DenseGraph[T]) AddEdge(from, to T) {
+	dg.AddNode(from)
+	dg.AddNode(to)
+
+	i, j := dg.index[from], dg.index[to]
+	if dg.hasBit(i, j) {
+		return
+	}
+
+	dg.setBit(i, j)
+	if !dg.directed {
+		dg.setBit(j, i)
+	}
+	dg.edges++
+}
+
+// RemoveEdge removes the edge between two nodes.
+func (dg *DenseGraph[T]) RemoveEdge(from, to T) {
+	i, okFrom := dg.index[from]
+	j, okTo := dg.index[to]
+	if !okFrom || !okTo || !dg.hasBit(i, j) {
+		return
+	}
+
+	dg.clearBit(i, j)
+	if !dg.directed {
+		dg.clearBit(j, i)
+	}
+	dg.edges--
+}
+
+// RemoveNode removes a node and all its edges. The last node takes over its matrix slot.
+func (dg *DenseGraph[T]) RemoveNode(node T) {
+	i, exists := dg.index[node]
+	if !exists {
+		return
+	}
+
+	for j := range dg.nodes {
+		if dg.hasBit(i, j) {
+			dg.RemoveEdge(node, dg.nodes[j])
+		}
+		if dg.directed && dg.hasBit(j, i) {
+			dg.RemoveEdge(dg.nodes[j], node)
+		}
+	}
+
+	// Move the last node into slot i
+	last := len(dg.nodes) - 1
+	if i != last {
+		moved := dg.nodes[last]
+		dg.nodes[i] = moved
+		dg.index[moved] = i
+		dg.matrix[i] = dg.matrix[last]
+		// Column i is empty after the edge removal above, so only set bits move
+		for row := 0; row < last; row++ {
+			if dg.hasBit(row, last) {
+				dg.setBit(row, i)
+				dg.clearBit(row, last)
+			}
+		}
+	}
+
+	delete(dg.index, node)
+	dg.nodes = dg.nodes[:last]
+	dg.matrix = dg.matrix[:last]
+}
+
+// HasNode checks if a node exists in the graph.
+func (dg *DenseGraph[T]) HasNode(node T) bool {
+	_, exists := dg.index[node]
+	return exists
+}
+
+// HasEdge checks if an edge exists between two nodes in O(1).
+func (dg *DenseGraph[T]) HasEdge(from, to T) bool {
+	i, okFrom := dg.index[from]
+	j, okTo := dg.index[to]
+	return okFrom && okTo && dg.hasBit(i, j)
+}
+
+// GetNeighbors returns all neighbors of a node.
+func (dg *DenseGraph[T]) GetNeighbors(node T) []T {
+	i, exists := dg.index[node]
+	if !exists {
+		return []T{}
+	}
+
+	result := []T{}
+	for w, word := range dg.matrix[i] {
+		for word != 0 {
+			bit := bits.TrailingZeros64(word)
+			result = append(result, dg.nodes[w*wordBits+bit])
+			word &= word - 1
+		}
+	}
+	return result
+}
+
+// GetNodes returns all nodes in the graph.
+func (dg *DenseGraph[T]) GetNodes() []T {
+	result := make([]T, len(dg.nodes))
+	copy(result, dg.nodes)
+	return result
+}
+
+// NodeCount returns the number of nodes in the graph.
+func (dg *DenseGraph[T]) NodeCount() int {
+	return len(dg.nodes)
+}
+
+// EdgeCount returns the number of edges in the graph.
+func (dg *DenseGraph[T]) EdgeCount() int {
+	return dg.edges
+}
+
+// Degree returns the number of neighbors of a node (out-degree for directed graphs).
+func (dg *DenseGraph[T]) Degree(node T) int {
+	i, exists := dg.index[node]
+	if !exists {
+		return 0
+	}
+
+	count := 0
+	for _, word := range dg.matrix[i] {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+// IsDirected checks if the graph is directed.
+func (dg *DenseGraph[T]) IsDirected() bool {
+	return dg.directed
+}
+
+// IsEmpty checks if the graph is empty.
+func (dg *DenseGraph[T]) IsEmpty() bool {
+	return len(dg.nodes) == 0
+}
+
+// Clear removes all nodes and edges from the graph.
+func (dg *DenseGraph[T]) Clear() {
+	dg.index = make(map[T]int)
+	dg.nodes = nil
+	dg.matrix = nil
+	dg.edges = 0
+}
+
+// Clone creates a deep copy of the graph.
+func (dg *DenseGraph[T]) Clone() *DenseGraph[T] {
+	result := NewDenseGraph[T](dg.directed)
+	result.nodes = make([]T, len(dg.nodes))
+	copy(result.nodes, dg.nodes)
+	for node, i := range dg.index {
+		result.index[node] = i
+	}
+	result.matrix = make([][]uint64, len(dg.matrix))
+	for i, row := range dg.matrix {
+		result.matrix[i] = make([]uint64, len(row))
+		copy(result.matrix[i], row)
+	}
+	result.edges = dg.edges
+	return result
+}
+
+// recount recomputes the edge count from the matrix.
+func (dg *DenseGraph[T]) recount() {
+	total, loops := 0, 0
+	for i, row := range dg.matrix {
+		for _, word := range row {
+			total += bits.OnesCount64(word)
+		}
+		if dg.hasBit(i, i) {
+			loops++
+		}
+	}
+
+	if dg.directed {
+		dg.edges = total
+	} else {
+		dg.edges = (total + loops) / 2
+	}
+}
+
+// Complement returns a graph with the same nodes containing exactly the edges missing
+// from this graph, excluding self-loops.
+func (dg *DenseGraph[T]) Complement() *DenseGraph[T] {
+	result := dg.Clone()
+	n := len(dg.nodes)
+
+	for i, row := range result.matrix {
+		for w := range row {
+			row[w] = ^row[w]
+			// Mask off bits beyond the last node
+			switch valid := n - w*wordBits; {
+			case valid <= 0:
+				row[w] = 0
+			case valid < wordBits:
+				row[w] &= (1 << uint(valid)) - 1
+			}
+		}
+		result.clearBit(i, i)
+	}
+
+	result.recount()
+	return result
+}
+
+// TransitiveClosure returns a graph with an edge u -> v whenever v is reachable from u
+// by a path of one or more edges, computed with Warshall's algorithm on bit rows.
+func (dg *DenseGraph[T]) TransitiveClosure() *DenseGraph[T] {
+	result := dg.Clone()
+
+	for k := range result.nodes {
+		rowK := result.matrix[k]
+		for i, row := range result.matrix {
+			if !result.hasBit(i, k) {
+				continue
+			}
+			for w := range row {
+				row[w] |= rowK[w]
+			}
+		}
+	}
+
+	result.recount()
+	return result
+}
+
+// AdjacencyMatrix returns the 0/1 adjacency matrix of the graph together with the node
+// order of its rows and columns, for example to inspect the result of TransitiveClosure.
+func (dg *DenseGraph[T]) AdjacencyMatrix() (*Matrix[int], []T) {
+	n := len(dg.nodes)
+	matrix := NewMatrix[int](n, n)
+	for i := 0; i < n; i++ {
+		row := matrix.Row(i)
+		for j := range row {
+			if dg.hasBit(i, j) {
+				row[j] = 1
+			}
+		}
+	}
+	return matrix, dg.GetNodes()
+}
+
+// ToGraph converts the dense graph to an adjacency-list Graph.
+func (dg *DenseGraph[T]) ToGraph() *Graph[T] {
+	graph := NewGraph[T](dg.directed)
+	for _, node := range dg.nodes {
+		graph.AddNode(node)
+	}
+	for i, from := range dg.nodes {
+		for j, to := range dg.nodes {
+			if dg.hasBit(i, j) && (dg.directed || i <= j) {
+				graph.AddEdge(from, to)
+			}
+		}
+	}
+	return graph
+}
+
+// String returns a string representation of the graph.
+func (dg *DenseGraph[T]) String() string {
+	return fmt.Sprintf("DenseGraph{Directed: %v, Nodes: %d, Edges: %d}", dg.directed, dg.NodeCount(), dg.EdgeCount())
+}
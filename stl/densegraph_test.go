@@ -0,0 +1,118 @@
+package stl
+
+import (
+	"testing"
+)
+
+func TestDenseGraphBasicOperations(t *testing.T) {
+	dg := NewDenseGraph[string](false)
+	dg.AddEdge("A", "B")
+	dg.AddEdge("B", "C")
+	dg.AddEdge("B", "A")
+
+	if dg.NodeCount() != 3 || dg.EdgeCount() != 2 {
+		t.Errorf("Expected 3 nodes and 2 edges, got %d and %d", dg.NodeCount(), dg.EdgeCount())
+	}
+	if !dg.HasEdge("C", "B") {
+		t.Error("Undirected dense graph should have reverse edge")
+	}
+	if dg.Degree("B") != 2 {
+		t.Errorf("Expected degree 2 for B, got %d", dg.Degree("B"))
+	}
+
+	dg.RemoveEdge("A", "B")
+	if dg.HasEdge("B", "A") || dg.EdgeCount() != 1 {
+		t.Error("Edge A-B should be removed in both directions")
+	}
+
+	dg.RemoveNode("A")
+	if dg.HasNode("A") || !dg.HasEdge("B", "C") {
+		t.Error("Removing A should keep edge B-C")
+	}
+}
+
+func TestDenseGraphRemoveNodeManyNodes(t *testing.T) {
+	// More than one word per row exercises the column bookkeeping
+	dg := NewDenseGraph[int](true)
+	for i := 0; i < 130; i++ {
+		dg.AddEdge(i, (i+1)%130)
+	}
+	dg.AddEdge(129, 129)
+
+	dg.RemoveNode(5)
+	if dg.NodeCount() != 129 || dg.EdgeCount() != 129 {
+		t.Errorf("Expected 129 nodes and 129 edges, got %d and %d", dg.NodeCount(), dg.EdgeCount())
+	}
+	if !dg.HasEdge(129, 0) || !dg.HasEdge(129, 129) || !dg.HasEdge(100, 101) {
+		t.Error("Edges of the moved node should survive removal")
+	}
+	if dg.HasEdge(4, 5) || dg.HasEdge(5, 6) {
+		t.Error("Edges of the removed node should be gone")
+	}
+
+	dg.AddEdge(200, 0)
+	if !dg.HasEdge(200, 0) {
+		t.Error("Adding a node after removal should work")
+	}
+}
+
+func TestDenseGraphComplementAndClosure(t *testing.T) {
+	dg := NewDenseGraph[int](true)
+	dg.AddEdge(1, 2)
+	dg.AddEdge(2, 3)
+	dg.AddNode(4)
+
+	closure := dg.TransitiveClosure()
+	if !closure.HasEdge(1, 3) || closure.HasEdge(3, 1) {
+		t.Error("Closure should add 1 -> 3 only")
+	}
+	if closure.EdgeCount() != 3 {
+		t.Errorf("Expected 3 closure edges, got %d", closure.EdgeCount())
+	}
+
+	complement := dg.Complement()
+	if complement.HasEdge(1, 2) || complement.HasEdge(1, 1) {
+		t.Error("Complement should not contain existing edges or self-loops")
+	}
+	if !complement.HasEdge(2, 1) || !complement.HasEdge(4, 1) {
+		t.Error("Complement should contain missing edges")
+	}
+	if complement.EdgeCount() != 4*3-2 {
+		t.Errorf("Expected 10 complement edges, got %d", complement.EdgeCount())
+	}
+}
+
+func TestDenseGraphConversions(t *testing.T) {
+	graph := NewGraphFromEdges([][2]int{{1, 2}, {2, 3}}, false)
+	dg := NewDenseGraphFromGraph(graph)
+
+	if !dg.ToGraph().Equals(graph) {
+		t.Error("Converting to dense and back should preserve the graph")
+	}
+
+	var shared GraphInterface[int] = dg
+	if !shared.HasEdge(3, 2) {
+		t.Error("DenseGraph should work through GraphInterface")
+	}
+}
+
+func TestDenseGraphAdjacencyMatrix(t *testing.T) {
+	dg := NewDenseGraph[string](true)
+	dg.AddEdge("a", "b")
+	dg.AddEdge("b", "c")
+
+	matrix, nodes := dg.AdjacencyMatrix()
+	if matrix.Rows() != 3 || len(nodes) != 3 {
+		t.Fatalf("Expected a 3x3 matrix, got %dx%d", matrix.Rows(), matrix.Cols())
+	}
+
+	// Squaring the adjacency matrix counts walks of length two
+	squared, _ := matrix.Multiply(matrix)
+	index := map[string]int{}
+	for i, node := range nodes {
+		index[node] = i
+	}
+	if walks, _ := squared.Get(index["a"], index["c"]); walks != 1 {
+		t.Errorf("Expected one walk of length two from a to c, got %d", walks)
+	}
+}
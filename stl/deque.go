@@ -2,27 +2,31 @@ package stl
 
 import (
 	"fmt"
+	"iter"
 	"math"
 )
 
 // Deque represents a double-ended queue.
 type Deque[T any] struct {
-	data  []T
-	front int
-	back  int
-	size  int
+	data   []T
+	front  int
+	back   int
+	size   int
+	equals func(T, T) bool
 }
 
-// NewDeque creates a new empty deque with initial capacity.
-func NewDeque[T any](initialCapacity int) *Deque[T] {
+// NewDeque creates a new empty deque with initial capacity. It honors WithEquals to replace
+// the default comparison of elements with reflect.DeepEqual in Contains and Equals.
+func NewDeque[T any](initialCapacity int, opts ...Option) *Deque[T] {
 	if initialCapacity <= 0 {
 		initialCapacity = 16
 	}
 	return &Deque[T]{
-		data:  make([]T, initialCapacity),
-		front: 0,
-		back:  0,
-		size:  0,
+		data:   make([]T, initialCapacity),
+		front:  0,
+		back:   0,
+		size:   0,
+		equals: equalsOf[T](applyOptions(opts), nil),
 	}
 }
 
@@ -114,6 +118,26 @@ func (d *Deque[T]) PopBack() (T, bool) {
 	return element, true
 }
 
+// PopFrontE is like PopFront but returns ErrEmpty if the deque is empty.
+func (d *Deque[T]) PopFrontE() (T, error) {
+	return orErrEmpty(d.PopFront())
+}
+
+// MustPopFront is like PopFront but panics if the deque is empty.
+func (d *Deque[T]) MustPopFront() T {
+	return must(d.PopFrontE())
+}
+
+// PopBackE is like PopBack but returns ErrEmpty if the deque is empty.
+func (d *Deque[T]) PopBackE() (T, error) {
+	return orErrEmpty(d.PopBack())
+}
+
+// MustPopBack is like PopBack but panics if the deque is empty.
+func (d *Deque[T]) MustPopBack() T {
+	return must(d.PopBackE())
+}
+
 // Front returns the element at the front of the deque without removing it.
 func (d *Deque[T]) Front() (T, bool) {
 	if d.IsEmpty() {
@@ -214,11 +238,25 @@ func (d *Deque[T]) ShrinkToFit() {
 
 // ToSlice converts the deque to a slice.
 func (d *Deque[T]) ToSlice() []T {
-	result := make([]T, d.size)
+	return d.AppendTo(make([]T, 0, d.size))
+}
+
+// AppendTo appends the elements from front to back to dst and returns the extended slice.
+func (d *Deque[T]) AppendTo(dst []T) []T {
 	for i := 0; i < d.size; i++ {
-		result[i] = d.data[(d.front+i)%len(d.data)]
+		dst = append(dst, d.data[(d.front+i)%len(d.data)])
 	}
-	return result
+	return dst
+}
+
+// Contains checks if the deque contains an element.
+func (d *Deque[T]) Contains(item T) bool {
+	for i := 0; i < d.size; i++ {
+		if elementsEqual(d.equals, d.data[(d.front+i)%len(d.data)], item) {
+			return true
+		}
+	}
+	return false
 }
 
 // String returns a string representation of the deque.
@@ -233,6 +271,26 @@ func (d *Deque[T]) ForEach(fn func(T)) {
 	}
 }
 
+// All returns an iterator over the elements from front to back.
+func (d *Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.size; i++ {
+			if !yield(d.data[(d.front+i)%len(d.data)]) {
+				return
+			}
+		}
+	}
+}
+
+// Iterator returns a bidirectional iterator over the elements from front to back.
+func (d *Deque[T]) Iterator() BidirectionalIterator[T] {
+	return newIndexIterator(
+		func(i int) T { return d.data[(d.front+i)%len(d.data)] },
+		d.Size,
+		func(i int) { d.Remove(i) },
+	)
+}
+
 // ForEachIndex applies a function to each element and its index in the deque.
 func (d *Deque[T]) ForEachIndex(fn func(int, T)) {
 	for i := 0; i < d.size; i++ {
@@ -240,9 +298,55 @@ func (d *Deque[T]) ForEachIndex(fn func(int, T)) {
 	}
 }
 
+// Reduce folds the elements into a single value, starting from initial and visiting the
+// elements from front to back.
+func (d *Deque[T]) Reduce(initial T, fn func(T, T) T) T {
+	result := initial
+	d.ForEach(func(element T) {
+		result = fn(result, element)
+	})
+	return result
+}
+
+// CountIf returns the number of elements that satisfy the predicate.
+func (d *Deque[T]) CountIf(predicate func(T) bool) int {
+	count := 0
+	d.ForEach(func(element T) {
+		if predicate(element) {
+			count++
+		}
+	})
+	return count
+}
+
+// Min returns the smallest element according to less, or false if the deque is empty.
+func (d *Deque[T]) Min(less func(T, T) bool) (T, bool) {
+	var result T
+	found := false
+	d.ForEach(func(element T) {
+		if !found || less(element, result) {
+			result, found = element, true
+		}
+	})
+	return result, found
+}
+
+// Max returns the largest element according to less, or false if the deque is empty.
+func (d *Deque[T]) Max(less func(T, T) bool) (T, bool) {
+	var result T
+	found := false
+	d.ForEach(func(element T) {
+		if !found || less(result, element) {
+			result, found = element, true
+		}
+	})
+	return result, found
+}
+
 // Filter returns a new deque containing elements that satisfy the predicate.
 func (d *Deque[T]) Filter(predicate func(T) bool) *Deque[T] {
 	result := NewDeque[T](d.size)
+	result.equals = d.equals
 	for i := 0; i < d.size; i++ {
 		element := d.data[(d.front+i)%len(d.data)]
 		if predicate(element) {
@@ -263,8 +367,8 @@ func (d *Deque[T]) Any(predicate func(T) bool) bool {
 	return false
 }
 
-// All returns true if all elements satisfy the predicate.
-func (d *Deque[T]) All(predicate func(T) bool) bool {
+// Every returns true if all elements satisfy the predicate.
+func (d *Deque[T]) Every(predicate func(T) bool) bool {
 	for i := 0; i < d.size; i++ {
 		element := d.data[(d.front+i)%len(d.data)]
 		if !predicate(element) {
@@ -277,12 +381,23 @@ func (d *Deque[T]) All(predicate func(T) bool) bool {
 // Clone creates a deep copy of the deque.
 func (d *Deque[T]) Clone() *Deque[T] {
 	result := NewDeque[T](d.size)
+	result.equals = d.equals
 	for i := 0; i < d.size; i++ {
 		result.PushBack(d.data[(d.front+i)%len(d.data)])
 	}
 	return result
 }
 
+// CloneWith creates a copy of the deque whose elements are copied by cloneElem.
+func (d *Deque[T]) CloneWith(cloneElem func(T) T) *Deque[T] {
+	result := NewDeque[T](d.size)
+	result.equals = d.equals
+	for i := 0; i < d.size; i++ {
+		result.PushBack(cloneElem(d.data[(d.front+i)%len(d.data)]))
+	}
+	return result
+}
+
 // Equals checks if two deques contain the same elements in the same order.
 func (d *Deque[T]) Equals(other *Deque[T]) bool {
 	if d.size != other.size {
@@ -292,7 +407,7 @@ func (d *Deque[T]) Equals(other *Deque[T]) bool {
 	for i := 0; i < d.size; i++ {
 		element1 := d.data[(d.front+i)%len(d.data)]
 		element2 := other.data[(other.front+i)%len(other.data)]
-		if fmt.Sprintf("%v", element1) != fmt.Sprintf("%v", element2) {
+		if !elementsEqual(d.equals, element1, element2) {
 			return false
 		}
 	}
@@ -167,3 +167,37 @@ func TestDequeFromSlice(t *testing.T) {
 func TestDequeContains(t *testing.T) {
 	t.Skip("Contains method not implemented yet")
 }
+
+func TestDequeAll(t *testing.T) {
+	deque := NewDeque[int](2)
+	deque.PushBack(2)
+	deque.PushBack(3)
+	deque.PushFront(1)
+
+	var got []int
+	for x := range deque.All() {
+		got = append(got, x)
+	}
+	if len(got) != 3 || got[0] != 1 || got[2] != 3 {
+		t.Errorf("Expected [1 2 3], got %v", got)
+	}
+	if !deque.Every(func(x int) bool { return x > 0 }) {
+		t.Error("Expected every element to be positive")
+	}
+}
+
+func TestDequeAggregations(t *testing.T) {
+	deque := NewDequeFromSlice([]int{5, 7, 6})
+	if smallest, ok := deque.Min(lessInt); !ok || smallest != 5 {
+		t.Errorf("Expected min 5, got %d", smallest)
+	}
+	if largest, ok := deque.Max(lessInt); !ok || largest != 7 {
+		t.Errorf("Expected max 7, got %d", largest)
+	}
+	if product := deque.Reduce(1, func(a, b int) int { return a * b }); product != 210 {
+		t.Errorf("Expected product 210, got %d", product)
+	}
+	if count := deque.CountIf(func(x int) bool { return x > 5 }); count != 2 {
+		t.Errorf("Expected 2, got %d", count)
+	}
+}
@@ -0,0 +1,177 @@
+package stl
+
+import (
+	"fmt"
+)
+
+// DisjointSet represents a union-find structure over elements of type T.
+// It uses union by rank and path compression, giving near-constant amortized operations.
+type DisjointSet[T comparable] struct {
+	parent map[T]T
+	rank   map[T]int
+	size   map[T]int
+	count  int
+}
+
+// NewDisjointSet creates a new empty disjoint set.
+func NewDisjointSet[T comparable]() *DisjointSet[T] {
+	return &DisjointSet[T]{
+		parent: make(map[T]T),
+		rank:   make(map[T]int),
+		size:   make(map[T]int),
+	}
+}
+
+// NewDisjointSetFromSlice creates a disjoint set with each element in its own set.
+func NewDisjointSetFromSlice[T comparable](slice []T) *DisjointSet[T] {
+	ds := NewDisjointSet[T]()
+	for _, item := range slice {
+		ds.MakeSet(item)
+	}
+	return ds
+}
+
+// MakeSet adds an element as a singleton set. It does nothing if the element already exists.
+func (ds *DisjointSet[T]) MakeSet(element T) {
+	if _, exists := ds.parent[element]; exists {
+		return
+	}
+	ds.parent[element] = element
+	ds.rank[element] = 0
+	ds.size[element] = 1
+	ds.count++
+}
+
+// Contains checks if an element has been added to the disjoint set.
+func (ds *DisjointSet[T]) Contains(element T) bool {
+	_, exists := ds.parent[element]
+	return exists
+}
+
+// Find returns the representative of the set containing the element.
+func (ds *DisjointSet[T]) Find(element T) (T, bool) {
+	if !ds.Contains(element) {
+		var zero T
+		return zero, false
+	}
+	return ds.find(element), true
+}
+
+// find returns the root of an existing element, compressing the path along the way.
+func (ds *DisjointSet[T]) find(element T) T {
+	root := element
+	for ds.parent[root] != root {
+		root = ds.parent[root]
+	}
+
+	// Path compression
+	for element != root {
+		next := ds.parent[element]
+		ds.parent[element] = root
+		element = next
+	}
+
+	return root
+}
+
+// Union merges the sets containing a and b, adding either element if it is missing.
+// It returns true if the sets were previously disjoint.
+func (ds *DisjointSet[T]) Union(a, b T) bool {
+	ds.MakeSet(a)
+	ds.MakeSet(b)
+
+	rootA := ds.find(a)
+	rootB := ds.find(b)
+	if rootA == rootB {
+		return false
+	}
+
+	// Union by rank
+	if ds.rank[rootA] < ds.rank[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	ds.parent[rootB] = rootA
+	ds.size[rootA] += ds.size[rootB]
+	delete(ds.size, rootB)
+	if ds.rank[rootA] == ds.rank[rootB] {
+		ds.rank[rootA]++
+	}
+	ds.count--
+
+	return true
+}
+
+// Connected checks if two elements belong to the same set.
+func (ds *DisjointSet[T]) Connected(a, b T) bool {
+	if !ds.Contains(a) || !ds.Contains(b) {
+		return false
+	}
+	return ds.find(a) == ds.find(b)
+}
+
+// SetSize returns the size of the set containing the element.
+func (ds *DisjointSet[T]) SetSize(element T) int {
+	if !ds.Contains(element) {
+		return 0
+	}
+	return ds.size[ds.find(element)]
+}
+
+// Size returns the total number of elements.
+func (ds *DisjointSet[T]) Size() int {
+	return len(ds.parent)
+}
+
+// SetCount returns the number of disjoint sets.
+func (ds *DisjointSet[T]) SetCount() int {
+	return ds.count
+}
+
+// IsEmpty checks if the disjoint set has no elements.
+func (ds *DisjointSet[T]) IsEmpty() bool {
+	return len(ds.parent) == 0
+}
+
+// Clear removes all elements.
+func (ds *DisjointSet[T]) Clear() {
+	ds.parent = make(map[T]T)
+	ds.rank = make(map[T]int)
+	ds.size = make(map[T]int)
+	ds.count = 0
+}
+
+// Sets returns all disjoint sets as slices of elements.
+func (ds *DisjointSet[T]) Sets() [][]T {
+	groups := make(map[T][]T, ds.count)
+	for element := range ds.parent {
+		root := ds.find(element)
+		groups[root] = append(groups[root], element)
+	}
+
+	result := make([][]T, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, group)
+	}
+	return result
+}
+
+// Clone creates a copy of the disjoint set.
+func (ds *DisjointSet[T]) Clone() *DisjointSet[T] {
+	result := NewDisjointSet[T]()
+	for element, parent := range ds.parent {
+		result.parent[element] = parent
+	}
+	for element, rank := range ds.rank {
+		result.rank[element] = rank
+	}
+	for element, size := range ds.size {
+		result.size[element] = size
+	}
+	result.count = ds.count
+	return result
+}
+
+// String returns a string representation of the disjoint set.
+func (ds *DisjointSet[T]) String() string {
+	return fmt.Sprintf("DisjointSet%v", ds.Sets())
+}
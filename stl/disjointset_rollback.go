@@ -0,0 +1,199 @@
+package stl
+
+import (
+	"fmt"
+)
+
+// rollbackEntry records one change to a RollbackDisjointSet so it can be undone.
+type rollbackEntry[T comparable] struct {
+	child      T    // element that was added, or root that was attached below another
+	root       T    // new root of child when merged
+	merged     bool // false when the entry records a MakeSet
+	rankRaised bool // whether the merge increased the rank of root
+}
+
+// RollbackDisjointSet represents a union-find structure whose changes can be undone in LIFO
+// order, as needed by offline dynamic-connectivity algorithms. It uses union by rank without
+// path compression, so Find is O(log n) and every change is a constant-size log entry.
+type RollbackDisjointSet[T comparable] struct {
+	parent map[T]T
+	rank   map[T]int
+	size   map[T]int
+	count  int
+	log    []rollbackEntry[T]
+}
+
+// NewRollbackDisjointSet creates a new empty rollback disjoint set.
+func NewRollbackDisjointSet[T comparable]() *RollbackDisjointSet[T] {
+	return &RollbackDisjointSet[T]{
+		parent: make(map[T]T),
+		rank:   make(map[T]int),
+		size:   make(map[T]int),
+	}
+}
+
+// MakeSet adds an element as a singleton set. It does nothing if the element already exists.
+func (ds *RollbackDisjointSet[T]) MakeSet(element T) {
+	if _, exists := ds.parent[element]; exists {
+		return
+	}
+	ds.parent[element] = element
+	ds.rank[element] = 0
+	ds.size[element] = 1
+	ds.count++
+	ds.log = append(ds.log, rollbackEntry[T]{child: element})
+}
+
+// Contains checks if an element has been added to the disjoint set.
+func (ds *RollbackDisjointSet[T]) Contains(element T) bool {
+	_, exists := ds.parent[element]
+	return exists
+}
+
+// Find returns the representative of the set containing the element.
+func (ds *RollbackDisjointSet[T]) Find(element T) (T, bool) {
+	if !ds.Contains(element) {
+		var zero T
+		return zero, false
+	}
+	return ds.find(element), true
+}
+
+// find returns the root of an existing element without modifying the structure.
+func (ds *RollbackDisjointSet[T]) find(element T) T {
+	for ds.parent[element] != element {
+		element = ds.parent[element]
+	}
+	return element
+}
+
+// Union merges the sets containing a and b, adding either element if it is missing.
+// It returns true if the sets were previously disjoint.
+func (ds *RollbackDisjointSet[T]) Union(a, b T) bool {
+	ds.MakeSet(a)
+	ds.MakeSet(b)
+
+	rootA := ds.find(a)
+	rootB := ds.find(b)
+	if rootA == rootB {
+		return false
+	}
+
+	// Union by rank
+	if ds.rank[rootA] < ds.rank[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	entry := rollbackEntry[T]{child: rootB, root: rootA, merged: true}
+	ds.parent[rootB] = rootA
+	ds.size[rootA] += ds.size[rootB]
+	if ds.rank[rootA] == ds.rank[rootB] {
+		ds.rank[rootA]++
+		entry.rankRaised = true
+	}
+	ds.count--
+	ds.log = append(ds.log, entry)
+
+	return true
+}
+
+// Connected checks if two elements belong to the same set.
+func (ds *RollbackDisjointSet[T]) Connected(a, b T) bool {
+	if !ds.Contains(a) || !ds.Contains(b) {
+		return false
+	}
+	return ds.find(a) == ds.find(b)
+}
+
+// SetSize returns the size of the set containing the element.
+func (ds *RollbackDisjointSet[T]) SetSize(element T) int {
+	if !ds.Contains(element) {
+		return 0
+	}
+	return ds.size[ds.find(element)]
+}
+
+// Checkpoint returns a marker for the current state that can later be passed to Rollback.
+func (ds *RollbackDisjointSet[T]) Checkpoint() int {
+	return len(ds.log)
+}
+
+// Rollback undoes every MakeSet and Union made since the given checkpoint, most recent
+// first. It returns false if the checkpoint is not reachable from the current state.
+func (ds *RollbackDisjointSet[T]) Rollback(checkpoint int) bool {
+	if checkpoint < 0 || checkpoint > len(ds.log) {
+		return false
+	}
+
+	for len(ds.log) > checkpoint {
+		entry := ds.log[len(ds.log)-1]
+		ds.log = ds.log[:len(ds.log)-1]
+
+		if !entry.merged {
+			delete(ds.parent, entry.child)
+			delete(ds.rank, entry.child)
+			delete(ds.size, entry.child)
+			ds.count--
+			continue
+		}
+
+		ds.parent[entry.child] = entry.child
+		ds.size[entry.root] -= ds.size[entry.child]
+		if entry.rankRaised {
+			ds.rank[entry.root]--
+		}
+		ds.count++
+	}
+	return true
+}
+
+// Undo reverts the most recent MakeSet or merging Union and reports whether there was one.
+func (ds *RollbackDisjointSet[T]) Undo() bool {
+	if len(ds.log) == 0 {
+		return false
+	}
+	return ds.Rollback(len(ds.log) - 1)
+}
+
+// Size returns the total number of elements.
+func (ds *RollbackDisjointSet[T]) Size() int {
+	return len(ds.parent)
+}
+
+// SetCount returns the number of disjoint sets.
+func (ds *RollbackDisjointSet[T]) SetCount() int {
+	return ds.count
+}
+
+// IsEmpty checks if the disjoint set has no elements.
+func (ds *RollbackDisjointSet[T]) IsEmpty() bool {
+	return len(ds.parent) == 0
+}
+
+// Clear removes all elements and discards the operation log.
+func (ds *RollbackDisjointSet[T]) Clear() {
+	ds.parent = make(map[T]T)
+	ds.rank = make(map[T]int)
+	ds.size = make(map[T]int)
+	ds.count = 0
+	ds.log = nil
+}
+
+// Sets returns all disjoint sets as slices of elements.
+func (ds *RollbackDisjointSet[T]) Sets() [][]T {
+	groups := make(map[T][]T, ds.count)
+	for element := range ds.parent {
+		root := ds.find(element)
+		groups[root] = append(groups[root], element)
+	}
+
+	result := make([][]T, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, group)
+	}
+	return result
+}
+
+// String returns a string representation of the disjoint set.
+func (ds *RollbackDisjointSet[T]) String() string {
+	return fmt.Sprintf("RollbackDisjointSet%v", ds.Sets())
+}
@@ -0,0 +1,63 @@
+package stl
+
+import (
+	"testing"
+)
+
+func TestRollbackDisjointSetRollback(t *testing.T) {
+	ds := NewRollbackDisjointSet[int]()
+	for i := 1; i <= 5; i++ {
+		ds.MakeSet(i)
+	}
+	ds.Union(1, 2)
+
+	checkpoint := ds.Checkpoint()
+	ds.Union(3, 4)
+	ds.Union(2, 4)
+	ds.Union(5, 6) // adds 6
+
+	if !ds.Connected(1, 3) || ds.SetCount() != 2 || ds.Size() != 6 {
+		t.Errorf("Unexpected state before rollback: %d sets of %d elements", ds.SetCount(), ds.Size())
+	}
+	if ds.SetSize(4) != 4 {
+		t.Errorf("Expected set size 4, got %d", ds.SetSize(4))
+	}
+
+	if !ds.Rollback(checkpoint) {
+		t.Fatal("Expected rollback to succeed")
+	}
+	if ds.Contains(6) || ds.Size() != 5 {
+		t.Error("Rollback should remove elements added after the checkpoint")
+	}
+	if !ds.Connected(1, 2) || ds.Connected(3, 4) || ds.Connected(1, 3) {
+		t.Error("Rollback should restore the connectivity at the checkpoint")
+	}
+	if ds.SetCount() != 4 || ds.SetSize(1) != 2 || ds.SetSize(3) != 1 {
+		t.Errorf("Unexpected counts after rollback: %d sets", ds.SetCount())
+	}
+
+	if ds.Rollback(checkpoint + 1) {
+		t.Error("Rollback to a discarded checkpoint should fail")
+	}
+}
+
+func TestRollbackDisjointSetUndo(t *testing.T) {
+	ds := NewRollbackDisjointSet[string]()
+	ds.Union("a", "b")
+	if ds.Union("b", "a") {
+		t.Error("Union of already joined elements should return false")
+	}
+
+	if !ds.Undo() {
+		t.Fatal("Expected Undo to revert the union")
+	}
+	if ds.Connected("a", "b") || ds.SetCount() != 2 {
+		t.Error("Undo should split the merged set")
+	}
+
+	for ds.Undo() {
+	}
+	if !ds.IsEmpty() || ds.SetCount() != 0 {
+		t.Error("Undoing every change should leave an empty set")
+	}
+}
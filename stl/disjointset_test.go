@@ -0,0 +1,87 @@
+package stl
+
+import (
+	"testing"
+)
+
+func TestDisjointSetBasicOperations(t *testing.T) {
+	ds := NewDisjointSetFromSlice([]int{1, 2, 3, 4, 5})
+
+	if ds.Size() != 5 || ds.SetCount() != 5 {
+		t.Errorf("Expected 5 elements in 5 sets, got %d in %d", ds.Size(), ds.SetCount())
+	}
+
+	if !ds.Union(1, 2) {
+		t.Error("Union of disjoint sets should return true")
+	}
+	if !ds.Union(3, 4) {
+		t.Error("Union of disjoint sets should return true")
+	}
+	if ds.Union(2, 1) {
+		t.Error("Union of already joined elements should return false")
+	}
+
+	if !ds.Connected(1, 2) || !ds.Connected(3, 4) {
+		t.Error("Joined elements should be connected")
+	}
+	if ds.Connected(1, 3) {
+		t.Error("1 and 3 should not be connected")
+	}
+	if ds.SetCount() != 3 {
+		t.Errorf("Expected 3 sets, got %d", ds.SetCount())
+	}
+
+	ds.Union(2, 4)
+	if !ds.Connected(1, 3) {
+		t.Error("1 and 3 should be connected after joining their sets")
+	}
+	if ds.SetSize(1) != 4 {
+		t.Errorf("Expected set size 4, got %d", ds.SetSize(1))
+	}
+
+	root1, _ := ds.Find(1)
+	root4, _ := ds.Find(4)
+	if root1 != root4 {
+		t.Errorf("Expected same representative, got %v and %v", root1, root4)
+	}
+
+	if _, ok := ds.Find(42); ok {
+		t.Error("Find should fail for missing element")
+	}
+}
+
+func TestDisjointSetUnionAddsMissing(t *testing.T) {
+	ds := NewDisjointSet[string]()
+	ds.Union("a", "b")
+
+	if !ds.Contains("a") || !ds.Contains("b") {
+		t.Error("Union should add missing elements")
+	}
+	if ds.Size() != 2 || ds.SetCount() != 1 {
+		t.Errorf("Expected 2 elements in 1 set, got %d in %d", ds.Size(), ds.SetCount())
+	}
+}
+
+func TestDisjointSetSetsAndClone(t *testing.T) {
+	ds := NewDisjointSetFromSlice([]int{1, 2, 3, 4})
+	ds.Union(1, 2)
+
+	sets := ds.Sets()
+	if len(sets) != 3 {
+		t.Errorf("Expected 3 sets, got %v", sets)
+	}
+
+	clone := ds.Clone()
+	clone.Union(3, 4)
+	if ds.Connected(3, 4) {
+		t.Error("Modifying clone should not affect original")
+	}
+	if !clone.Connected(1, 2) {
+		t.Error("Clone should preserve existing unions")
+	}
+
+	ds.Clear()
+	if !ds.IsEmpty() || ds.SetCount() != 0 {
+		t.Error("Disjoint set should be empty after clear")
+	}
+}
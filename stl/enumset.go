@@ -0,0 +1,155 @@
+package stl
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// EnumSetCapacity is the number of distinct values an EnumSet can hold: 0 through 127.
+const EnumSetCapacity = 128
+
+// EnumSet represents a set over a small integer domain, such as an enum type, stored in two
+// machine words. Every operation is a handful of bit instructions and never allocates except
+// when returning a new set. Values outside [0, EnumSetCapacity) are never members.
+// The zero value is an empty set ready to use.
+type EnumSet[T ~int] struct {
+	bits [2]uint64
+}
+
+// NewEnumSet creates a new empty enum set.
+func NewEnumSet[T ~int]() *EnumSet[T] {
+	return &EnumSet[T]{}
+}
+
+// NewEnumSetFromSlice creates an enum set from a slice, ignoring out-of-range values.
+func NewEnumSetFromSlice[T ~int](slice []T) *EnumSet[T] {
+	s := NewEnumSet[T]()
+	for _, item := range slice {
+		s.Add(item)
+	}
+	return s
+}
+
+// enumBit returns the word and mask for value, or false if it is out of range.
+func enumBit[T ~int](value T) (int, uint64, bool) {
+	if value < 0 || int(value) >= EnumSetCapacity {
+		return 0, 0, false
+	}
+	return int(value) / 64, 1 << (uint(value) % 64), true
+}
+
+// Add adds a value to the set. Out-of-range values are ignored.
+func (s *EnumSet[T]) Add(value T) {
+	if w, mask, ok := enumBit(value); ok {
+		s.bits[w] |= mask
+	}
+}
+
+// Remove removes a value from the set.
+func (s *EnumSet[T]) Remove(value T) {
+	if w, mask, ok := enumBit(value); ok {
+		s.bits[w] &^= mask
+	}
+}
+
+// Contains checks if a value is in the set.
+func (s *EnumSet[T]) Contains(value T) bool {
+	w, mask, ok := enumBit(value)
+	return ok && s.bits[w]&mask != 0
+}
+
+// Size returns the number of values in the set.
+func (s *EnumSet[T]) Size() int {
+	return bits.OnesCount64(s.bits[0]) + bits.OnesCount64(s.bits[1])
+}
+
+// IsEmpty checks if the set is empty.
+func (s *EnumSet[T]) IsEmpty() bool {
+	return s.bits[0]|s.bits[1] == 0
+}
+
+// Clear removes all values from the set.
+func (s *EnumSet[T]) Clear() {
+	s.bits = [2]uint64{}
+}
+
+// ForEach applies a function to each value in ascending order.
+func (s *EnumSet[T]) ForEach(fn func(T)) {
+	for w, word := range s.bits {
+		for word != 0 {
+			fn(T(w*64 + bits.TrailingZeros64(word)))
+			word &= word - 1
+		}
+	}
+}
+
+// ToSlice returns the values in ascending order.
+func (s *EnumSet[T]) ToSlice() []T {
+	result := make([]T, 0, s.Size())
+	s.ForEach(func(value T) {
+		result = append(result, value)
+	})
+	return result
+}
+
+// Filter returns a new set with the values satisfying the predicate.
+func (s *EnumSet[T]) Filter(predicate func(T) bool) *EnumSet[T] {
+	result := NewEnumSet[T]()
+	s.ForEach(func(value T) {
+		if predicate(value) {
+			result.Add(value)
+		}
+	})
+	return result
+}
+
+// Union returns a new set containing the values of both sets.
+func (s *EnumSet[T]) Union(other *EnumSet[T]) *EnumSet[T] {
+	return &EnumSet[T]{bits: [2]uint64{s.bits[0] | other.bits[0], s.bits[1] | other.bits[1]}}
+}
+
+// Intersection returns a new set containing the values present in both sets.
+func (s *EnumSet[T]) Intersection(other *EnumSet[T]) *EnumSet[T] {
+	return &EnumSet[T]{bits: [2]uint64{s.bits[0] & other.bits[0], s.bits[1] & other.bits[1]}}
+}
+
+// Difference returns a new set containing the values in s but not in other.
+func (s *EnumSet[T]) Difference(other *EnumSet[T]) *EnumSet[T] {
+	return &EnumSet[T]{bits: [2]uint64{s.bits[0] &^ other.bits[0], s.bits[1] &^ other.bits[1]}}
+}
+
+// SymmetricDifference returns a new set containing the values in either set but not both.
+func (s *EnumSet[T]) SymmetricDifference(other *EnumSet[T]) *EnumSet[T] {
+	return &EnumSet[T]{bits: [2]uint64{s.bits[0] ^ other.bits[0], s.bits[1] ^ other.bits[1]}}
+}
+
+// IsSubset checks if s is a subset of other.
+func (s *EnumSet[T]) IsSubset(other *EnumSet[T]) bool {
+	return s.bits[0]&^other.bits[0] == 0 && s.bits[1]&^other.bits[1] == 0
+}
+
+// IsSuperset checks if s is a superset of other.
+func (s *EnumSet[T]) IsSuperset(other *EnumSet[T]) bool {
+	return other.IsSubset(s)
+}
+
+// IsDisjoint checks if s and other have no values in common.
+func (s *EnumSet[T]) IsDisjoint(other *EnumSet[T]) bool {
+	return s.bits[0]&other.bits[0] == 0 && s.bits[1]&other.bits[1] == 0
+}
+
+// Equals checks if two sets contain the same values.
+func (s *EnumSet[T]) Equals(other *EnumSet[T]) bool {
+	return s.bits == other.bits
+}
+
+// Clone creates a copy of the set.
+func (s *EnumSet[T]) Clone() *EnumSet[T] {
+	clone := *s
+	return &clone
+}
+
+// String returns a string representation of the set.
+func (s *EnumSet[T]) String() string {
+	return fmt.Sprintf("EnumSet%v", s.ToSlice())
+}
@@ -0,0 +1,77 @@
+package stl
+
+import (
+	"testing"
+)
+
+type testWeekday int
+
+const (
+	testMonday testWeekday = iota
+	testTuesday
+	testWednesday
+	testThursday
+	testFriday
+	testSaturday
+	testSunday
+)
+
+func TestEnumSetBasicOperations(t *testing.T) {
+	var s EnumSet[testWeekday]
+	s.Add(testFriday)
+	s.Add(testMonday)
+	s.Add(testFriday)
+	s.Add(testWeekday(100))
+	s.Add(testWeekday(-1))
+	s.Add(testWeekday(EnumSetCapacity))
+
+	if s.Size() != 3 || s.String() != "EnumSet[0 4 100]" {
+		t.Errorf("Unexpected set %s", s.String())
+	}
+	if !s.Contains(testMonday) || s.Contains(testSunday) || s.Contains(testWeekday(-1)) {
+		t.Error("Contains returned an unexpected result")
+	}
+
+	s.Remove(testWeekday(100))
+	if s.Size() != 2 {
+		t.Errorf("Expected size 2, got %d", s.Size())
+	}
+
+	weekend := s.Filter(func(day testWeekday) bool { return day >= testSaturday })
+	if !weekend.IsEmpty() {
+		t.Errorf("Expected no weekend days, got %s", weekend.String())
+	}
+
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Error("Set should be empty after Clear")
+	}
+}
+
+func TestEnumSetAlgebra(t *testing.T) {
+	weekdays := NewEnumSetFromSlice([]testWeekday{testMonday, testTuesday, testWednesday, testThursday, testFriday})
+	meetings := NewEnumSetFromSlice([]testWeekday{testTuesday, testThursday, testSaturday})
+
+	if union := weekdays.Union(meetings); union.Size() != 6 {
+		t.Errorf("Expected 6 days, got %s", union.String())
+	}
+	if inter := weekdays.Intersection(meetings); inter.String() != "EnumSet[1 3]" {
+		t.Errorf("Unexpected intersection %s", inter.String())
+	}
+	if diff := meetings.Difference(weekdays); diff.String() != "EnumSet[5]" {
+		t.Errorf("Unexpected difference %s", diff.String())
+	}
+	if sym := weekdays.SymmetricDifference(meetings); sym.Size() != 4 {
+		t.Errorf("Unexpected symmetric difference %s", sym.String())
+	}
+
+	if !weekdays.Intersection(meetings).IsSubset(weekdays) || meetings.IsSubset(weekdays) {
+		t.Error("IsSubset returned an unexpected result")
+	}
+	if !weekdays.IsSuperset(weekdays.Clone()) || !weekdays.Equals(weekdays.Clone()) {
+		t.Error("Clone should equal the original")
+	}
+	if weekdays.IsDisjoint(meetings) || !weekdays.IsDisjoint(NewEnumSetFromSlice([]testWeekday{testSunday})) {
+		t.Error("IsDisjoint returned an unexpected result")
+	}
+}
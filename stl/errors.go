@@ -0,0 +1,23 @@
+package stl
+
+import "errors"
+
+// ErrEmpty is returned by the error-returning variants of operations such as Stack.PopE
+// when the container is empty.
+var ErrEmpty = errors.New("stl: container is empty")
+
+// orErrEmpty converts the (value, bool) result of an operation to a (value, error) result.
+func orErrEmpty[T any](value T, ok bool) (T, error) {
+	if !ok {
+		return value, ErrEmpty
+	}
+	return value, nil
+}
+
+// must returns value, or panics with err if it is not nil.
+func must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
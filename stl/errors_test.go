@@ -0,0 +1,52 @@
+package stl
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrorReturningVariants(t *testing.T) {
+	stack := NewStack[int]()
+	queue := NewQueue[int]()
+	deque := NewDeque[int](0)
+	pq := NewMinPriorityQueue[int]()
+
+	for name, op := range map[string]func() (int, error){
+		"Stack.PopE":             stack.PopE,
+		"Stack.PeekE":            stack.PeekE,
+		"Queue.DequeueE":         queue.DequeueE,
+		"Queue.PeekE":            queue.PeekE,
+		"Deque.PopFrontE":        deque.PopFrontE,
+		"Deque.PopBackE":         deque.PopBackE,
+		"PriorityQueue.DequeueE": pq.DequeueE,
+		"PriorityQueue.PeekE":    pq.PeekE,
+	} {
+		if _, err := op(); !errors.Is(err, ErrEmpty) {
+			t.Errorf("%s: expected ErrEmpty on an empty container, got %v", name, err)
+		}
+	}
+
+	stack.Push(1)
+	queue.Enqueue(2)
+	deque.PushBack(3)
+	pq.Enqueue(4)
+	if value, err := stack.PopE(); err != nil || value != 1 {
+		t.Errorf("Expected 1 from PopE, got %d (%v)", value, err)
+	}
+	if value, err := queue.PeekE(); err != nil || value != 2 {
+		t.Errorf("Expected 2 from PeekE, got %d (%v)", value, err)
+	}
+	if deque.MustPopBack() != 3 || pq.MustDequeue() != 4 || queue.MustDequeue() != 2 {
+		t.Error("Expected Must variants to return the element")
+	}
+}
+
+func TestMustPanicsWhenEmpty(t *testing.T) {
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, ErrEmpty) {
+			t.Errorf("Expected a panic with ErrEmpty, got %v", err)
+		}
+	}()
+	NewStack[int]().MustPop()
+}
@@ -0,0 +1,183 @@
+package stl
+
+import (
+	"fmt"
+)
+
+// ForwardElement is a node of a ForwardList.
+type ForwardElement[T any] struct {
+	Value T
+	next  *ForwardElement[T]
+}
+
+// Next returns the next element or nil.
+func (e *ForwardElement[T]) Next() *ForwardElement[T] {
+	return e.next
+}
+
+// ForwardList represents a singly linked list. Each node carries a single link, making it
+// lighter than LinkedList; insertion and removal work after a known element. Elements passed
+// to InsertAfter and RemoveAfter must belong to the list.
+type ForwardList[T any] struct {
+	head   *ForwardElement[T]
+	size   int
+	equals func(T, T) bool
+}
+
+// NewForwardList creates a new empty forward list. It honors WithEquals to replace the
+// default comparison of values with reflect.DeepEqual in Contains.
+func NewForwardList[T any](opts ...Option) *ForwardList[T] {
+	return &ForwardList[T]{equals: equalsOf[T](applyOptions(opts), nil)}
+}
+
+// NewForwardListFromSlice creates a forward list holding the slice elements in order.
+func NewForwardListFromSlice[T any](slice []T) *ForwardList[T] {
+	fl := NewForwardList[T]()
+	for i := len(slice) - 1; i >= 0; i-- {
+		fl.PushFront(slice[i])
+	}
+	return fl
+}
+
+// Front returns the first element or nil if the list is empty.
+func (fl *ForwardList[T]) Front() *ForwardElement[T] {
+	return fl.head
+}
+
+// PushFront adds a value at the front and returns its element.
+func (fl *ForwardList[T]) PushFront(value T) *ForwardElement[T] {
+	fl.head = &ForwardElement[T]{Value: value, next: fl.head}
+	fl.size++
+	return fl.head
+}
+
+// PopFront removes and returns the first value.
+func (fl *ForwardList[T]) PopFront() (T, bool) {
+	if fl.head == nil {
+		var zero T
+		return zero, false
+	}
+	e := fl.head
+	fl.head = e.next
+	e.next = nil
+	fl.size--
+	return e.Value, true
+}
+
+// InsertAfter adds a value just after mark and returns its element.
+func (fl *ForwardList[T]) InsertAfter(value T, mark *ForwardElement[T]) *ForwardElement[T] {
+	mark.next = &ForwardElement[T]{Value: value, next: mark.next}
+	fl.size++
+	return mark.next
+}
+
+// RemoveAfter removes and returns the value following mark.
+func (fl *ForwardList[T]) RemoveAfter(mark *ForwardElement[T]) (T, bool) {
+	e := mark.next
+	if e == nil {
+		var zero T
+		return zero, false
+	}
+	mark.next = e.next
+	e.next = nil
+	fl.size--
+	return e.Value, true
+}
+
+// RemoveIf removes every value satisfying the predicate and returns how many were removed.
+func (fl *ForwardList[T]) RemoveIf(predicate func(T) bool) int {
+	removed := 0
+	link := &fl.head
+	for *link != nil {
+		if predicate((*link).Value) {
+			*link = (*link).next
+			removed++
+		} else {
+			link = &(*link).next
+		}
+	}
+	fl.size -= removed
+	return removed
+}
+
+// Size returns the number of elements in the list.
+func (fl *ForwardList[T]) Size() int {
+	return fl.size
+}
+
+// IsEmpty checks if the list is empty.
+func (fl *ForwardList[T]) IsEmpty() bool {
+	return fl.head == nil
+}
+
+// Clear removes all elements from the list.
+func (fl *ForwardList[T]) Clear() {
+	fl.head = nil
+	fl.size = 0
+}
+
+// Reverse reverses the order of the elements in place.
+func (fl *ForwardList[T]) Reverse() {
+	var previous *ForwardElement[T]
+	for current := fl.head; current != nil; {
+		next := current.next
+		current.next = previous
+		previous, current = current, next
+	}
+	fl.head = previous
+}
+
+// Contains checks if the list contains a value.
+func (fl *ForwardList[T]) Contains(value T) bool {
+	for e := fl.head; e != nil; e = e.next {
+		if elementsEqual(fl.equals, e.Value, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// ToSlice returns the values from front to back.
+func (fl *ForwardList[T]) ToSlice() []T {
+	return fl.AppendTo(make([]T, 0, fl.size))
+}
+
+// AppendTo appends the values from front to back to dst and returns the extended slice.
+func (fl *ForwardList[T]) AppendTo(dst []T) []T {
+	for e := fl.head; e != nil; e = e.next {
+		dst = append(dst, e.Value)
+	}
+	return dst
+}
+
+// ForEach applies a function to each value from front to back.
+func (fl *ForwardList[T]) ForEach(fn func(T)) {
+	for e := fl.head; e != nil; e = e.next {
+		fn(e.Value)
+	}
+}
+
+// Clone creates a copy of the list with new elements.
+func (fl *ForwardList[T]) Clone() *ForwardList[T] {
+	return fl.CloneWith(identity[T])
+}
+
+// CloneWith creates a copy of the list whose values are copied by cloneElem.
+func (fl *ForwardList[T]) CloneWith(cloneElem func(T) T) *ForwardList[T] {
+	result := NewForwardList[T]()
+	result.equals = fl.equals
+	var tail *ForwardElement[T]
+	for e := fl.head; e != nil; e = e.next {
+		if tail == nil {
+			tail = result.PushFront(cloneElem(e.Value))
+		} else {
+			tail = result.InsertAfter(cloneElem(e.Value), tail)
+		}
+	}
+	return result
+}
+
+// String returns a string representation of the list.
+func (fl *ForwardList[T]) String() string {
+	return fmt.Sprintf("ForwardList%v", fl.ToSlice())
+}
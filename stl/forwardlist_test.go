@@ -0,0 +1,75 @@
+package stl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestForwardListBasicOperations(t *testing.T) {
+	fl := NewForwardList[int]()
+	one := fl.PushFront(1)
+	fl.PushFront(0)
+	three := fl.InsertAfter(3, one)
+	fl.InsertAfter(2, one)
+
+	if fl.String() != "ForwardList[0 1 2 3]" || fl.Size() != 4 {
+		t.Errorf("Unexpected list %s", fl.String())
+	}
+	if one.Next().Value != 2 || three.Next() != nil {
+		t.Error("Element navigation returned unexpected neighbours")
+	}
+
+	if value, ok := fl.RemoveAfter(one); !ok || value != 2 {
+		t.Errorf("Expected to remove 2, got %d", value)
+	}
+	if _, ok := fl.RemoveAfter(three); ok {
+		t.Error("RemoveAfter the last element should fail")
+	}
+	if value, _ := fl.PopFront(); value != 0 {
+		t.Errorf("Expected 0, got %d", value)
+	}
+	if fl.String() != "ForwardList[1 3]" || fl.Size() != 2 {
+		t.Errorf("Unexpected list %s", fl.String())
+	}
+
+	fl.Clear()
+	if !fl.IsEmpty() || fl.Front() != nil {
+		t.Error("List should be empty after Clear")
+	}
+	if _, ok := fl.PopFront(); ok {
+		t.Error("PopFront on an empty list should fail")
+	}
+}
+
+func TestForwardListTransformations(t *testing.T) {
+	fl := NewForwardListFromSlice([]int{1, 2, 3, 4, 5, 6})
+
+	if removed := fl.RemoveIf(func(v int) bool { return v%2 == 0 }); removed != 3 {
+		t.Errorf("Expected 3 removed, got %d", removed)
+	}
+	if fl.String() != "ForwardList[1 3 5]" || fl.Size() != 3 {
+		t.Errorf("Unexpected list %s", fl.String())
+	}
+
+	clone := fl.Clone()
+	fl.Reverse()
+	if fl.String() != "ForwardList[5 3 1]" {
+		t.Errorf("Unexpected reversed list %s", fl.String())
+	}
+	if clone.String() != "ForwardList[1 3 5]" || clone.Size() != 3 {
+		t.Errorf("Clone should be unaffected, got %s", clone.String())
+	}
+}
+
+func TestForwardListContainsEquality(t *testing.T) {
+	fl := NewForwardListFromSlice([]any{1, []int{2}})
+	if fl.Contains("1") || !fl.Contains(1) || !fl.Contains([]int{2}) {
+		t.Errorf("Expected deep equality by default")
+	}
+
+	folded := NewForwardList[string](WithEquals(strings.EqualFold))
+	folded.PushFront("Go")
+	if !folded.Contains("GO") || !folded.Clone().Contains("go") {
+		t.Errorf("Expected WithEquals to be honored by Contains and kept by Clone")
+	}
+}
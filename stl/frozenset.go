@@ -0,0 +1,120 @@
+package stl
+
+import (
+	"fmt"
+	"hash/maphash"
+	"iter"
+	"sync"
+)
+
+// frozenSetSeed seeds FrozenSet hashes, so they are stable for the life of the process.
+var frozenSetSeed = maphash.MakeSeed()
+
+// FrozenSet is a read-only set created by Set.Freeze. It has no mutators, so it can be shared
+// across goroutines without locks. Hash and Equals let it key a HashMap or belong to a
+// HashSet, for example to build sets of sets.
+type FrozenSet[T comparable] struct {
+	data     map[T]struct{}
+	hashOnce sync.Once
+	hash     uint64
+}
+
+// Freeze returns a read-only copy of the set in O(1). Like Snapshot, it shares storage with
+// the set until the set is next modified.
+func (s *Set[T]) Freeze() *FrozenSet[T] {
+	s.shared = true
+	return &FrozenSet[T]{data: s.data}
+}
+
+// NewFrozenSetFromSlice creates a frozen set from a slice, removing duplicates.
+func NewFrozenSetFromSlice[T comparable](slice []T) *FrozenSet[T] {
+	return NewSetFromSlice(slice).Freeze()
+}
+
+// Contains checks if an element exists in the set.
+func (fs *FrozenSet[T]) Contains(element T) bool {
+	_, exists := fs.data[element]
+	return exists
+}
+
+// Size returns the number of elements in the set.
+func (fs *FrozenSet[T]) Size() int {
+	return len(fs.data)
+}
+
+// IsEmpty checks if the set is empty.
+func (fs *FrozenSet[T]) IsEmpty() bool {
+	return len(fs.data) == 0
+}
+
+// ToSlice converts the set to a slice.
+func (fs *FrozenSet[T]) ToSlice() []T {
+	return fs.AppendTo(make([]T, 0, len(fs.data)))
+}
+
+// AppendTo appends the elements to dst and returns the extended slice.
+func (fs *FrozenSet[T]) AppendTo(dst []T) []T {
+	for element := range fs.data {
+		dst = append(dst, element)
+	}
+	return dst
+}
+
+// ForEach applies a function to each element in the set.
+func (fs *FrozenSet[T]) ForEach(fn func(T)) {
+	for element := range fs.data {
+		fn(element)
+	}
+}
+
+// All returns an iterator over the elements in unspecified order.
+func (fs *FrozenSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for element := range fs.data {
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// IsSubset checks if fs is a subset of other.
+func (fs *FrozenSet[T]) IsSubset(other *FrozenSet[T]) bool {
+	if len(fs.data) > len(other.data) {
+		return false
+	}
+	for element := range fs.data {
+		if !other.Contains(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals checks if two frozen sets contain the same elements.
+func (fs *FrozenSet[T]) Equals(other *FrozenSet[T]) bool {
+	return len(fs.data) == len(other.data) && fs.IsSubset(other)
+}
+
+// Hash returns a hash of the elements that does not depend on their order, so equal sets
+// have equal hashes. It is computed on first use and is stable for the life of the process,
+// but not across processes.
+func (fs *FrozenSet[T]) Hash() uint64 {
+	fs.hashOnce.Do(func() {
+		for element := range fs.data {
+			fs.hash += maphash.Comparable(frozenSetSeed, element)
+		}
+	})
+	return fs.hash
+}
+
+// ToSet returns a mutable set with the frozen set's elements. It is O(1); the elements are
+// copied when the returned set is first modified.
+func (fs *FrozenSet[T]) ToSet() *Set[T] {
+	return &Set[T]{data: fs.data, shared: true}
+}
+
+// String returns a string representation of the set.
+func (fs *FrozenSet[T]) String() string {
+	return fmt.Sprintf("FrozenSet%v", fs.ToSlice())
+}
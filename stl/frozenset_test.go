@@ -0,0 +1,61 @@
+package stl
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestFrozenSet(t *testing.T) {
+	set := NewSetFromSlice([]int{1, 2, 3})
+	frozen := set.Freeze()
+	set.Add(4)
+	set.Remove(1)
+	if frozen.Size() != 3 || !frozen.Contains(1) || frozen.Contains(4) {
+		t.Errorf("Expected the frozen set to keep {1 2 3}, got %v", frozen)
+	}
+
+	mutable := frozen.ToSet()
+	mutable.Add(9)
+	if frozen.Contains(9) {
+		t.Error("Expected ToSet to copy before the first write")
+	}
+
+	same := NewFrozenSetFromSlice([]int{3, 2, 1})
+	if !frozen.Equals(same) || frozen.Hash() != same.Hash() {
+		t.Errorf("Expected equal sets with equal hashes, got %v and %v", frozen, same)
+	}
+	if frozen.Equals(NewFrozenSetFromSlice([]int{1, 2})) || !NewFrozenSetFromSlice([]int{1, 2}).IsSubset(frozen) {
+		t.Error("Expected {1 2} to be a proper subset of {1 2 3}")
+	}
+}
+
+func TestFrozenSetAsKey(t *testing.T) {
+	hash := (*FrozenSet[string]).Hash
+	equals := (*FrozenSet[string]).Equals
+	groups := NewMapWithHasher[*FrozenSet[string], int](hash, equals)
+	groups.Put(NewFrozenSetFromSlice([]string{"a", "b"}), 1)
+	groups.Put(NewFrozenSetFromSlice([]string{"b", "a"}), 2)
+	groups.Put(NewFrozenSetFromSlice([]string{"c"}), 3)
+
+	if groups.Size() != 2 {
+		t.Errorf("Expected 2 distinct keys, got %d", groups.Size())
+	}
+	if value, ok := groups.Get(NewFrozenSetFromSlice([]string{"a", "b"})); !ok || value != 2 {
+		t.Errorf("Expected 2 for {a b}, got %d", value)
+	}
+}
+
+func TestFrozenSetConcurrentReads(t *testing.T) {
+	frozen := NewFrozenSetFromSlice([]int{1, 2, 3, 4})
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if frozen.Hash() == 0 || !frozen.Contains(2) || len(frozen.ToSlice()) != 4 {
+				t.Error("Expected consistent concurrent reads")
+			}
+		}()
+	}
+	wg.Wait()
+}
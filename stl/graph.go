@@ -2,18 +2,59 @@ package stl
 
 import (
 	"fmt"
+	"iter"
+	"math"
+	"sort"
 )
 
+// GraphInterface is the set of operations shared by the graph implementations in this
+// package, so algorithms can be written once for both sparse and dense representations.
+type GraphInterface[T comparable] interface {
+	AddNode(node T)
+	AddEdge(from, to T)
+	RemoveNode(node T)
+	RemoveEdge(from, to T)
+	HasNode(node T) bool
+	HasEdge(from, to T) bool
+	GetNeighbors(node T) []T
+	GetNodes() []T
+	NodeCount() int
+	EdgeCount() int
+	Degree(node T) int
+	IsDirected() bool
+	IsEmpty() bool
+	Clear()
+}
+
+// Compile-time checks that both graph representations satisfy GraphInterface.
+var (
+	_ GraphInterface[int] = (*Graph[int])(nil)
+	_ GraphInterface[int] = (*DenseGraph[int])(nil)
+)
+
+// defaultEdgeWeight is the weight reported for edges added without an explicit weight.
+const defaultEdgeWeight = 1.0
+
 // Graph represents a graph using adjacency list representation.
+// Each node keeps its neighbors in a slice, for ordered traversal, together with an index of
+// their positions, so HasEdge and RemoveEdge run in O(1). Parallel edges are not stored;
+// use MultiGraph for those. Edges added with AddEdge have a weight of 1; AddWeightedEdge
+// assigns explicit weights.
 type Graph[T comparable] struct {
 	adjacency map[T][]T
+	position  map[T]map[T]int
+	weights   map[T]map[T]float64
+	edgeCount int
 	directed  bool
 }
 
-// NewGraph creates a new empty graph.
-func NewGraph[T comparable](directed bool) *Graph[T] {
+// NewGraph creates a new empty graph. It honors WithCapacity as the expected number of nodes.
+func NewGraph[T comparable](directed bool, opts ...Option) *Graph[T] {
+	n := applyOptions(opts).capacity
 	return &Graph[T]{
-		adjacency: make(map[T][]T),
+		adjacency: make(map[T][]T, n),
+		position:  make(map[T]map[T]int, n),
+		weights:   make(map[T]map[T]float64, n),
 		directed:  directed,
 	}
 }
@@ -31,53 +72,120 @@ func NewGraphFromEdges[T comparable](edges [][2]T, directed bool) *Graph[T] {
 func (g *Graph[T]) AddNode(node T) {
 	if _, exists := g.adjacency[node]; !exists {
 		g.adjacency[node] = []T{}
+		g.position[node] = make(map[T]int)
 	}
 }
 
-// AddEdge adds an edge between two nodes.
+// AddEdge adds an edge between two nodes. Adding an existing edge has no effect.
 func (g *Graph[T]) AddEdge(from, to T) {
 	g.AddNode(from)
 	g.AddNode(to)
 
+	if g.HasEdge(from, to) {
+		return
+	}
+
+	g.appendNeighbor(from, to)
+	if !g.directed && from != to {
+		g.appendNeighbor(to, from)
+	}
+	g.edgeCount++
+}
+
+// appendNeighbor records to as a neighbor of from.
+func (g *Graph[T]) appendNeighbor(from, to T) {
+	g.position[from][to] = len(g.adjacency[from])
 	g.adjacency[from] = append(g.adjacency[from], to)
+}
+
+// removeNeighbor removes to from the neighbors of from in O(1) by moving the last
+// neighbor into its slot.
+func (g *Graph[T]) removeNeighbor(from, to T) {
+	i := g.position[from][to]
+	neighbors := g.adjacency[from]
+	last := len(neighbors) - 1
 
+	if i != last {
+		neighbors[i] = neighbors[last]
+		g.position[from][neighbors[i]] = i
+	}
+	g.adjacency[from] = neighbors[:last]
+	delete(g.position[from], to)
+	delete(g.weights[from], to)
+}
+
+// AddWeightedEdge adds an edge with the given weight, or updates the weight if the edge exists.
+func (g *Graph[T]) AddWeightedEdge(from, to T, weight float64) {
+	if !g.HasEdge(from, to) {
+		g.AddEdge(from, to)
+	}
+
+	g.setWeight(from, to, weight)
 	if !g.directed {
-		g.adjacency[to] = append(g.adjacency[to], from)
+		g.setWeight(to, from, weight)
+	}
+}
+
+// setWeight records the weight of the edge from -> to.
+func (g *Graph[T]) setWeight(from, to T, weight float64) {
+	if g.weights[from] == nil {
+		g.weights[from] = make(map[T]float64)
+	}
+	g.weights[from][to] = weight
+}
+
+// GetWeight returns the weight of the edge between two nodes.
+func (g *Graph[T]) GetWeight(from, to T) (float64, bool) {
+	if !g.HasEdge(from, to) {
+		return 0, false
+	}
+	return g.edgeWeight(from, to), true
+}
+
+// edgeWeight returns the weight of an existing edge, defaulting to 1 for unweighted edges.
+func (g *Graph[T]) edgeWeight(from, to T) float64 {
+	if weight, exists := g.weights[from][to]; exists {
+		return weight
 	}
+	return defaultEdgeWeight
 }
 
 // RemoveNode removes a node and all its edges from the graph.
 func (g *Graph[T]) RemoveNode(node T) {
+	if !g.HasNode(node) {
+		return
+	}
+
+	// Remove all edges from this node
+	for len(g.adjacency[node]) > 0 {
+		g.RemoveEdge(node, g.adjacency[node][0])
+	}
+
 	// Remove all edges to this node
-	for from := range g.adjacency {
-		g.RemoveEdge(from, node)
+	if g.directed {
+		for from := range g.adjacency {
+			g.RemoveEdge(from, node)
+		}
 	}
 
 	// Remove the node itself
 	delete(g.adjacency, node)
+	delete(g.position, node)
+	delete(g.weights, node)
 }
 
 // RemoveEdge removes an edge between two nodes.
+// The neighbor order of the affected nodes may change.
 func (g *Graph[T]) RemoveEdge(from, to T) {
-	if neighbors, exists := g.adjacency[from]; exists {
-		for i, neighbor := range neighbors {
-			if neighbor == to {
-				g.adjacency[from] = append(neighbors[:i], neighbors[i+1:]...)
-				break
-			}
-		}
+	if !g.HasEdge(from, to) {
+		return
 	}
 
-	if !g.directed {
-		if neighbors, exists := g.adjacency[to]; exists {
-			for i, neighbor := range neighbors {
-				if neighbor == from {
-					g.adjacency[to] = append(neighbors[:i], neighbors[i+1:]...)
-					break
-				}
-			}
-		}
+	g.removeNeighbor(from, to)
+	if !g.directed && from != to {
+		g.removeNeighbor(to, from)
 	}
+	g.edgeCount--
 }
 
 // HasNode checks if a node exists in the graph.
@@ -88,14 +196,8 @@ func (g *Graph[T]) HasNode(node T) bool {
 
 // HasEdge checks if an edge exists between two nodes.
 func (g *Graph[T]) HasEdge(from, to T) bool {
-	if neighbors, exists := g.adjacency[from]; exists {
-		for _, neighbor := range neighbors {
-			if neighbor == to {
-				return true
-			}
-		}
-	}
-	return false
+	_, exists := g.position[from][to]
+	return exists
 }
 
 // GetNeighbors returns all neighbors of a node.
@@ -119,27 +221,37 @@ func (g *Graph[T]) GetNodes() []T {
 
 // GetEdges returns all edges in the graph.
 func (g *Graph[T]) GetEdges() [][2]T {
-	var edges [][2]T
-	visited := make(map[string]bool)
+	return g.edgeList(g.GetNodes())
+}
 
-	for from, neighbors := range g.adjacency {
-		for _, to := range neighbors {
-			edgeKey := fmt.Sprintf("%v->%v", from, to)
-			reverseKey := fmt.Sprintf("%v->%v", to, from)
-
-			if !g.directed {
-				if !visited[edgeKey] && !visited[reverseKey] {
-					edges = append(edges, [2]T{from, to})
-					visited[edgeKey] = true
-					visited[reverseKey] = true
-				}
-			} else {
-				if !visited[edgeKey] {
-					edges = append(edges, [2]T{from, to})
-					visited[edgeKey] = true
-				}
+// sortedNodes returns all nodes ordered by their %v representation, for stable output.
+func (g *Graph[T]) sortedNodes() []T {
+	keys := make(map[T]string, len(g.adjacency))
+	nodes := make([]T, 0, len(g.adjacency))
+	for node := range g.adjacency {
+		keys[node] = fmt.Sprintf("%v", node)
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return keys[nodes[i]] < keys[nodes[j]]
+	})
+	return nodes
+}
+
+// edgeList returns the edges leaving the given nodes in order. Each undirected edge is
+// reported once, from the endpoint that appears first.
+func (g *Graph[T]) edgeList(nodes []T) [][2]T {
+	edges := make([][2]T, 0, g.edgeCount)
+	reported := make(map[T]bool)
+
+	for _, from := range nodes {
+		for _, to := range g.adjacency[from] {
+			if !g.directed && reported[to] {
+				continue
 			}
+			edges = append(edges, [2]T{from, to})
 		}
+		reported[from] = true
 	}
 
 	return edges
@@ -152,16 +264,7 @@ func (g *Graph[T]) NodeCount() int {
 
 // EdgeCount returns the number of edges in the graph.
 func (g *Graph[T]) EdgeCount() int {
-	total := 0
-	for _, neighbors := range g.adjacency {
-		total += len(neighbors)
-	}
-
-	if !g.directed {
-		total /= 2
-	}
-
-	return total
+	return g.edgeCount
 }
 
 // IsEmpty checks if the graph is empty.
@@ -172,6 +275,9 @@ func (g *Graph[T]) IsEmpty() bool {
 // Clear removes all nodes and edges from the graph.
 func (g *Graph[T]) Clear() {
 	g.adjacency = make(map[T][]T)
+	g.position = make(map[T]map[T]int)
+	g.weights = make(map[T]map[T]float64)
+	g.edgeCount = 0
 }
 
 // IsDirected checks if the graph is directed.
@@ -194,11 +300,9 @@ func (g *Graph[T]) InDegree(node T) int {
 	}
 
 	count := 0
-	for _, neighbors := range g.adjacency {
-		for _, neighbor := range neighbors {
-			if neighbor == node {
-				count++
-			}
+	for from := range g.adjacency {
+		if g.HasEdge(from, node) {
+			count++
 		}
 	}
 	return count
@@ -235,21 +339,57 @@ func (g *Graph[T]) BFS(start T) []T {
 // DFS performs depth-first search starting from the given node.
 func (g *Graph[T]) DFS(start T) []T {
 	var result []T
-	visited := make(map[T]bool)
-	g.dfsRecursive(start, visited, &result)
+	g.dfsWalk(start, make(map[T]bool), func(node T, _ int) bool {
+		result = append(result, node)
+		return true
+	}, nil)
 	return result
 }
 
-// dfsRecursive is the recursive helper for DFS.
-func (g *Graph[T]) dfsRecursive(node T, visited map[T]bool, result *[]T) {
-	visited[node] = true
-	*result = append(*result, node)
+// dfsFrame is an explicit stack entry of an iterative depth-first search. next is the index of
+// the neighbor to explore when the search returns to node.
+type dfsFrame[T comparable] struct {
+	node T
+	next int
+}
+
+// dfsWalk runs an iterative depth-first search from start, skipping nodes already in visited.
+// enter is called in preorder with the node's depth in the DFS tree and may return false to stop
+// the walk; exit, if not nil, is called once all neighbors of a node are finished. Neighbors are
+// explored in adjacency order, so the result matches a recursive search without its stack limit.
+// It returns false if enter stopped the walk.
+func (g *Graph[T]) dfsWalk(start T, visited map[T]bool, enter func(node T, depth int) bool, exit func(node T)) bool {
+	visited[start] = true
+	if !enter(start, 0) {
+		return false
+	}
+	stack := []dfsFrame[T]{{node: start}}
+
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		neighbors := g.adjacency[top.node]
+		if top.next == len(neighbors) {
+			if exit != nil {
+				exit(top.node)
+			}
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		neighbor := neighbors[top.next]
+		top.next++
+		if visited[neighbor] {
+			continue
+		}
 
-	for _, neighbor := range g.GetNeighbors(node) {
-		if !visited[neighbor] {
-			g.dfsRecursive(neighbor, visited, result)
+		visited[neighbor] = true
+		if !enter(neighbor, len(stack)) {
+			return false
 		}
+		stack = append(stack, dfsFrame[T]{node: neighbor})
 	}
+
+	return true
 }
 
 // DFSIterative performs iterative depth-first search.
@@ -279,6 +419,44 @@ func (g *Graph[T]) DFSIterative(start T) []T {
 	return result
 }
 
+// TraverseBFS visits nodes in breadth-first order starting from start, passing each node and
+// its distance in edges from start. Traversal stops as soon as visit returns false. Nothing is
+// visited if start is not in the graph.
+func (g *Graph[T]) TraverseBFS(start T, visit func(node T, depth int) bool) {
+	if !g.HasNode(start) {
+		return
+	}
+
+	depth := map[T]int{start: 0}
+	queue := []T{start}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		if !visit(node, depth[node]) {
+			return
+		}
+
+		for _, neighbor := range g.adjacency[node] {
+			if _, seen := depth[neighbor]; !seen {
+				depth[neighbor] = depth[node] + 1
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+}
+
+// TraverseDFS visits nodes in depth-first preorder starting from start, passing each node and
+// its depth in the DFS tree. The order matches DFS. Traversal stops as soon as visit returns
+// false. Nothing is visited if start is not in the graph.
+func (g *Graph[T]) TraverseDFS(start T, visit func(node T, depth int) bool) {
+	if !g.HasNode(start) {
+		return
+	}
+	g.dfsWalk(start, make(map[T]bool), visit, nil)
+}
+
 // ConnectedComponents returns all connected components in the graph.
 func (g *Graph[T]) ConnectedComponents() [][]T {
 	var components [][]T
@@ -287,7 +465,10 @@ func (g *Graph[T]) ConnectedComponents() [][]T {
 	for node := range g.adjacency {
 		if !visited[node] {
 			var component []T
-			g.dfsRecursive(node, visited, &component)
+			g.dfsWalk(node, visited, func(member T, _ int) bool {
+				component = append(component, member)
+				return true
+			}, nil)
 			components = append(components, component)
 		}
 	}
@@ -321,18 +502,10 @@ func (g *Graph[T]) ShortestPath(start, end T) ([]T, bool) {
 		queue = queue[1:]
 
 		if node == end {
-			// Reconstruct path
-			var path []T
-			current := end
-			for current != start {
-				path = append([]T{current}, path...)
-				current = parent[current]
-			}
-			path = append([]T{start}, path...)
-			return path, true
+			return reconstructPath(parent, start, end), true
 		}
 
-		for _, neighbor := range g.GetNeighbors(node) {
+		for _, neighbor := range g.adjacency[node] {
 			if !visited[neighbor] {
 				visited[neighbor] = true
 				parent[neighbor] = node
@@ -344,67 +517,306 @@ func (g *Graph[T]) ShortestPath(start, end T) ([]T, bool) {
 	return nil, false
 }
 
+// reconstructPath follows parent links back from end to start and returns the path in order.
+func reconstructPath[T comparable](parent map[T]T, start, end T) []T {
+	path := []T{end}
+	for current := end; current != start; {
+		current = parent[current]
+		path = append(path, current)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// ShortestPathTree holds the result of a single-source shortest path search. Distances and
+// Predecessors contain only nodes reachable from Source; Source itself has no predecessor.
+type ShortestPathTree[T comparable] struct {
+	Source       T
+	Distances    map[T]float64
+	Predecessors map[T]T
+}
+
+// PathTo returns the shortest path from the source to target.
+func (t *ShortestPathTree[T]) PathTo(target T) ([]T, bool) {
+	if _, reached := t.Distances[target]; !reached {
+		return nil, false
+	}
+	return reconstructPath(t.Predecessors, t.Source, target), true
+}
+
+// DistanceTo returns the length of the shortest path from the source to target.
+func (t *ShortestPathTree[T]) DistanceTo(target T) (float64, bool) {
+	d, reached := t.Distances[target]
+	return d, reached
+}
+
+// nodeDistance is a tentative distance queued during Dijkstra's algorithm.
+type nodeDistance[T comparable] struct {
+	node T
+	dist float64
+}
+
+// ShortestPathsFrom computes shortest paths from start to every reachable node in a single run
+// of Dijkstra's algorithm over edge weights (unweighted edges count as 1, giving hop counts).
+// It returns false if start is not in the graph or a negative edge weight is reachable; use
+// BellmanFord for such graphs.
+func (g *Graph[T]) ShortestPathsFrom(start T) (*ShortestPathTree[T], bool) {
+	if !g.HasNode(start) {
+		return nil, false
+	}
+
+	tree := &ShortestPathTree[T]{
+		Source:       start,
+		Distances:    map[T]float64{start: 0},
+		Predecessors: make(map[T]T),
+	}
+	done := make(map[T]bool)
+	pq := NewPriorityQueue[nodeDistance[T]](func(a, b nodeDistance[T]) bool {
+		return a.dist < b.dist
+	})
+	pq.Enqueue(nodeDistance[T]{node: start})
+
+	for !pq.IsEmpty() {
+		current, _ := pq.Dequeue()
+		if done[current.node] {
+			continue
+		}
+		done[current.node] = true
+
+		for _, neighbor := range g.adjacency[current.node] {
+			weight := g.edgeWeight(current.node, neighbor)
+			if weight < 0 {
+				return nil, false
+			}
+			candidate := current.dist + weight
+			if d, seen := tree.Distances[neighbor]; !seen || candidate < d {
+				tree.Distances[neighbor] = candidate
+				tree.Predecessors[neighbor] = current.node
+				pq.Enqueue(nodeDistance[T]{node: neighbor, dist: candidate})
+			}
+		}
+	}
+
+	return tree, true
+}
+
+// BellmanFord computes shortest path distances from start using the Bellman-Ford algorithm.
+// Negative edge weights are supported. Only nodes reachable from start are present in the
+// returned map. It returns false if start is not in the graph or if a
+// negative-weight cycle is reachable from start, in which case the distances are undefined.
+// In an undirected graph any negative edge forms such a cycle.
+func (g *Graph[T]) BellmanFord(start T) (map[T]float64, bool) {
+	if !g.HasNode(start) {
+		return nil, false
+	}
+
+	dist := map[T]float64{start: 0}
+
+	// relax relaxes every edge once and reports whether any distance changed
+	relax := func() bool {
+		changed := false
+		for from, neighbors := range g.adjacency {
+			d, reached := dist[from]
+			if !reached {
+				continue
+			}
+			for _, to := range neighbors {
+				candidate := d + g.edgeWeight(from, to)
+				if current, seen := dist[to]; !seen || candidate < current {
+					dist[to] = candidate
+					changed = true
+				}
+			}
+		}
+		return changed
+	}
+
+	for i := 1; i < len(g.adjacency); i++ {
+		if !relax() {
+			return dist, true
+		}
+	}
+
+	// Any further improvement means a negative cycle is reachable
+	if relax() {
+		return nil, false
+	}
+
+	return dist, true
+}
+
+// AdjacencyMatrix returns the weighted adjacency matrix of the graph together with the
+// node order of its rows and columns. Missing edges are 0.
+func (g *Graph[T]) AdjacencyMatrix() (*Matrix[float64], []T) {
+	nodes := g.GetNodes()
+	index := make(map[T]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+
+	matrix := NewMatrix[float64](len(nodes), len(nodes))
+	for from, neighbors := range g.adjacency {
+		for _, to := range neighbors {
+			matrix.Set(index[from], index[to], g.edgeWeight(from, to))
+		}
+	}
+	return matrix, nodes
+}
+
+// FloydWarshall computes the shortest-path distance between every pair of nodes. It returns
+// the distance matrix together with the node order of its rows and columns; unreachable
+// pairs are +Inf. A negative entry on the diagonal means the node lies on a negative cycle.
+func (g *Graph[T]) FloydWarshall() (*Matrix[float64], []T) {
+	dist, nodes := g.AdjacencyMatrix()
+	n := len(nodes)
+
+	for i := 0; i < n; i++ {
+		row := dist.Row(i)
+		for j := range row {
+			if i != j && !g.HasEdge(nodes[i], nodes[j]) {
+				row[j] = math.Inf(1)
+			}
+		}
+		row[i] = min(row[i], 0)
+	}
+
+	for k := 0; k < n; k++ {
+		through := dist.Row(k)
+		for i := 0; i < n; i++ {
+			row := dist.Row(i)
+			if math.IsInf(row[k], 1) {
+				continue
+			}
+			for j, d := range through {
+				if candidate := row[k] + d; candidate < row[j] {
+					row[j] = candidate
+				}
+			}
+		}
+	}
+	return dist, nodes
+}
+
 // AllPaths finds all paths between two nodes.
+// The number of paths can grow exponentially; see AllPathsLimit and AllPathsFunc for bounded searches.
 func (g *Graph[T]) AllPaths(start, end T) [][]T {
+	return g.AllPathsLimit(start, end, 0, 0)
+}
+
+// AllPathsLimit finds simple paths between two nodes that use at most maxDepth edges, stopping
+// after maxPaths paths have been found. A non-positive limit means no limit.
+func (g *Graph[T]) AllPathsLimit(start, end T, maxDepth, maxPaths int) [][]T {
 	var paths [][]T
-	visited := make(map[T]bool)
-	g.findAllPaths(start, end, visited, []T{start}, &paths)
+	g.AllPathsFunc(start, end, maxDepth, func(path []T) bool {
+		paths = append(paths, path)
+		return maxPaths <= 0 || len(paths) < maxPaths
+	})
 	return paths
 }
 
-// findAllPaths is the recursive helper for AllPaths.
-func (g *Graph[T]) findAllPaths(current, end T, visited map[T]bool, path []T, paths *[][]T) {
-	if current == end {
-		pathCopy := make([]T, len(path))
-		copy(pathCopy, path)
-		*paths = append(*paths, pathCopy)
+// AllPathsFunc calls yield for every simple path between two nodes that uses at most maxDepth
+// edges (no limit if maxDepth is non-positive). The search stops as soon as yield returns false.
+// Each path passed to yield is a fresh slice owned by the caller.
+func (g *Graph[T]) AllPathsFunc(start, end T, maxDepth int, yield func(path []T) bool) {
+	path := []T{start}
+	if start == end {
+		yield(path)
 		return
 	}
 
-	visited[current] = true
+	onPath := map[T]bool{start: true}
+	stack := []dfsFrame[T]{{node: start}}
 
-	for _, neighbor := range g.GetNeighbors(current) {
-		if !visited[neighbor] {
-			g.findAllPaths(neighbor, end, visited, append(path, neighbor), paths)
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		neighbors := g.adjacency[top.node]
+		if top.next == len(neighbors) || (maxDepth > 0 && len(stack) > maxDepth) {
+			onPath[top.node] = false
+			path = path[:len(path)-1]
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		neighbor := neighbors[top.next]
+		top.next++
+		if onPath[neighbor] {
+			continue
+		}
+
+		if neighbor == end {
+			found := make([]T, len(path)+1)
+			copy(found, path)
+			found[len(path)] = end
+			if !yield(found) {
+				return
+			}
+			continue
 		}
-	}
 
-	visited[current] = false
+		onPath[neighbor] = true
+		path = append(path, neighbor)
+		stack = append(stack, dfsFrame[T]{node: neighbor})
+	}
 }
 
-// HasCycle checks if the graph has a cycle.
+// HasCycle checks if the graph has a cycle. In undirected graphs an edge is not a cycle on its
+// own; a cycle needs a closed walk over distinct edges, or a self-loop.
 func (g *Graph[T]) HasCycle() bool {
+	_, found := g.FindCycle()
+	return found
+}
+
+// FindCycle returns the nodes of a cycle in traversal order, where the last node connects back to
+// the first. A self-loop is reported as a single node. It returns false if the graph is acyclic.
+func (g *Graph[T]) FindCycle() ([]T, bool) {
 	visited := make(map[T]bool)
-	recStack := make(map[T]bool)
+	// position holds the stack index of every node on the current DFS path
+	position := make(map[T]int)
 
 	for node := range g.adjacency {
-		if !visited[node] {
-			if g.hasCycleDFS(node, visited, recStack) {
-				return true
-			}
+		if visited[node] {
+			continue
 		}
-	}
 
-	return false
-}
+		visited[node] = true
+		position[node] = 0
+		stack := []dfsFrame[T]{{node: node}}
+
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			neighbors := g.adjacency[top.node]
+			if top.next == len(neighbors) {
+				delete(position, top.node)
+				stack = stack[:len(stack)-1]
+				continue
+			}
 
-// hasCycleDFS is the recursive helper for HasCycle.
-func (g *Graph[T]) hasCycleDFS(node T, visited, recStack map[T]bool) bool {
-	visited[node] = true
-	recStack[node] = true
+			neighbor := neighbors[top.next]
+			top.next++
 
-	for _, neighbor := range g.GetNeighbors(node) {
-		if !visited[neighbor] {
-			if g.hasCycleDFS(neighbor, visited, recStack) {
-				return true
+			if index, onPath := position[neighbor]; onPath {
+				// In undirected graphs the edge back to the parent is the tree edge itself
+				if !g.directed && index == len(stack)-2 {
+					continue
+				}
+				cycle := make([]T, 0, len(stack)-index)
+				for _, frame := range stack[index:] {
+					cycle = append(cycle, frame.node)
+				}
+				return cycle, true
+			}
+
+			if !visited[neighbor] {
+				visited[neighbor] = true
+				position[neighbor] = len(stack)
+				stack = append(stack, dfsFrame[T]{node: neighbor})
 			}
-		} else if recStack[neighbor] {
-			return true
 		}
 	}
 
-	recStack[node] = false
-	return false
+	return nil, false
 }
 
 // TopologicalSort performs topological sorting (for DAGs).
@@ -422,7 +834,9 @@ func (g *Graph[T]) TopologicalSort() ([]T, bool) {
 
 	for node := range g.adjacency {
 		if !visited[node] {
-			g.topologicalSortDFS(node, visited, &result)
+			g.dfsWalk(node, visited, func(T, int) bool { return true }, func(finished T) {
+				result = append(result, finished)
+			})
 		}
 	}
 
@@ -434,17 +848,175 @@ func (g *Graph[T]) TopologicalSort() ([]T, bool) {
 	return result, true
 }
 
-// topologicalSortDFS is the recursive helper for TopologicalSort.
-func (g *Graph[T]) topologicalSortDFS(node T, visited map[T]bool, result *[]T) {
-	visited[node] = true
+// AllTopologicalSorts enumerates topological orderings of a DAG, stopping after limit orderings
+// (no limit if limit is non-positive). Orderings are produced in lexicographic order of the %v
+// form of their nodes. It returns false if the graph is undirected or has a cycle.
+func (g *Graph[T]) AllTopologicalSorts(limit int) ([][]T, bool) {
+	if !g.directed || g.HasCycle() {
+		return nil, false
+	}
+
+	nodes := g.sortedNodes()
+	index := make(map[T]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+	inDegree := make([]int, len(nodes))
+	for _, neighbors := range g.adjacency {
+		for _, to := range neighbors {
+			inDegree[index[to]]++
+		}
+	}
+
+	var result [][]T
+	order := make([]T, 0, len(nodes))
+	placed := make([]bool, len(nodes))
+
+	// extend tries every available node at the current position and reports whether to continue
+	var extend func() bool
+	extend = func() bool {
+		if len(order) == len(nodes) {
+			result = append(result, append([]T(nil), order...))
+			return limit <= 0 || len(result) < limit
+		}
+
+		for i, node := range nodes {
+			if placed[i] || inDegree[i] != 0 {
+				continue
+			}
+
+			placed[i] = true
+			order = append(order, node)
+			for _, to := range g.adjacency[node] {
+				inDegree[index[to]]--
+			}
+
+			more := extend()
 
-	for _, neighbor := range g.GetNeighbors(node) {
-		if !visited[neighbor] {
-			g.topologicalSortDFS(neighbor, visited, result)
+			for _, to := range g.adjacency[node] {
+				inDegree[index[to]]++
+			}
+			order = order[:len(order)-1]
+			placed[i] = false
+
+			if !more {
+				return false
+			}
 		}
+		return true
 	}
 
-	*result = append(*result, node)
+	extend()
+	return result, true
+}
+
+// StronglyConnectedComponents returns the strongly connected components of the graph using
+// Tarjan's algorithm. Components are returned in reverse topological order of the condensed
+// graph. For undirected graphs the result equals the connected components.
+func (g *Graph[T]) StronglyConnectedComponents() [][]T {
+	index := make(map[T]int)
+	lowlink := make(map[T]int)
+	onStack := make(map[T]bool)
+	var stack []T
+	var components [][]T
+	counter := 0
+
+	// frame tracks the next neighbor to explore for a node on the explicit call stack
+	type frame struct {
+		node T
+		next int
+	}
+
+	push := func(node T) {
+		index[node] = counter
+		lowlink[node] = counter
+		counter++
+		stack = append(stack, node)
+		onStack[node] = true
+	}
+
+	for root := range g.adjacency {
+		if _, seen := index[root]; seen {
+			continue
+		}
+
+		push(root)
+		callStack := []frame{{node: root}}
+
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			node := top.node
+			neighbors := g.adjacency[node]
+
+			if top.next < len(neighbors) {
+				neighbor := neighbors[top.next]
+				top.next++
+				if _, seen := index[neighbor]; !seen {
+					push(neighbor)
+					callStack = append(callStack, frame{node: neighbor})
+				} else if onStack[neighbor] && index[neighbor] < lowlink[node] {
+					lowlink[node] = index[neighbor]
+				}
+				continue
+			}
+
+			callStack = callStack[:len(callStack)-1]
+			if len(callStack) > 0 {
+				parent := callStack[len(callStack)-1].node
+				if lowlink[node] < lowlink[parent] {
+					lowlink[parent] = lowlink[node]
+				}
+			}
+
+			// Node is the root of a component
+			if lowlink[node] == index[node] {
+				var component []T
+				for {
+					member := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[member] = false
+					component = append(component, member)
+					if member == node {
+						break
+					}
+				}
+				components = append(components, component)
+			}
+		}
+	}
+
+	return components
+}
+
+// Condensation collapses each strongly connected component into a single node and returns
+// the resulting directed acyclic graph together with a mapping from original nodes to
+// component IDs. Component IDs are assigned in topological order, so every edge of the
+// condensed graph goes from a lower ID to a higher one. Edge weights are not preserved.
+func (g *Graph[T]) Condensation() (*Graph[int], map[T]int) {
+	components := g.StronglyConnectedComponents()
+	componentOf := make(map[T]int, len(g.adjacency))
+	for i, component := range components {
+		id := len(components) - 1 - i
+		for _, node := range component {
+			componentOf[node] = id
+		}
+	}
+
+	dag := NewGraph[int](true)
+	for id := range components {
+		dag.AddNode(id)
+	}
+
+	for from, neighbors := range g.adjacency {
+		for _, to := range neighbors {
+			fromID, toID := componentOf[from], componentOf[to]
+			if fromID != toID && !dag.HasEdge(fromID, toID) {
+				dag.AddEdge(fromID, toID)
+			}
+		}
+	}
+
+	return dag, componentOf
 }
 
 // IsBipartite checks if the graph is bipartite.
@@ -453,17 +1025,24 @@ func (g *Graph[T]) IsBipartite() bool {
 		return true
 	}
 
+	_, ok := g.bipartition()
+	return ok
+}
+
+// bipartition two-colors the graph, returning 1 or -1 for every node, or false if the
+// graph is not bipartite.
+func (g *Graph[T]) bipartition() (map[T]int, bool) {
 	color := make(map[T]int)
 
 	for node := range g.adjacency {
 		if color[node] == 0 {
 			if !g.isBipartiteBFS(node, color) {
-				return false
+				return nil, false
 			}
 		}
 	}
 
-	return true
+	return color, true
 }
 
 // isBipartiteBFS is the BFS helper for IsBipartite.
@@ -488,6 +1067,100 @@ func (g *Graph[T]) isBipartiteBFS(start T, color map[T]int) bool {
 	return true
 }
 
+// MaximumBipartiteMatching computes a maximum matching of an undirected bipartite graph
+// using the Hopcroft-Karp algorithm. Each returned pair holds one node from each side of
+// the bipartition. It returns false if the graph is directed or not bipartite.
+func (g *Graph[T]) MaximumBipartiteMatching() ([][2]T, bool) {
+	// Directed edges only appear in the adjacency of their source, so both the two-coloring
+	// and the augmenting paths would miss edges pointing into the left side
+	if g.directed {
+		return nil, false
+	}
+	color, ok := g.bipartition()
+	if !ok {
+		return nil, false
+	}
+
+	var left []T
+	for node, c := range color {
+		if c == 1 {
+			left = append(left, node)
+		}
+	}
+
+	const infinity = math.MaxInt
+	pairLeft := make(map[T]T)
+	pairRight := make(map[T]T)
+	dist := make(map[T]int)
+	freeDist := infinity
+
+	// bfs layers the graph from free left nodes and reports whether an augmenting path exists
+	bfs := func() bool {
+		var queue []T
+		for _, u := range left {
+			if _, matched := pairLeft[u]; matched {
+				dist[u] = infinity
+			} else {
+				dist[u] = 0
+				queue = append(queue, u)
+			}
+		}
+		freeDist = infinity
+
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			if dist[u] >= freeDist {
+				continue
+			}
+			for _, v := range g.adjacency[u] {
+				partner, matched := pairRight[v]
+				switch {
+				case !matched:
+					if freeDist == infinity {
+						freeDist = dist[u] + 1
+					}
+				case dist[partner] == infinity:
+					dist[partner] = dist[u] + 1
+					queue = append(queue, partner)
+				}
+			}
+		}
+
+		return freeDist != infinity
+	}
+
+	// dfs searches for an augmenting path from u along the BFS layers
+	var dfs func(u T) bool
+	dfs = func(u T) bool {
+		for _, v := range g.adjacency[u] {
+			partner, matched := pairRight[v]
+			if (!matched && freeDist == dist[u]+1) ||
+				(matched && dist[partner] == dist[u]+1 && dfs(partner)) {
+				pairLeft[u] = v
+				pairRight[v] = u
+				return true
+			}
+		}
+		dist[u] = infinity
+		return false
+	}
+
+	for bfs() {
+		for _, u := range left {
+			if _, matched := pairLeft[u]; !matched {
+				dfs(u)
+			}
+		}
+	}
+
+	matching := make([][2]T, 0, len(pairLeft))
+	for u, v := range pairLeft {
+		matching = append(matching, [2]T{u, v})
+	}
+	return matching, true
+}
+
 // Clone creates a deep copy of the graph.
 func (g *Graph[T]) Clone() *Graph[T] {
 	result := NewGraph[T](g.directed)
@@ -495,6 +1168,17 @@ func (g *Graph[T]) Clone() *Graph[T] {
 	for node, neighbors := range g.adjacency {
 		result.adjacency[node] = make([]T, len(neighbors))
 		copy(result.adjacency[node], neighbors)
+		result.position[node] = make(map[T]int, len(neighbors))
+		for i, neighbor := range neighbors {
+			result.position[node][neighbor] = i
+		}
+	}
+	result.edgeCount = g.edgeCount
+
+	for from, targets := range g.weights {
+		for to, weight := range targets {
+			result.setWeight(from, to, weight)
+		}
 	}
 
 	return result
@@ -516,12 +1200,10 @@ func (g *Graph[T]) Equals(other *Graph[T]) bool {
 			return false
 		}
 
-		// Create sets for comparison
-		set1 := NewSetFromSlice(neighbors)
-		set2 := NewSetFromSlice(otherNeighbors)
-
-		if !set1.Equals(set2) {
-			return false
+		for _, neighbor := range neighbors {
+			if !other.HasEdge(node, neighbor) {
+				return false
+			}
 		}
 	}
 
@@ -540,31 +1222,24 @@ func (g *Graph[T]) ForEachNode(fn func(T)) {
 	}
 }
 
-// ForEachEdge applies a function to each edge in the graph.
-func (g *Graph[T]) ForEachEdge(fn func(T, T)) {
-	visited := make(map[string]bool)
-
-	for from, neighbors := range g.adjacency {
-		for _, to := range neighbors {
-			edgeKey := fmt.Sprintf("%v->%v", from, to)
-			reverseKey := fmt.Sprintf("%v->%v", to, from)
-
-			if !g.directed {
-				if !visited[edgeKey] && !visited[reverseKey] {
-					fn(from, to)
-					visited[edgeKey] = true
-					visited[reverseKey] = true
-				}
-			} else {
-				if !visited[edgeKey] {
-					fn(from, to)
-					visited[edgeKey] = true
-				}
+// All returns an iterator over the nodes in unspecified order.
+func (g *Graph[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := range g.adjacency {
+			if !yield(node) {
+				return
 			}
 		}
 	}
 }
 
+// ForEachEdge applies a function to each edge in the graph.
+func (g *Graph[T]) ForEachEdge(fn func(T, T)) {
+	for _, edge := range g.GetEdges() {
+		fn(edge[0], edge[1])
+	}
+}
+
 // FilterNodes returns a new graph containing only nodes that satisfy the predicate.
 func (g *Graph[T]) FilterNodes(predicate func(T) bool) *Graph[T] {
 	result := NewGraph[T](g.directed)
@@ -580,6 +1255,9 @@ func (g *Graph[T]) FilterNodes(predicate func(T) bool) *Graph[T] {
 			for _, to := range neighbors {
 				if result.HasNode(to) {
 					result.AddEdge(from, to)
+					if weight, exists := g.weights[from][to]; exists {
+						result.setWeight(from, to, weight)
+					}
 				}
 			}
 		}
@@ -630,6 +1308,9 @@ func (g *Graph[T]) Union(other *Graph[T]) *Graph[T] {
 		for _, to := range neighbors {
 			result.AddNode(to)
 			result.AddEdge(from, to)
+			if weight, exists := other.weights[from][to]; exists {
+				result.setWeight(from, to, weight)
+			}
 		}
 	}
 
@@ -659,27 +1340,173 @@ func (g *Graph[T]) Intersection(other *Graph[T]) *Graph[T] {
 	return result
 }
 
-// For unweighted graphs, it returns a spanning tree (not minimum).
-func (g *Graph[T]) PrimMST(start T) [][2]T {
+// LineGraph returns the line graph of g, which has one node per edge of g. Node i of the result
+// stands for edges[i], in the order and orientation reported by GetEdges. In an undirected graph
+// two edges are adjacent when they share an endpoint; in a directed graph edge (u, v) points to
+// every edge (v, w).
+func (g *Graph[T]) LineGraph() (*Graph[int], [][2]T) {
+	edges := g.GetEdges()
+	result := NewGraph[int](g.directed)
+
+	// Index every edge by the endpoints it can be reached from
+	incident := make(map[T][]int)
+	for i, edge := range edges {
+		result.AddNode(i)
+		incident[edge[0]] = append(incident[edge[0]], i)
+		if !g.directed && edge[0] != edge[1] {
+			incident[edge[1]] = append(incident[edge[1]], i)
+		}
+	}
+
+	if g.directed {
+		for i, edge := range edges {
+			for _, next := range incident[edge[1]] {
+				if next != i {
+					result.AddEdge(i, next)
+				}
+			}
+		}
+		return result, edges
+	}
+
+	for _, shared := range incident {
+		for i := range shared {
+			for j := i + 1; j < len(shared); j++ {
+				result.AddEdge(shared[i], shared[j])
+			}
+		}
+	}
+	return result, edges
+}
+
+// ContractEdge returns a copy of the graph in which the edge u-v is contracted: v is merged into
+// u, every other edge of v is reattached to u, and the contracted edge disappears. Where u already
+// had an edge to the same node, u's edge and weight are kept. It returns nil if the edge does not
+// exist or is a self-loop.
+func (g *Graph[T]) ContractEdge(u, v T) *Graph[T] {
+	if u == v || !g.HasEdge(u, v) {
+		return nil
+	}
+
+	result := g.Clone()
+	result.RemoveNode(v)
+
+	// reattach adds from -> to unless it already exists, carrying any explicit weight
+	reattach := func(from, to, origFrom, origTo T) {
+		if result.HasEdge(from, to) {
+			return
+		}
+		result.AddEdge(from, to)
+		if weight, exists := g.weights[origFrom][origTo]; exists {
+			result.setWeight(from, to, weight)
+		}
+	}
+
+	for _, neighbor := range g.adjacency[v] {
+		switch neighbor {
+		case u:
+			continue
+		case v:
+			reattach(u, u, v, v)
+		default:
+			reattach(u, neighbor, v, neighbor)
+		}
+	}
+
+	if g.directed {
+		for from, neighbors := range g.adjacency {
+			if from == u || from == v {
+				continue
+			}
+			for _, to := range neighbors {
+				if to == v {
+					reattach(from, u, from, v)
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// weightedEdge is an edge candidate used by the minimum spanning tree algorithms.
+type weightedEdge[T comparable] struct {
+	from   T
+	to     T
+	weight float64
+}
+
+// PrimMST computes a minimum spanning tree of the component containing start using Prim's
+// algorithm and returns its edges together with the total weight. Unweighted edges count as 1.
+// The graph is expected to be undirected; for directed graphs only outgoing edges are followed.
+func (g *Graph[T]) PrimMST(start T) ([][2]T, float64) {
+	if !g.HasNode(start) {
+		return nil, 0
+	}
+
 	visited := make(map[T]bool)
 	var mst [][2]T
-	queue := []T{start}
-	visited[start] = true
+	total := 0.0
 
-	for len(queue) > 0 {
-		node := queue[0]
-		queue = queue[1:]
+	pq := NewPriorityQueue[weightedEdge[T]](func(a, b weightedEdge[T]) bool {
+		return a.weight < b.weight
+	})
 
-		for _, neighbor := range g.GetNeighbors(node) {
+	// visit marks a node as part of the tree and queues its outgoing edges
+	visit := func(node T) {
+		visited[node] = true
+		for _, neighbor := range g.adjacency[node] {
 			if !visited[neighbor] {
-				mst = append(mst, [2]T{node, neighbor})
-				visited[neighbor] = true
-				queue = append(queue, neighbor)
+				pq.Enqueue(weightedEdge[T]{from: node, to: neighbor, weight: g.edgeWeight(node, neighbor)})
+			}
+		}
+	}
+
+	visit(start)
+	for !pq.IsEmpty() && len(mst) < len(g.adjacency)-1 {
+		edge, _ := pq.Dequeue()
+		if visited[edge.to] {
+			continue
+		}
+		mst = append(mst, [2]T{edge.from, edge.to})
+		total += edge.weight
+		visit(edge.to)
+	}
+
+	return mst, total
+}
+
+// KruskalMST computes a minimum spanning forest using Kruskal's algorithm and returns its
+// edges together with the total weight. Unweighted edges count as 1.
+func (g *Graph[T]) KruskalMST() ([][2]T, float64) {
+	var edges []weightedEdge[T]
+	for from, neighbors := range g.adjacency {
+		for _, to := range neighbors {
+			edges = append(edges, weightedEdge[T]{from: from, to: to, weight: g.edgeWeight(from, to)})
+		}
+	}
+	sort.SliceStable(edges, func(i, j int) bool {
+		return edges[i].weight < edges[j].weight
+	})
+
+	ds := NewDisjointSet[T]()
+	for node := range g.adjacency {
+		ds.MakeSet(node)
+	}
+
+	var mst [][2]T
+	total := 0.0
+	for _, edge := range edges {
+		if ds.Union(edge.from, edge.to) {
+			mst = append(mst, [2]T{edge.from, edge.to})
+			total += edge.weight
+			if ds.SetCount() == 1 {
+				break
 			}
 		}
 	}
 
-	return mst
+	return mst, total
 }
 
 // Filter returns a slice of nodes that satisfy the predicate, along with their degree.
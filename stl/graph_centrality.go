@@ -0,0 +1,159 @@
+package stl
+
+// PageRank computes the PageRank of every node by power iteration. The damping factor is
+// usually 0.85. Rank from nodes without outgoing edges is spread evenly over all nodes, so
+// the scores always sum to 1. Undirected edges count in both directions.
+func (g *Graph[T]) PageRank(damping float64, iterations int) map[T]float64 {
+	n := len(g.adjacency)
+	rank := make(map[T]float64, n)
+	if n == 0 {
+		return rank
+	}
+
+	for node := range g.adjacency {
+		rank[node] = 1 / float64(n)
+	}
+
+	for i := 0; i < iterations; i++ {
+		dangling := 0.0
+		for node, neighbors := range g.adjacency {
+			if len(neighbors) == 0 {
+				dangling += rank[node]
+			}
+		}
+
+		base := (1-damping)/float64(n) + damping*dangling/float64(n)
+		next := make(map[T]float64, n)
+		for node := range g.adjacency {
+			next[node] = base
+		}
+		for node, neighbors := range g.adjacency {
+			if len(neighbors) == 0 {
+				continue
+			}
+			share := damping * rank[node] / float64(len(neighbors))
+			for _, neighbor := range neighbors {
+				next[neighbor] += share
+			}
+		}
+		rank = next
+	}
+
+	return rank
+}
+
+// DegreeCentrality returns the degree of every node divided by n-1, the largest possible
+// degree. For directed graphs the out-degree is used.
+func (g *Graph[T]) DegreeCentrality() map[T]float64 {
+	result := make(map[T]float64, len(g.adjacency))
+	if len(g.adjacency) <= 1 {
+		for node := range g.adjacency {
+			result[node] = 0
+		}
+		return result
+	}
+
+	scale := 1 / float64(len(g.adjacency)-1)
+	for node, neighbors := range g.adjacency {
+		result[node] = float64(len(neighbors)) * scale
+	}
+	return result
+}
+
+// ClosenessCentrality returns, for every node, the number of other nodes it reaches divided
+// by the sum of their BFS distances. Isolated nodes score 0. For directed graphs distances
+// follow outgoing edges.
+func (g *Graph[T]) ClosenessCentrality() map[T]float64 {
+	result := make(map[T]float64, len(g.adjacency))
+
+	for node := range g.adjacency {
+		dist := g.bfsDistances(node)
+		total := 0
+		for _, d := range dist {
+			total += d
+		}
+		if total == 0 {
+			result[node] = 0
+			continue
+		}
+		result[node] = float64(len(dist)-1) / float64(total)
+	}
+
+	return result
+}
+
+// bfsDistances returns the hop distance from start to every reachable node.
+func (g *Graph[T]) bfsDistances(start T) map[T]int {
+	dist := map[T]int{start: 0}
+	queue := []T{start}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, neighbor := range g.adjacency[node] {
+			if _, seen := dist[neighbor]; !seen {
+				dist[neighbor] = dist[node] + 1
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+
+	return dist
+}
+
+// BetweennessCentrality returns, for every node, the number of shortest paths between other
+// pairs of nodes that pass through it, computed with Brandes' algorithm on unweighted edges.
+// Paths split between several shortest routes contribute fractionally. For undirected graphs
+// each pair is counted once.
+func (g *Graph[T]) BetweennessCentrality() map[T]float64 {
+	result := make(map[T]float64, len(g.adjacency))
+	for node := range g.adjacency {
+		result[node] = 0
+	}
+
+	for source := range g.adjacency {
+		// Single-source shortest paths, recording visit order and predecessors
+		var order []T
+		predecessors := make(map[T][]T)
+		paths := map[T]float64{source: 1}
+		dist := map[T]int{source: 0}
+		queue := []T{source}
+
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			order = append(order, node)
+
+			for _, neighbor := range g.adjacency[node] {
+				if _, seen := dist[neighbor]; !seen {
+					dist[neighbor] = dist[node] + 1
+					queue = append(queue, neighbor)
+				}
+				if dist[neighbor] == dist[node]+1 {
+					paths[neighbor] += paths[node]
+					predecessors[neighbor] = append(predecessors[neighbor], node)
+				}
+			}
+		}
+
+		// Accumulate dependencies in reverse BFS order
+		dependency := make(map[T]float64, len(order))
+		for i := len(order) - 1; i >= 0; i-- {
+			node := order[i]
+			for _, pred := range predecessors[node] {
+				dependency[pred] += paths[pred] / paths[node] * (1 + dependency[node])
+			}
+			if node != source {
+				result[node] += dependency[node]
+			}
+		}
+	}
+
+	if !g.directed {
+		for node := range result {
+			result[node] /= 2
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,70 @@
+package stl
+
+import (
+	"math"
+	"testing"
+)
+
+// approxEqual reports whether two floats are within a small tolerance.
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+func TestGraphPageRank(t *testing.T) {
+	// Star pointing at the hub: the hub should rank highest
+	graph := NewGraphFromEdges([][2]string{{"a", "hub"}, {"b", "hub"}, {"c", "hub"}}, true)
+	rank := graph.PageRank(0.85, 50)
+
+	total := 0.0
+	for _, score := range rank {
+		total += score
+	}
+	if !approxEqual(total, 1) {
+		t.Errorf("PageRank scores should sum to 1, got %v", total)
+	}
+	if rank["hub"] <= rank["a"] {
+		t.Errorf("Hub should outrank leaves, got %v", rank)
+	}
+	if !approxEqual(rank["a"], rank["b"]) {
+		t.Errorf("Symmetric leaves should have equal rank, got %v", rank)
+	}
+
+	cycle := NewGraphFromEdges([][2]int{{1, 2}, {2, 3}, {3, 1}}, true)
+	for node, score := range cycle.PageRank(0.85, 20) {
+		if !approxEqual(score, 1.0/3) {
+			t.Errorf("Cycle node %d should have rank 1/3, got %v", node, score)
+		}
+	}
+}
+
+func TestGraphDegreeAndClosenessCentrality(t *testing.T) {
+	// Path a - b - c
+	graph := NewGraphFromEdges([][2]string{{"a", "b"}, {"b", "c"}}, false)
+
+	degree := graph.DegreeCentrality()
+	if degree["b"] != 1 || degree["a"] != 0.5 {
+		t.Errorf("Unexpected degree centrality %v", degree)
+	}
+
+	closeness := graph.ClosenessCentrality()
+	if closeness["b"] != 1 || !approxEqual(closeness["a"], 2.0/3) {
+		t.Errorf("Unexpected closeness centrality %v", closeness)
+	}
+}
+
+func TestGraphBetweennessCentrality(t *testing.T) {
+	// Path 1 - 2 - 3 - 4: node 2 lies on paths 1-3 and 1-4
+	path := NewGraphFromEdges([][2]int{{1, 2}, {2, 3}, {3, 4}}, false)
+	betweenness := path.BetweennessCentrality()
+	if betweenness[1] != 0 || betweenness[2] != 2 || betweenness[3] != 2 {
+		t.Errorf("Unexpected path betweenness %v", betweenness)
+	}
+
+	// Square: each node splits one shortest path with its opposite corner
+	square := NewGraphFromEdges([][2]int{{1, 2}, {2, 3}, {3, 4}, {4, 1}}, false)
+	for node, score := range square.BetweennessCentrality() {
+		if !approxEqual(score, 0.5) {
+			t.Errorf("Square node %d should have betweenness 0.5, got %v", node, score)
+		}
+	}
+}
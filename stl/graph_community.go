@@ -0,0 +1,128 @@
+package stl
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// maxLabelPropagationRounds bounds the number of passes Communities makes over the nodes.
+const maxLabelPropagationRounds = 100
+
+// labelPropagationSeed seeds the visiting order and tie-breaking of Communities so results are
+// reproducible.
+const labelPropagationSeed = 1
+
+// Communities groups nodes into communities using label propagation. Every node starts in its
+// own community and repeatedly adopts the label carrying the most edge weight among its
+// neighbors until no label changes. Edge direction is ignored. Nodes are visited in shuffled
+// order and ties are broken at random, but with a fixed seed, so the result is deterministic.
+// Communities and their members are ordered by the %v form of their nodes.
+func (g *Graph[T]) Communities() [][]T {
+	nodes := g.sortedNodes()
+	index := make(map[T]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+
+	// Collect undirected weighted neighborhoods by node index
+	neighbors := make([]map[int]float64, len(nodes))
+	for i := range neighbors {
+		neighbors[i] = make(map[int]float64)
+	}
+	for from, targets := range g.adjacency {
+		for _, to := range targets {
+			if from == to {
+				continue
+			}
+			weight := g.edgeWeight(from, to)
+			neighbors[index[from]][index[to]] += weight
+			if g.directed {
+				neighbors[index[to]][index[from]] += weight
+			}
+		}
+	}
+
+	labels := make([]int, len(nodes))
+	for i := range labels {
+		labels[i] = i
+	}
+
+	r := rand.New(rand.NewSource(labelPropagationSeed))
+	order := make([]int, len(nodes))
+	for i := range order {
+		order[i] = i
+	}
+
+	for round := 0; round < maxLabelPropagationRounds; round++ {
+		r.Shuffle(len(order), func(i, j int) {
+			order[i], order[j] = order[j], order[i]
+		})
+
+		changed := false
+		for _, i := range order {
+			if len(neighbors[i]) == 0 {
+				continue
+			}
+
+			// Sum weight per label, remembering labels in first-seen order for stable tie-breaking
+			score := make(map[int]float64)
+			var candidates []int
+			for _, j := range sortedKeys(neighbors[i]) {
+				if _, seen := score[labels[j]]; !seen {
+					candidates = append(candidates, labels[j])
+				}
+				score[labels[j]] += neighbors[i][j]
+			}
+
+			bestScore := math.Inf(-1)
+			var best []int
+			for _, label := range candidates {
+				switch {
+				case score[label] > bestScore:
+					bestScore = score[label]
+					best = append(best[:0], label)
+				case score[label] == bestScore:
+					best = append(best, label)
+				}
+			}
+
+			// Keep the current label whenever it is among the best to help convergence. With
+			// negative weights it can score above every candidate, including when no neighbor
+			// shares it and it scores 0.
+			if score[labels[i]] >= bestScore {
+				continue
+			}
+			labels[i] = best[r.Intn(len(best))]
+			changed = true
+		}
+		if !changed {
+			break
+		}
+	}
+
+	// Group nodes by label, keeping communities in order of their first member
+	var communities [][]T
+	position := make(map[int]int)
+	for i, node := range nodes {
+		p, exists := position[labels[i]]
+		if !exists {
+			p = len(communities)
+			position[labels[i]] = p
+			communities = append(communities, nil)
+		}
+		communities[p] = append(communities[p], node)
+	}
+
+	return communities
+}
+
+// sortedKeys returns the keys of m in increasing order.
+func sortedKeys(m map[int]float64) []int {
+	keys := make([]int, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	return keys
+}
@@ -0,0 +1,74 @@
+package stl
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGraphCommunities(t *testing.T) {
+	// Two triangles joined by a single bridge
+	graph := NewGraphFromEdges([][2]int{
+		{1, 2}, {2, 3}, {3, 1},
+		{4, 5}, {5, 6}, {6, 4},
+		{3, 4},
+	}, false)
+
+	communities := graph.Communities()
+	if len(communities) != 2 {
+		t.Fatalf("Expected 2 communities, got %v", communities)
+	}
+	for _, community := range communities {
+		if len(community) != 3 {
+			t.Errorf("Expected communities of 3 nodes, got %v", communities)
+		}
+	}
+	if !containsNode(communities[0], 1) || !containsNode(communities[0], 2) || !containsNode(communities[0], 3) {
+		t.Errorf("Expected first community {1, 2, 3}, got %v", communities[0])
+	}
+
+	again := graph.Communities()
+	for i := range communities {
+		for j := range communities[i] {
+			if communities[i][j] != again[i][j] {
+				t.Fatalf("Expected deterministic communities, got %v and %v", communities, again)
+			}
+		}
+	}
+}
+
+func TestGraphCommunitiesIsolatedAndDirected(t *testing.T) {
+	graph := NewGraph[string](true)
+	graph.AddEdge("a", "b")
+	graph.AddEdge("c", "b")
+	graph.AddNode("lonely")
+
+	communities := graph.Communities()
+	if len(communities) != 2 {
+		t.Fatalf("Expected 2 communities, got %v", communities)
+	}
+	if len(communities[0]) != 3 || len(communities[1]) != 1 || communities[1][0] != "lonely" {
+		t.Errorf("Expected {a, b, c} and {lonely}, got %v", communities)
+	}
+
+	if communities := NewGraph[int](false).Communities(); len(communities) != 0 {
+		t.Errorf("Expected no communities for empty graph, got %v", communities)
+	}
+}
+
+func TestGraphCommunitiesNegativeWeights(t *testing.T) {
+	graph := NewGraph[string](false)
+	graph.AddWeightedEdge("a", "b", 1)
+	graph.AddWeightedEdge("b", "c", 10)
+	graph.AddWeightedEdge("a", "c", -5)
+
+	// Negative weights can leave every candidate label scoring below zero, which used to
+	// panic choosing among no best labels
+	communities := graph.Communities()
+	together := false
+	for _, community := range communities {
+		together = together || slices.Contains(community, "b") && slices.Contains(community, "c")
+	}
+	if !together {
+		t.Errorf("Expected b and c in one community, got %v", communities)
+	}
+}
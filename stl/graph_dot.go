@@ -0,0 +1,440 @@
+package stl
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// dotConfig holds the settings used by Graph.ToDOT.
+type dotConfig[T comparable] struct {
+	name      string
+	nodeID    func(T) string
+	nodeLabel func(T) string
+	edgeLabel func(from, to T) string
+}
+
+// DOTOption configures the output of Graph.ToDOT.
+type DOTOption[T comparable] func(*dotConfig[T])
+
+// WithDOTName sets the graph name written in the DOT header.
+func WithDOTName[T comparable](name string) DOTOption[T] {
+	return func(c *dotConfig[T]) {
+		c.name = name
+	}
+}
+
+// WithDOTNodeID sets a function producing the DOT ID of each node, for node types whose %v
+// formatting is not unique.
+func WithDOTNodeID[T comparable](id func(T) string) DOTOption[T] {
+	return func(c *dotConfig[T]) {
+		c.nodeID = id
+	}
+}
+
+// WithDOTNodeLabel sets a function producing the label attribute of each node.
+func WithDOTNodeLabel[T comparable](label func(T) string) DOTOption[T] {
+	return func(c *dotConfig[T]) {
+		c.nodeLabel = label
+	}
+}
+
+// WithDOTEdgeLabel sets a function producing the label attribute of each edge.
+func WithDOTEdgeLabel[T comparable](label func(from, to T) string) DOTOption[T] {
+	return func(c *dotConfig[T]) {
+		c.edgeLabel = label
+	}
+}
+
+// ToDOT writes the graph in Graphviz DOT format. Node IDs are formatted with %v unless
+// WithDOTNodeID is given, nodes are written in sorted order for stable output, and explicitly
+// weighted edges carry a weight attribute. It returns an error without writing anything if
+// two nodes get the same ID.
+func (g *Graph[T]) ToDOT(w io.Writer, opts ...DOTOption[T]) error {
+	config := &dotConfig[T]{
+		name:   "G",
+		nodeID: func(node T) string { return fmt.Sprintf("%v", node) },
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	kind, edgeOp := "graph", "--"
+	if g.directed {
+		kind, edgeOp = "digraph", "->"
+	}
+
+	nodes := g.sortedNodes()
+
+	// Distinct nodes with one ID would be merged by Graphviz
+	ids := make(map[T]string, len(nodes))
+	owners := make(map[string]T, len(nodes))
+	for _, node := range nodes {
+		id := config.nodeID(node)
+		if other, exists := owners[id]; exists {
+			return fmt.Errorf("dot: nodes %#v and %#v have the same ID %q", other, node, id)
+		}
+		ids[node] = id
+		owners[id] = node
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %s {\n", kind, dotQuote(config.name))
+
+	for _, node := range nodes {
+		sb.WriteString("  " + dotQuote(ids[node]))
+		if config.nodeLabel != nil {
+			sb.WriteString(" [label=" + dotQuote(config.nodeLabel(node)) + "]")
+		}
+		sb.WriteString(";\n")
+	}
+
+	for _, edge := range g.edgeList(nodes) {
+		from, to := edge[0], edge[1]
+
+		var attrs []string
+		if weight, exists := g.weights[from][to]; exists {
+			attrs = append(attrs, "weight="+strconv.FormatFloat(weight, 'g', -1, 64))
+		}
+		if config.edgeLabel != nil {
+			attrs = append(attrs, "label="+dotQuote(config.edgeLabel(from, to)))
+		}
+
+		fmt.Fprintf(&sb, "  %s %s %s", dotQuote(ids[from]), edgeOp, dotQuote(ids[to]))
+		if len(attrs) > 0 {
+			sb.WriteString(" [" + strings.Join(attrs, ", ") + "]")
+		}
+		sb.WriteString(";\n")
+	}
+
+	sb.WriteString("}\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// dotQuote returns s as a quoted DOT identifier.
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
+
+// DOTLabels holds the node and edge labels read by ParseDOTWithLabels.
+type DOTLabels struct {
+	Nodes map[string]string
+	Edges map[[2]string]string
+}
+
+// ParseDOT reads a graph in Graphviz DOT format. Node IDs become strings and numeric weight
+// attributes on edges become edge weights. Subgraphs and HTML labels are not supported.
+func ParseDOT(r io.Reader) (*Graph[string], error) {
+	graph, _, err := ParseDOTWithLabels(r)
+	return graph, err
+}
+
+// ParseDOTWithLabels reads a graph in DOT format like ParseDOT and additionally returns the
+// label attributes of nodes and edges.
+func ParseDOTWithLabels(r io.Reader) (*Graph[string], *DOTLabels, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokens, err := tokenizeDOT(string(data))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := &dotParser{
+		tokens: tokens,
+		labels: &DOTLabels{
+			Nodes: make(map[string]string),
+			Edges: make(map[[2]string]string),
+		},
+	}
+	if err := p.parse(); err != nil {
+		return nil, nil, err
+	}
+	return p.graph, p.labels, nil
+}
+
+// dotToken is a lexical token of the DOT language.
+type dotToken struct {
+	text   string
+	quoted bool
+}
+
+// tokenizeDOT splits DOT source into tokens, dropping comments and whitespace.
+func tokenizeDOT(src string) ([]dotToken, error) {
+	var tokens []dotToken
+	runes := []rune(src)
+
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '#' || (c == '/' && i+1 < len(runes) && runes[i+1] == '/'):
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			end := i + 2
+			for end+1 < len(runes) && (runes[end] != '*' || runes[end+1] != '/') {
+				end++
+			}
+			if end+1 >= len(runes) {
+				return nil, fmt.Errorf("dot: unterminated comment")
+			}
+			i = end + 2
+		case isDOTEdgeOp(runes, i):
+			tokens = append(tokens, dotToken{text: string(runes[i : i+2])})
+			i += 2
+		case strings.ContainsRune("{}[];,=", c):
+			tokens = append(tokens, dotToken{text: string(c)})
+			i++
+		case c == '"':
+			var sb strings.Builder
+			i++
+			for i < len(runes) && runes[i] != '"' {
+				if runes[i] == '\\' && i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '\\') {
+					i++
+				}
+				sb.WriteRune(runes[i])
+				i++
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("dot: unterminated string")
+			}
+			i++
+			tokens = append(tokens, dotToken{text: sb.String(), quoted: true})
+		case c == '<':
+			return nil, fmt.Errorf("dot: HTML strings are not supported")
+		case isDOTIDRune(c):
+			start := i
+			for i < len(runes) && isDOTIDRune(runes[i]) && !isDOTEdgeOp(runes, i) {
+				i++
+			}
+			tokens = append(tokens, dotToken{text: string(runes[start:i])})
+		default:
+			return nil, fmt.Errorf("dot: unexpected character %q", c)
+		}
+	}
+
+	return tokens, nil
+}
+
+// isDOTEdgeOp reports whether an edge operator (-> or --) starts at position i.
+func isDOTEdgeOp(runes []rune, i int) bool {
+	return runes[i] == '-' && i+1 < len(runes) && (runes[i+1] == '>' || runes[i+1] == '-')
+}
+
+// isDOTIDRune reports whether c may appear in an unquoted DOT identifier or numeral.
+func isDOTIDRune(c rune) bool {
+	return c == '_' || c == '.' || c == '-' || unicode.IsLetter(c) || unicode.IsDigit(c)
+}
+
+// dotParser builds a graph from a DOT token stream.
+type dotParser struct {
+	tokens []dotToken
+	pos    int
+	graph  *Graph[string]
+	labels *DOTLabels
+}
+
+// peek returns the current token without consuming it.
+func (p *dotParser) peek() (dotToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return dotToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+// next consumes and returns the current token.
+func (p *dotParser) next() (dotToken, error) {
+	tok, ok := p.peek()
+	if !ok {
+		return tok, fmt.Errorf("dot: unexpected end of input")
+	}
+	p.pos++
+	return tok, nil
+}
+
+// accept consumes the current token if it is the given unquoted symbol.
+func (p *dotParser) accept(symbol string) bool {
+	if tok, ok := p.peek(); ok && !tok.quoted && tok.text == symbol {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// skipSeparators consumes any ';' or ',' tokens.
+func (p *dotParser) skipSeparators() {
+	for p.accept(";") || p.accept(",") {
+		continue
+	}
+}
+
+// isKeyword reports whether tok is the given case-insensitive DOT keyword.
+func isKeyword(tok dotToken, keyword string) bool {
+	return !tok.quoted && strings.EqualFold(tok.text, keyword)
+}
+
+// parse parses a complete DOT graph.
+func (p *dotParser) parse() error {
+	tok, err := p.next()
+	if err != nil {
+		return err
+	}
+	if isKeyword(tok, "strict") {
+		if tok, err = p.next(); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case isKeyword(tok, "graph"):
+		p.graph = NewGraph[string](false)
+	case isKeyword(tok, "digraph"):
+		p.graph = NewGraph[string](true)
+	default:
+		return fmt.Errorf("dot: expected graph or digraph, got %q", tok.text)
+	}
+
+	if !p.accept("{") {
+		if _, err := p.id(); err != nil {
+			return err
+		}
+		if !p.accept("{") {
+			return fmt.Errorf("dot: expected '{'")
+		}
+	}
+
+	for !p.accept("}") {
+		if err := p.statement(); err != nil {
+			return err
+		}
+		p.skipSeparators()
+	}
+
+	if _, ok := p.peek(); ok {
+		return fmt.Errorf("dot: unexpected content after closing '}'")
+	}
+	return nil
+}
+
+// id consumes an identifier token.
+func (p *dotParser) id() (string, error) {
+	tok, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	if !tok.quoted && (strings.ContainsAny(tok.text, "{}[];,=") || tok.text == "->" || tok.text == "--") {
+		return "", fmt.Errorf("dot: expected identifier, got %q", tok.text)
+	}
+	return tok.text, nil
+}
+
+// statement parses a single node, edge, or attribute statement.
+func (p *dotParser) statement() error {
+	tok, ok := p.peek()
+	if !ok {
+		return fmt.Errorf("dot: missing closing '}'")
+	}
+
+	switch {
+	case isKeyword(tok, "subgraph") || (!tok.quoted && tok.text == "{"):
+		return fmt.Errorf("dot: subgraphs are not supported")
+	case isKeyword(tok, "graph") || isKeyword(tok, "node") || isKeyword(tok, "edge"):
+		p.pos++
+		_, err := p.attributes()
+		return err
+	}
+
+	first, err := p.id()
+	if err != nil {
+		return err
+	}
+
+	// Graph attribute assignment such as rankdir=LR
+	if p.accept("=") {
+		_, err := p.id()
+		return err
+	}
+
+	chain := []string{first}
+	for {
+		tok, ok := p.peek()
+		if !ok || tok.quoted || (tok.text != "->" && tok.text != "--") {
+			break
+		}
+		if (tok.text == "->") != p.graph.directed {
+			return fmt.Errorf("dot: edge operator %q does not match graph type", tok.text)
+		}
+		p.pos++
+		if p.accept("{") {
+			return fmt.Errorf("dot: subgraphs are not supported")
+		}
+		to, err := p.id()
+		if err != nil {
+			return err
+		}
+		chain = append(chain, to)
+	}
+
+	attrs, err := p.attributes()
+	if err != nil {
+		return err
+	}
+
+	if len(chain) == 1 {
+		p.graph.AddNode(first)
+		if label, exists := attrs["label"]; exists {
+			p.labels.Nodes[first] = label
+		}
+		return nil
+	}
+
+	for i := 0; i+1 < len(chain); i++ {
+		from, to := chain[i], chain[i+1]
+		if raw, exists := attrs["weight"]; exists {
+			weight, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				return fmt.Errorf("dot: invalid weight %q", raw)
+			}
+			p.graph.AddWeightedEdge(from, to, weight)
+		} else {
+			p.graph.AddEdge(from, to)
+		}
+		if label, exists := attrs["label"]; exists {
+			p.labels.Edges[[2]string{from, to}] = label
+		}
+	}
+	return nil
+}
+
+// attributes parses zero or more bracketed attribute lists.
+func (p *dotParser) attributes() (map[string]string, error) {
+	attrs := make(map[string]string)
+	for p.accept("[") {
+		for !p.accept("]") {
+			key, err := p.id()
+			if err != nil {
+				return nil, err
+			}
+			value := "true"
+			if p.accept("=") {
+				if value, err = p.id(); err != nil {
+					return nil, err
+				}
+			}
+			attrs[key] = value
+			p.skipSeparators()
+		}
+	}
+	return attrs, nil
+}
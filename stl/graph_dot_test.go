@@ -0,0 +1,126 @@
+package stl
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestGraphToDOT(t *testing.T) {
+	graph := NewGraph[int](true)
+	graph.AddEdge(1, 2)
+	graph.AddWeightedEdge(2, 3, 1.5)
+
+	var sb strings.Builder
+	err := graph.ToDOT(&sb, WithDOTName[int]("deps"), WithDOTNodeLabel(func(n int) string {
+		return "n" + string(rune('0'+n))
+	}))
+	if err != nil {
+		t.Fatalf("ToDOT failed: %v", err)
+	}
+
+	expected := `digraph "deps" {
+  "1" [label="n1"];
+  "2" [label="n2"];
+  "3" [label="n3"];
+  "1" -> "2";
+  "2" -> "3" [weight=1.5];
+}
+`
+	if sb.String() != expected {
+		t.Errorf("Unexpected DOT output:\n%s", sb.String())
+	}
+}
+
+func TestGraphToDOTNodeIDs(t *testing.T) {
+	// 1 and "1" both format as 1 with %v
+	graph := NewGraph[any](false)
+	graph.AddEdge(1, "1")
+
+	var sb strings.Builder
+	if err := graph.ToDOT(&sb); err == nil || !strings.Contains(err.Error(), "same ID") {
+		t.Errorf("Expected an ID collision error, got %v", err)
+	}
+	if sb.Len() != 0 {
+		t.Errorf("Expected nothing written on error, got:\n%s", sb.String())
+	}
+
+	err := graph.ToDOT(&sb, WithDOTNodeID(func(node any) string { return fmt.Sprintf("%T:%v", node, node) }))
+	if err != nil {
+		t.Fatalf("ToDOT failed: %v", err)
+	}
+	if !strings.Contains(sb.String(), `"int:1" -- "string:1"`) && !strings.Contains(sb.String(), `"string:1" -- "int:1"`) {
+		t.Errorf("Expected custom node IDs, got:\n%s", sb.String())
+	}
+}
+
+func TestGraphDOTRoundTrip(t *testing.T) {
+	graph := NewGraph[string](false)
+	graph.AddWeightedEdge("a", "b", 2)
+	graph.AddEdge("b", `say "hi"`)
+	graph.AddNode("lonely")
+
+	var sb strings.Builder
+	if err := graph.ToDOT(&sb); err != nil {
+		t.Fatalf("ToDOT failed: %v", err)
+	}
+	if strings.Count(sb.String(), "--") != 2 {
+		t.Errorf("Undirected edges should be written once, got:\n%s", sb.String())
+	}
+
+	parsed, err := ParseDOT(strings.NewReader(sb.String()))
+	if err != nil {
+		t.Fatalf("ParseDOT failed: %v", err)
+	}
+	if !parsed.Equals(graph) {
+		t.Errorf("Round trip mismatch: %v vs %v", parsed, graph)
+	}
+	if w, _ := parsed.GetWeight("b", "a"); w != 2 {
+		t.Errorf("Expected weight 2 after round trip, got %v", w)
+	}
+}
+
+func TestParseDOTWithLabels(t *testing.T) {
+	src := `
+	/* pipeline */
+	strict digraph build {
+		rankdir=LR; node [shape=box]
+		fetch [label="Fetch sources"]
+		fetch -> compile -> test [label=next, weight=3] // chained
+		# deploy is standalone
+		deploy
+	}`
+
+	graph, labels, err := ParseDOTWithLabels(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("ParseDOTWithLabels failed: %v", err)
+	}
+	if !graph.IsDirected() || graph.NodeCount() != 4 || graph.EdgeCount() != 2 {
+		t.Errorf("Unexpected graph %v", graph)
+	}
+	if w, ok := graph.GetWeight("compile", "test"); !ok || w != 3 {
+		t.Errorf("Expected weight 3 on compile->test, got %v", w)
+	}
+	if labels.Nodes["fetch"] != "Fetch sources" {
+		t.Errorf("Expected node label, got %v", labels.Nodes)
+	}
+	if labels.Edges[[2]string{"fetch", "compile"}] != "next" {
+		t.Errorf("Expected edge label, got %v", labels.Edges)
+	}
+}
+
+func TestParseDOTErrors(t *testing.T) {
+	inputs := []string{
+		`graph { a -> b }`,
+		`digraph { subgraph x { a } }`,
+		`digraph { a -> b [weight=heavy] }`,
+		`digraph { a -> b`,
+		`tree { }`,
+		`digraph { "unterminated }`,
+	}
+	for _, input := range inputs {
+		if _, err := ParseDOT(strings.NewReader(input)); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
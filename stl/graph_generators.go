@@ -0,0 +1,116 @@
+package stl
+
+import (
+	"math/rand"
+	"time"
+)
+
+// newRand returns a generator for src, seeding a new source from the clock when src is nil.
+func newRand(src rand.Source) *rand.Rand {
+	if src == nil {
+		src = rand.NewSource(time.Now().UnixNano())
+	}
+	return rand.New(src)
+}
+
+// GenerateRandomGraph creates an undirected Erdős–Rényi graph with nodes 0..n-1 where each
+// possible edge is present independently with probability p. A nil src uses a time-seeded source.
+func GenerateRandomGraph(n int, p float64, src rand.Source) *Graph[int] {
+	r := newRand(src)
+	graph := NewGraph[int](false)
+
+	for i := 0; i < n; i++ {
+		graph.AddNode(i)
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if r.Float64() < p {
+				graph.AddEdge(i, j)
+			}
+		}
+	}
+
+	return graph
+}
+
+// GenerateCompleteGraph creates an undirected graph with nodes 0..n-1 and an edge between
+// every pair of distinct nodes.
+func GenerateCompleteGraph(n int) *Graph[int] {
+	graph := NewGraph[int](false)
+
+	for i := 0; i < n; i++ {
+		graph.AddNode(i)
+		for j := 0; j < i; j++ {
+			graph.AddEdge(j, i)
+		}
+	}
+
+	return graph
+}
+
+// GenerateGridGraph creates an undirected rows x cols lattice. The node at row r and
+// column c is r*cols + c, and it is connected to its horizontal and vertical neighbors.
+func GenerateGridGraph(rows, cols int) *Graph[int] {
+	graph := NewGraph[int](false)
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			node := r*cols + c
+			graph.AddNode(node)
+			if c > 0 {
+				graph.AddEdge(node-1, node)
+			}
+			if r > 0 {
+				graph.AddEdge(node-cols, node)
+			}
+		}
+	}
+
+	return graph
+}
+
+// GenerateTree creates a uniformly random labeled tree on nodes 0..n-1 by decoding a random
+// Prüfer sequence. A nil src uses a time-seeded source.
+func GenerateTree(n int, src rand.Source) *Graph[int] {
+	graph := NewGraph[int](false)
+	if n <= 0 {
+		return graph
+	}
+	graph.AddNode(0)
+	if n == 1 {
+		return graph
+	}
+
+	r := newRand(src)
+	sequence := make([]int, n-2)
+	degree := make([]int, n)
+	for i := range degree {
+		degree[i] = 1
+	}
+	for i := range sequence {
+		sequence[i] = r.Intn(n)
+		degree[sequence[i]]++
+	}
+
+	// Repeatedly join the smallest leaf to the next node of the sequence
+	leaves := NewPriorityQueue[int](func(a, b int) bool { return a < b })
+	for node, d := range degree {
+		if d == 1 {
+			leaves.Enqueue(node)
+		}
+	}
+	for _, node := range sequence {
+		leaf, _ := leaves.Dequeue()
+		graph.AddEdge(leaf, node)
+		degree[node]--
+		if degree[node] == 1 {
+			leaves.Enqueue(node)
+		}
+	}
+
+	u, _ := leaves.Dequeue()
+	v, _ := leaves.Dequeue()
+	graph.AddEdge(u, v)
+
+	return graph
+}
@@ -0,0 +1,58 @@
+package stl
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestGenerateRandomGraph(t *testing.T) {
+	graph := GenerateRandomGraph(50, 0.2, rand.NewSource(1))
+	if graph.NodeCount() != 50 {
+		t.Errorf("Expected 50 nodes, got %d", graph.NodeCount())
+	}
+
+	again := GenerateRandomGraph(50, 0.2, rand.NewSource(1))
+	if !graph.Equals(again) {
+		t.Error("Same seed should produce the same graph")
+	}
+
+	if GenerateRandomGraph(10, 0, nil).EdgeCount() != 0 {
+		t.Error("p = 0 should produce no edges")
+	}
+	if GenerateRandomGraph(10, 1, nil).EdgeCount() != 45 {
+		t.Error("p = 1 should produce a complete graph")
+	}
+}
+
+func TestGenerateCompleteAndGridGraph(t *testing.T) {
+	complete := GenerateCompleteGraph(6)
+	if complete.EdgeCount() != 15 || complete.Degree(3) != 5 {
+		t.Errorf("Unexpected complete graph %v", complete)
+	}
+
+	grid := GenerateGridGraph(3, 4)
+	if grid.NodeCount() != 12 || grid.EdgeCount() != 3*3+2*4 {
+		t.Errorf("Unexpected grid graph %v", grid)
+	}
+	if !grid.HasEdge(5, 6) || !grid.HasEdge(5, 9) || grid.HasEdge(3, 4) {
+		t.Error("Grid edges should connect only horizontal and vertical neighbors")
+	}
+	if grid.Degree(0) != 2 || grid.Degree(5) != 4 {
+		t.Errorf("Unexpected grid degrees %d and %d", grid.Degree(0), grid.Degree(5))
+	}
+}
+
+func TestGenerateTree(t *testing.T) {
+	for n := 0; n <= 30; n++ {
+		tree := GenerateTree(n, rand.NewSource(int64(n)))
+		if tree.NodeCount() != n {
+			t.Fatalf("Expected %d nodes, got %d", n, tree.NodeCount())
+		}
+		if n == 0 {
+			continue
+		}
+		if tree.EdgeCount() != n-1 || !tree.IsConnected() {
+			t.Errorf("Tree on %d nodes should be connected with %d edges, got %v", n, n-1, tree)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package stl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGraphWriteGraphML(t *testing.T) {
+	graph := NewGraph[int](true)
+	graph.AddWeightedEdge(1, 2, 0.5)
+	graph.AddEdge(2, 3)
+
+	var sb strings.Builder
+	if err := graph.WriteGraphML(&sb); err != nil {
+		t.Fatalf("WriteGraphML failed: %v", err)
+	}
+
+	out := sb.String()
+	for _, fragment := range []string{
+		`<graphml xmlns="http://graphml.graphdrawing.org/xmlns">`,
+		`<key id="weight" for="edge" attr.name="weight" attr.type="double"></key>`,
+		`<graph id="G" edgedefault="directed">`,
+		`<node id="3"></node>`,
+		`<data key="weight">0.5</data>`,
+	} {
+		if !strings.Contains(out, fragment) {
+			t.Errorf("Expected output to contain %s, got:\n%s", fragment, out)
+		}
+	}
+}
+
+func TestGraphMLRoundTrip(t *testing.T) {
+	graph := NewGraph[string](false)
+	graph.AddWeightedEdge("a", "b", 3)
+	graph.AddEdge("b", "c")
+	graph.AddNode("d")
+
+	var sb strings.Builder
+	if err := graph.WriteGraphML(&sb); err != nil {
+		t.Fatalf("WriteGraphML failed: %v", err)
+	}
+
+	parsed, err := ReadGraphML(strings.NewReader(sb.String()))
+	if err != nil {
+		t.Fatalf("ReadGraphML failed: %v", err)
+	}
+	if !parsed.Equals(graph) {
+		t.Errorf("Round trip mismatch: %v vs %v", parsed, graph)
+	}
+	if w, _ := parsed.GetWeight("b", "a"); w != 3 {
+		t.Errorf("Expected weight 3, got %v", w)
+	}
+}
+
+func TestReadGraphMLForeignKeys(t *testing.T) {
+	// Document in the style written by NetworkX
+	src := `<?xml version="1.0" encoding="utf-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="d0" for="edge" attr.name="weight" attr.type="double"/>
+  <key id="d1" for="node" attr.name="label" attr.type="string"/>
+  <graph edgedefault="directed">
+    <node id="x"><data key="d1">X</data></node>
+    <node id="y"/>
+    <edge source="x" target="y"><data key="d0">4.25</data></edge>
+  </graph>
+</graphml>`
+
+	graph, err := ReadGraphML(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("ReadGraphML failed: %v", err)
+	}
+	if w, ok := graph.GetWeight("x", "y"); !ok || w != 4.25 {
+		t.Errorf("Expected weight 4.25, got %v", w)
+	}
+
+	if _, err := ReadGraphML(strings.NewReader(`<graphml></graphml>`)); err == nil {
+		t.Error("Expected error for document without graph")
+	}
+}
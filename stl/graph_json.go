@@ -0,0 +1,85 @@
+package stl
+
+import (
+	"encoding/json"
+)
+
+// graphJSON is the node-link document used for Graph JSON serialization.
+type graphJSON[T comparable] struct {
+	Directed bool               `json:"directed"`
+	Nodes    []graphJSONNode[T] `json:"nodes"`
+	Edges    []graphJSONEdge[T] `json:"edges"`
+}
+
+// graphJSONNode is a node entry in the node-link document.
+type graphJSONNode[T comparable] struct {
+	ID T `json:"id"`
+}
+
+// graphJSONEdge is an edge entry in the node-link document.
+type graphJSONEdge[T comparable] struct {
+	Source T        `json:"source"`
+	Target T        `json:"target"`
+	Weight *float64 `json:"weight,omitempty"`
+}
+
+// MarshalJSON encodes the graph as a node-link document:
+//
+//	{"directed": true, "nodes": [{"id": 1}, ...], "edges": [{"source": 1, "target": 2, "weight": 1.5}, ...]}
+//
+// Nodes are sorted by their %v representation. Undirected edges appear once, and the weight
+// field is present only for edges added with an explicit weight.
+func (g *Graph[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.document())
+}
+
+// document builds the node-link document for the graph.
+func (g *Graph[T]) document() graphJSON[T] {
+	nodes := g.sortedNodes()
+	doc := graphJSON[T]{
+		Directed: g.directed,
+		Nodes:    make([]graphJSONNode[T], 0, len(nodes)),
+		Edges:    []graphJSONEdge[T]{},
+	}
+
+	for _, node := range nodes {
+		doc.Nodes = append(doc.Nodes, graphJSONNode[T]{ID: node})
+	}
+
+	for _, edge := range g.edgeList(nodes) {
+		entry := graphJSONEdge[T]{Source: edge[0], Target: edge[1]}
+		if weight, exists := g.weights[edge[0]][edge[1]]; exists {
+			entry.Weight = &weight
+		}
+		doc.Edges = append(doc.Edges, entry)
+	}
+
+	return doc
+}
+
+// UnmarshalJSON decodes a node-link document produced by MarshalJSON, replacing the
+// contents of the graph. Edge endpoints missing from the node list are added implicitly.
+func (g *Graph[T]) UnmarshalJSON(data []byte) error {
+	var doc graphJSON[T]
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+
+	g.load(doc)
+	return nil
+}
+
+// load replaces the contents of the graph with a node-link document.
+func (g *Graph[T]) load(doc graphJSON[T]) {
+	*g = *NewGraph[T](doc.Directed)
+	for _, node := range doc.Nodes {
+		g.AddNode(node.ID)
+	}
+	for _, edge := range doc.Edges {
+		if edge.Weight != nil {
+			g.AddWeightedEdge(edge.Source, edge.Target, *edge.Weight)
+		} else {
+			g.AddEdge(edge.Source, edge.Target)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package stl
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGraphMarshalJSON(t *testing.T) {
+	graph := NewGraph[string](false)
+	graph.AddEdge("a", "b")
+	graph.AddWeightedEdge("b", "c", 2.5)
+
+	data, err := json.Marshal(graph)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	expected := `{"directed":false,"nodes":[{"id":"a"},{"id":"b"},{"id":"c"}],` +
+		`"edges":[{"source":"a","target":"b"},{"source":"b","target":"c","weight":2.5}]}`
+	if string(data) != expected {
+		t.Errorf("Unexpected JSON:\n%s", data)
+	}
+}
+
+func TestGraphJSONRoundTrip(t *testing.T) {
+	graph := NewGraph[int](true)
+	graph.AddEdge(1, 2)
+	graph.AddWeightedEdge(2, 3, -1)
+	graph.AddNode(4)
+
+	data, err := json.Marshal(graph)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	var decoded Graph[int]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !decoded.Equals(graph) {
+		t.Errorf("Round trip mismatch: %v vs %v", &decoded, graph)
+	}
+	if w, _ := decoded.GetWeight(2, 3); w != -1 {
+		t.Errorf("Expected weight -1, got %v", w)
+	}
+}
+
+func TestGraphUnmarshalJSONImplicitNodes(t *testing.T) {
+	graph := NewGraph[string](false)
+	graph.AddEdge("old", "data")
+
+	data := []byte(`{"directed":true,"nodes":[],"edges":[{"source":"x","target":"y"}]}`)
+	if err := json.Unmarshal(data, graph); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !graph.IsDirected() || graph.NodeCount() != 2 || !graph.HasEdge("x", "y") {
+		t.Errorf("Unexpected graph after unmarshal: %v", graph)
+	}
+	if graph.HasNode("old") {
+		t.Error("Unmarshal should replace existing contents")
+	}
+
+	if err := json.Unmarshal([]byte(`{"nodes": 5}`), graph); err == nil {
+		t.Error("Expected error for malformed document")
+	}
+}
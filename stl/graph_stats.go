@@ -0,0 +1,118 @@
+package stl
+
+import "fmt"
+
+// exactDiameterLimit is the largest node count for which Stats computes the diameter exactly.
+// Larger graphs run BFS from a sample of diameterSampleSize nodes instead.
+const (
+	exactDiameterLimit = 2000
+	diameterSampleSize = 64
+)
+
+// GraphStats summarizes the shape of a graph.
+type GraphStats struct {
+	Nodes    int
+	Edges    int
+	Directed bool
+
+	// Degrees are out-degrees for directed graphs.
+	MinDegree     int
+	MaxDegree     int
+	AverageDegree float64
+	// DegreeDistribution maps each degree to the number of nodes with that degree.
+	DegreeDistribution map[int]int
+
+	// Density is the fraction of possible edges between distinct nodes that are present.
+	// Self-loops are not counted.
+	Density float64
+	// Components counts weakly connected components for directed graphs.
+	Components int
+
+	// Diameter is the longest shortest path, in edges, between any two mutually reachable nodes.
+	// When DiameterExact is false it was estimated from a sample of BFS sources and is a lower bound.
+	Diameter      int
+	DiameterExact bool
+}
+
+// String returns a one-line summary of the statistics.
+func (s GraphStats) String() string {
+	approx := ""
+	if !s.DiameterExact {
+		approx = "~"
+	}
+	return fmt.Sprintf("GraphStats{Nodes: %d, Edges: %d, Density: %.4f, Degree: %d..%d (avg %.2f), Components: %d, Diameter: %s%d}",
+		s.Nodes, s.Edges, s.Density, s.MinDegree, s.MaxDegree, s.AverageDegree, s.Components, approx, s.Diameter)
+}
+
+// Stats computes node and edge counts, the degree distribution, density, the number of
+// components, and the diameter. The diameter is exact for graphs of up to a few thousand nodes
+// and sampled for larger ones.
+func (g *Graph[T]) Stats() GraphStats {
+	n := len(g.adjacency)
+	stats := GraphStats{
+		Nodes:              n,
+		Edges:              g.edgeCount,
+		Directed:           g.directed,
+		DegreeDistribution: make(map[int]int),
+		DiameterExact:      true,
+	}
+	if n == 0 {
+		return stats
+	}
+
+	stats.MinDegree = -1
+	totalDegree, loops := 0, 0
+	for node, neighbors := range g.adjacency {
+		if _, ok := g.position[node][node]; ok {
+			loops++
+		}
+		degree := len(neighbors)
+		stats.DegreeDistribution[degree]++
+		totalDegree += degree
+		if stats.MinDegree < 0 || degree < stats.MinDegree {
+			stats.MinDegree = degree
+		}
+		if degree > stats.MaxDegree {
+			stats.MaxDegree = degree
+		}
+	}
+	stats.AverageDegree = float64(totalDegree) / float64(n)
+
+	if n > 1 {
+		possible := float64(n) * float64(n-1)
+		if !g.directed {
+			possible /= 2
+		}
+		// Self-loops are not edges between distinct nodes, so they would push density past 1
+		stats.Density = float64(g.edgeCount-loops) / possible
+	}
+
+	components := NewDisjointSet[T]()
+	for node, neighbors := range g.adjacency {
+		components.MakeSet(node)
+		for _, neighbor := range neighbors {
+			components.MakeSet(neighbor)
+			components.Union(node, neighbor)
+		}
+	}
+	stats.Components = components.SetCount()
+
+	sources := n
+	if n > exactDiameterLimit {
+		sources = diameterSampleSize
+		stats.DiameterExact = false
+	}
+	for node := range g.adjacency {
+		if sources == 0 {
+			break
+		}
+		sources--
+		for _, d := range g.bfsDistances(node) {
+			if d > stats.Diameter {
+				stats.Diameter = d
+			}
+		}
+	}
+
+	return stats
+}
@@ -0,0 +1,76 @@
+package stl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGraphStats(t *testing.T) {
+	// Path 0 - 1 - 2 - 3 plus an isolated node 4
+	graph := NewGraphFromEdges([][2]int{{0, 1}, {1, 2}, {2, 3}}, false)
+	graph.AddNode(4)
+
+	stats := graph.Stats()
+	if stats.Nodes != 5 || stats.Edges != 3 || stats.Directed {
+		t.Errorf("Unexpected counts %+v", stats)
+	}
+	if stats.MinDegree != 0 || stats.MaxDegree != 2 || stats.AverageDegree != 1.2 {
+		t.Errorf("Unexpected degrees %+v", stats)
+	}
+	if stats.DegreeDistribution[0] != 1 || stats.DegreeDistribution[1] != 2 || stats.DegreeDistribution[2] != 2 {
+		t.Errorf("Unexpected degree distribution %v", stats.DegreeDistribution)
+	}
+	if stats.Density != 0.3 {
+		t.Errorf("Expected density 0.3, got %v", stats.Density)
+	}
+	if stats.Components != 2 {
+		t.Errorf("Expected 2 components, got %d", stats.Components)
+	}
+	if stats.Diameter != 3 || !stats.DiameterExact {
+		t.Errorf("Expected exact diameter 3, got %d (exact %v)", stats.Diameter, stats.DiameterExact)
+	}
+	if !strings.Contains(stats.String(), "Diameter: 3") {
+		t.Errorf("Unexpected summary %s", stats)
+	}
+}
+
+func TestGraphStatsDirectedAndEmpty(t *testing.T) {
+	// Weak components ignore edge direction
+	graph := NewGraphFromEdges([][2]string{{"a", "b"}, {"c", "b"}}, true)
+	stats := graph.Stats()
+	if stats.Components != 1 || stats.Density != 2.0/6 || stats.Diameter != 1 {
+		t.Errorf("Unexpected directed stats %+v", stats)
+	}
+
+	empty := NewGraph[int](false).Stats()
+	if empty.Nodes != 0 || empty.Components != 0 || empty.Diameter != 0 || empty.MinDegree != 0 {
+		t.Errorf("Unexpected empty stats %+v", empty)
+	}
+}
+
+func TestGraphStatsSelfLoops(t *testing.T) {
+	// Complete graph on 2 nodes plus a loop on each: density stays 1
+	for _, directed := range []bool{false, true} {
+		graph := NewGraphFromEdges([][2]int{{0, 1}, {1, 0}, {0, 0}, {1, 1}}, directed)
+		stats := graph.Stats()
+		if stats.Density != 1 {
+			t.Errorf("Expected density 1 (directed %v), got %v", directed, stats.Density)
+		}
+	}
+
+	single := NewGraphFromEdges([][2]int{{0, 0}}, false).Stats()
+	if single.Edges != 1 || single.Density != 0 {
+		t.Errorf("Expected one edge and density 0 for a lone loop, got %+v", single)
+	}
+}
+
+func TestGraphStatsSampledDiameter(t *testing.T) {
+	graph := GenerateGridGraph(50, 50)
+	stats := graph.Stats()
+	if stats.DiameterExact {
+		t.Error("Expected sampled diameter for a large graph")
+	}
+	if stats.Diameter <= 0 || stats.Diameter > 98 {
+		t.Errorf("Sampled diameter should be between 1 and 98, got %d", stats.Diameter)
+	}
+}
@@ -1,6 +1,7 @@
 package stl
 
 import (
+	"math"
 	"testing"
 )
 
@@ -180,6 +181,625 @@ func TestGraphClear(t *testing.T) {
 	}
 }
 
+func TestGraphWeightedEdges(t *testing.T) {
+	graph := NewGraph[string](false)
+	graph.AddEdge("A", "B")
+	graph.AddWeightedEdge("B", "C", 2.5)
+
+	if w, ok := graph.GetWeight("A", "B"); !ok || w != 1 {
+		t.Errorf("Expected default weight 1 for A-B, got %v", w)
+	}
+	if w, ok := graph.GetWeight("C", "B"); !ok || w != 2.5 {
+		t.Errorf("Expected weight 2.5 for C-B, got %v", w)
+	}
+
+	graph.AddWeightedEdge("B", "C", 4)
+	if graph.EdgeCount() != 2 {
+		t.Errorf("Updating a weight should not add an edge, got %d edges", graph.EdgeCount())
+	}
+	if w, _ := graph.GetWeight("B", "C"); w != 4 {
+		t.Errorf("Expected updated weight 4, got %v", w)
+	}
+
+	graph.RemoveEdge("B", "C")
+	if _, ok := graph.GetWeight("B", "C"); ok {
+		t.Error("Removed edge should not have a weight")
+	}
+}
+
+func TestGraphBellmanFord(t *testing.T) {
+	graph := NewGraph[string](true)
+	graph.AddWeightedEdge("A", "B", 4)
+	graph.AddWeightedEdge("A", "C", 5)
+	graph.AddWeightedEdge("C", "B", -3)
+	graph.AddWeightedEdge("B", "D", 2)
+	graph.AddNode("E")
+
+	dist, ok := graph.BellmanFord("A")
+	if !ok {
+		t.Fatal("Graph without negative cycles should succeed")
+	}
+
+	expected := map[string]float64{"A": 0, "B": 2, "C": 5, "D": 4}
+	if len(dist) != len(expected) {
+		t.Errorf("Expected %d reachable nodes, got %v", len(expected), dist)
+	}
+	for node, d := range expected {
+		if dist[node] != d {
+			t.Errorf("Expected distance %v to %s, got %v", d, node, dist[node])
+		}
+	}
+
+	// Introduce a negative cycle B -> D -> C -> B
+	graph.AddWeightedEdge("D", "C", -1)
+	if _, ok := graph.BellmanFord("A"); ok {
+		t.Error("Should detect reachable negative cycle")
+	}
+
+	// Cycle unreachable from E
+	if dist, ok := graph.BellmanFord("E"); !ok || len(dist) != 1 {
+		t.Errorf("Unreachable negative cycle should not be reported, got %v, %v", dist, ok)
+	}
+
+	if _, ok := graph.BellmanFord("Z"); ok {
+		t.Error("Missing start node should fail")
+	}
+}
+
+func TestGraphPrimMST(t *testing.T) {
+	graph := NewGraph[string](false)
+	graph.AddWeightedEdge("A", "B", 4)
+	graph.AddWeightedEdge("A", "C", 1)
+	graph.AddWeightedEdge("C", "B", 2)
+	graph.AddWeightedEdge("B", "D", 5)
+	graph.AddWeightedEdge("C", "D", 8)
+	graph.AddWeightedEdge("D", "E", 3)
+
+	mst, total := graph.PrimMST("A")
+	if len(mst) != 4 {
+		t.Errorf("Expected 4 MST edges, got %v", mst)
+	}
+	if total != 11 {
+		t.Errorf("Expected total weight 11, got %v", total)
+	}
+
+	// Every node must be covered by the tree
+	covered := NewSet[string]()
+	for _, edge := range mst {
+		covered.Add(edge[0])
+		covered.Add(edge[1])
+	}
+	if covered.Size() != 5 {
+		t.Errorf("MST should span all 5 nodes, got %v", covered)
+	}
+
+	if mst, total := graph.PrimMST("Z"); mst != nil || total != 0 {
+		t.Errorf("Expected empty MST for missing node, got %v, %v", mst, total)
+	}
+}
+
+func TestGraphKruskalMST(t *testing.T) {
+	graph := NewGraph[string](false)
+	graph.AddWeightedEdge("A", "B", 4)
+	graph.AddWeightedEdge("A", "C", 1)
+	graph.AddWeightedEdge("C", "B", 2)
+	graph.AddWeightedEdge("B", "D", 5)
+	graph.AddWeightedEdge("C", "D", 8)
+	graph.AddWeightedEdge("D", "E", 3)
+	graph.AddWeightedEdge("X", "Y", 7)
+
+	mst, total := graph.KruskalMST()
+	if len(mst) != 5 {
+		t.Errorf("Expected 5 edges in spanning forest, got %v", mst)
+	}
+	if total != 18 {
+		t.Errorf("Expected total weight 18, got %v", total)
+	}
+}
+
+func TestGraphStronglyConnectedComponents(t *testing.T) {
+	graph := NewGraphFromEdges([][2]int{
+		{1, 2}, {2, 3}, {3, 1},
+		{3, 4}, {4, 5}, {5, 4},
+		{5, 6},
+	}, true)
+
+	components := graph.StronglyConnectedComponents()
+	if len(components) != 3 {
+		t.Fatalf("Expected 3 components, got %v", components)
+	}
+
+	sizes := NewMultiSet[int]()
+	for _, component := range components {
+		sizes.Add(len(component))
+	}
+	if sizes.Count(3) != 1 || sizes.Count(2) != 1 || sizes.Count(1) != 1 {
+		t.Errorf("Expected component sizes 3, 2 and 1, got %v", components)
+	}
+}
+
+func TestGraphCondensation(t *testing.T) {
+	graph := NewGraphFromEdges([][2]string{
+		{"a", "b"}, {"b", "a"},
+		{"b", "c"}, {"c", "d"}, {"d", "c"},
+		{"a", "e"}, {"e", "d"},
+	}, true)
+
+	dag, componentOf := graph.Condensation()
+	if dag.NodeCount() != 3 {
+		t.Errorf("Expected 3 components, got %d", dag.NodeCount())
+	}
+	if componentOf["a"] != componentOf["b"] || componentOf["c"] != componentOf["d"] {
+		t.Errorf("Cyclic nodes should share a component, got %v", componentOf)
+	}
+	if dag.HasCycle() {
+		t.Error("Condensation should be acyclic")
+	}
+
+	// IDs follow topological order
+	dag.ForEachEdge(func(from, to int) {
+		if from >= to {
+			t.Errorf("Edge %d -> %d violates topological numbering", from, to)
+		}
+	})
+	if dag.EdgeCount() != 3 {
+		t.Errorf("Expected 3 condensed edges, got %d", dag.EdgeCount())
+	}
+}
+
+func TestGraphMaximumBipartiteMatching(t *testing.T) {
+	// Workers w1..w4 and jobs j1..j4; w4 can only take j1, competing with w1
+	graph := NewGraphFromEdges([][2]string{
+		{"w1", "j1"}, {"w1", "j2"},
+		{"w2", "j2"}, {"w2", "j3"},
+		{"w3", "j3"}, {"w3", "j4"},
+		{"w4", "j1"},
+	}, false)
+
+	matching, ok := graph.MaximumBipartiteMatching()
+	if !ok {
+		t.Fatal("Graph should be bipartite")
+	}
+	if len(matching) != 4 {
+		t.Errorf("Expected perfect matching of size 4, got %v", matching)
+	}
+
+	used := NewSet[string]()
+	for _, pair := range matching {
+		if !graph.HasEdge(pair[0], pair[1]) {
+			t.Errorf("Matched pair %v is not an edge", pair)
+		}
+		if used.Contains(pair[0]) || used.Contains(pair[1]) {
+			t.Errorf("Node reused in matching %v", matching)
+		}
+		used.Add(pair[0])
+		used.Add(pair[1])
+	}
+
+	triangle := NewGraphFromEdges([][2]int{{1, 2}, {2, 3}, {3, 1}}, false)
+	if _, ok := triangle.MaximumBipartiteMatching(); ok {
+		t.Error("Odd cycle is not bipartite")
+	}
+
+	directed := NewGraphFromEdges([][2]string{{"w1", "j1"}, {"j2", "w1"}}, true)
+	if matching, ok := directed.MaximumBipartiteMatching(); ok || matching != nil {
+		t.Errorf("Expected directed graphs to be rejected, got %v", matching)
+	}
+}
+
+func TestGraphNeighborIndex(t *testing.T) {
+	graph := NewGraph[int](false)
+	for i := 1; i <= 5; i++ {
+		graph.AddEdge(0, i)
+	}
+	graph.AddEdge(0, 3) // duplicate is ignored
+	graph.AddEdge(7, 7) // self-loop
+
+	if graph.EdgeCount() != 6 {
+		t.Errorf("Expected 6 edges, got %d", graph.EdgeCount())
+	}
+
+	graph.RemoveEdge(0, 2)
+	graph.RemoveEdge(4, 0)
+	for i := 1; i <= 5; i++ {
+		expected := i != 2 && i != 4
+		if graph.HasEdge(0, i) != expected || graph.HasEdge(i, 0) != expected {
+			t.Errorf("Unexpected HasEdge result for 0-%d after removals", i)
+		}
+	}
+	if neighbors := graph.GetNeighbors(0); len(neighbors) != 3 {
+		t.Errorf("Expected 3 neighbors of hub, got %v", neighbors)
+	}
+
+	graph.RemoveNode(7)
+	if graph.EdgeCount() != 3 {
+		t.Errorf("Expected 3 edges after removing self-loop node, got %d", graph.EdgeCount())
+	}
+
+	complement := graph.Complement()
+	if complement.HasEdge(0, 1) || !complement.HasEdge(0, 2) || !complement.HasEdge(1, 5) {
+		t.Error("Complement should swap present and missing edges")
+	}
+}
+
+func TestGraphDirectedRemoveNode(t *testing.T) {
+	graph := NewGraphFromEdges([][2]string{{"A", "B"}, {"C", "B"}, {"B", "D"}}, true)
+	graph.RemoveNode("B")
+
+	if graph.EdgeCount() != 0 || graph.OutDegree("A") != 0 || graph.InDegree("D") != 0 {
+		t.Errorf("All edges touching B should be removed, got %v", graph.GetEdges())
+	}
+}
+
+func TestGraphTraverseBFS(t *testing.T) {
+	// 1 -> 2 -> 4, 1 -> 3
+	graph := NewGraphFromEdges([][2]int{{1, 2}, {1, 3}, {2, 4}}, true)
+
+	depths := make(map[int]int)
+	var order []int
+	graph.TraverseBFS(1, func(node, depth int) bool {
+		order = append(order, node)
+		depths[node] = depth
+		return true
+	})
+	if len(order) != 4 || order[0] != 1 || order[3] != 4 {
+		t.Errorf("Expected BFS order starting at 1 and ending at 4, got %v", order)
+	}
+	if depths[1] != 0 || depths[3] != 1 || depths[4] != 2 {
+		t.Errorf("Unexpected BFS depths %v", depths)
+	}
+
+	visited := 0
+	graph.TraverseBFS(1, func(node, depth int) bool {
+		visited++
+		return node != 2
+	})
+	if visited != 2 {
+		t.Errorf("Expected traversal to stop after reaching 2, visited %d nodes", visited)
+	}
+
+	graph.TraverseBFS(99, func(node, depth int) bool {
+		t.Errorf("Missing start node should not be visited, got %d", node)
+		return true
+	})
+}
+
+func TestGraphTraverseDFS(t *testing.T) {
+	graph := NewGraphFromEdges([][2]int{{1, 2}, {2, 3}, {1, 4}, {4, 5}}, false)
+
+	var order []int
+	depths := make(map[int]int)
+	graph.TraverseDFS(1, func(node, depth int) bool {
+		order = append(order, node)
+		depths[node] = depth
+		return true
+	})
+
+	expected := graph.DFS(1)
+	if len(order) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, order)
+	}
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Errorf("Expected DFS order %v, got %v", expected, order)
+			break
+		}
+	}
+	if depths[1] != 0 || depths[3] != 2 || depths[5] != 2 {
+		t.Errorf("Unexpected DFS depths %v", depths)
+	}
+
+	var stopped []int
+	graph.TraverseDFS(1, func(node, depth int) bool {
+		stopped = append(stopped, node)
+		return depth < 2
+	})
+	if len(stopped) != 3 {
+		t.Errorf("Expected traversal to stop at the first node of depth 2, got %v", stopped)
+	}
+}
+
+func TestGraphDeepChainTraversals(t *testing.T) {
+	// Long chains must not depend on call-stack depth
+	const n = 100000
+	graph := NewGraph[int](true)
+	for i := 0; i < n-1; i++ {
+		graph.AddEdge(i, i+1)
+	}
+
+	if order := graph.DFS(0); len(order) != n || order[n-1] != n-1 {
+		t.Errorf("Expected DFS to reach all %d nodes in order", n)
+	}
+	if graph.HasCycle() {
+		t.Error("Chain should not have a cycle")
+	}
+	order, ok := graph.TopologicalSort()
+	if !ok || len(order) != n || order[0] != 0 || order[n-1] != n-1 {
+		t.Error("Expected chain to sort topologically from 0 to n-1")
+	}
+
+	graph.AddEdge(n-1, 0)
+	if !graph.HasCycle() {
+		t.Error("Closing the chain should create a cycle")
+	}
+
+	undirected := NewGraph[int](false)
+	for i := 0; i < n-1; i++ {
+		undirected.AddEdge(i, i+1)
+	}
+	if components := undirected.ConnectedComponents(); len(components) != 1 || len(components[0]) != n {
+		t.Errorf("Expected a single component of %d nodes", n)
+	}
+}
+
+func TestGraphAllPathsLimit(t *testing.T) {
+	// Two routes from 1 to 4 of length 2 and one of length 3
+	graph := NewGraphFromEdges([][2]int{{1, 2}, {2, 4}, {1, 3}, {3, 4}, {2, 3}}, true)
+
+	if paths := graph.AllPaths(1, 4); len(paths) != 3 {
+		t.Errorf("Expected 3 paths, got %v", paths)
+	}
+	if paths := graph.AllPathsLimit(1, 4, 2, 0); len(paths) != 2 {
+		t.Errorf("Expected 2 paths of at most 2 edges, got %v", paths)
+	}
+	if paths := graph.AllPathsLimit(1, 4, 0, 1); len(paths) != 1 || paths[0][0] != 1 || paths[0][len(paths[0])-1] != 4 {
+		t.Errorf("Expected a single path from 1 to 4, got %v", paths)
+	}
+	if paths := graph.AllPathsLimit(1, 4, 1, 0); len(paths) != 0 {
+		t.Errorf("Expected no path of a single edge, got %v", paths)
+	}
+
+	// Complete graphs have factorially many paths; the callback must be able to stop early
+	complete := GenerateCompleteGraph(12)
+	count := 0
+	complete.AllPathsFunc(0, 11, 0, func(path []int) bool {
+		count++
+		return count < 5
+	})
+	if count != 5 {
+		t.Errorf("Expected streaming search to stop after 5 paths, got %d", count)
+	}
+}
+
+func TestGraphUndirectedCycles(t *testing.T) {
+	tree := NewGraphFromEdges([][2]int{{1, 2}, {1, 3}, {3, 4}}, false)
+	if tree.HasCycle() {
+		t.Error("Undirected tree should not have a cycle")
+	}
+	if cycle, found := tree.FindCycle(); found {
+		t.Errorf("Expected no cycle in tree, got %v", cycle)
+	}
+
+	tree.AddEdge(4, 1)
+	cycle, found := tree.FindCycle()
+	if !found || len(cycle) != 3 || !isCycle(tree, cycle) {
+		t.Errorf("Expected cycle through 1, 3, 4, got %v", cycle)
+	}
+
+	loop := NewGraph[int](false)
+	loop.AddEdge(1, 1)
+	if cycle, found := loop.FindCycle(); !found || len(cycle) != 1 || cycle[0] != 1 {
+		t.Errorf("Expected self-loop cycle [1], got %v", cycle)
+	}
+}
+
+func TestGraphFindCycleDirected(t *testing.T) {
+	graph := NewGraphFromEdges([][2]string{{"a", "b"}, {"b", "c"}, {"a", "c"}}, true)
+	if _, found := graph.FindCycle(); found {
+		t.Error("DAG should not have a cycle")
+	}
+
+	// Two opposite edges form a cycle in a directed graph
+	graph.AddEdge("c", "b")
+	cycle, found := graph.FindCycle()
+	if !found || len(cycle) != 2 || !isCycle(graph, cycle) {
+		t.Errorf("Expected cycle between b and c, got %v", cycle)
+	}
+}
+
+func TestGraphLineGraph(t *testing.T) {
+	// Path a - b - c - d has three edges forming a path in the line graph
+	path := NewGraphFromEdges([][2]string{{"a", "b"}, {"b", "c"}, {"c", "d"}}, false)
+	line, edges := path.LineGraph()
+	if line.NodeCount() != 3 || line.EdgeCount() != 2 || len(edges) != 3 {
+		t.Errorf("Expected line graph with 3 nodes and 2 edges, got %s", line)
+	}
+	index := make(map[[2]string]int)
+	for i, edge := range edges {
+		index[edge] = i
+	}
+	ab, bc, cd := index[[2]string{"a", "b"}], index[[2]string{"b", "c"}], index[[2]string{"c", "d"}]
+	if !line.HasEdge(ab, bc) || !line.HasEdge(bc, cd) || line.HasEdge(ab, cd) {
+		t.Error("Line graph should join only edges sharing an endpoint")
+	}
+
+	// Star with three leaves becomes a triangle
+	star := NewGraphFromEdges([][2]int{{0, 1}, {0, 2}, {0, 3}}, false)
+	if triangle, _ := star.LineGraph(); triangle.EdgeCount() != 3 {
+		t.Errorf("Expected star line graph to be a triangle, got %v", triangle.GetEdges())
+	}
+
+	directed := NewGraphFromEdges([][2]int{{1, 2}, {2, 3}, {3, 1}}, true)
+	cycle, arcs := directed.LineGraph()
+	for i, arc := range arcs {
+		for j, next := range arcs {
+			if cycle.HasEdge(i, j) != (arc[1] == next[0]) {
+				t.Errorf("Unexpected adjacency between %v and %v", arc, next)
+			}
+		}
+	}
+}
+
+func TestGraphContractEdge(t *testing.T) {
+	// Square 1-2-3-4 with a weighted edge 2-3
+	graph := NewGraphFromEdges([][2]int{{1, 2}, {3, 4}, {4, 1}}, false)
+	graph.AddWeightedEdge(2, 3, 5)
+
+	contracted := graph.ContractEdge(1, 2)
+	if contracted == nil || contracted.HasNode(2) || contracted.NodeCount() != 3 {
+		t.Fatalf("Expected 2 to be merged into 1, got %v", contracted)
+	}
+	if !contracted.HasEdge(1, 3) || !contracted.HasEdge(1, 4) || contracted.EdgeCount() != 3 {
+		t.Errorf("Unexpected edges after contraction %v", contracted.GetEdges())
+	}
+	if weight, _ := contracted.GetWeight(1, 3); weight != 5 {
+		t.Errorf("Expected reattached edge to keep weight 5, got %v", weight)
+	}
+	if !graph.HasNode(2) || graph.EdgeCount() != 4 {
+		t.Error("ContractEdge should not modify the original graph")
+	}
+
+	if graph.ContractEdge(1, 3) != nil {
+		t.Error("Contracting a missing edge should return nil")
+	}
+
+	directed := NewGraphFromEdges([][2]string{{"a", "b"}, {"b", "c"}, {"d", "b"}}, true)
+	merged := directed.ContractEdge("a", "b")
+	if !merged.HasEdge("a", "c") || !merged.HasEdge("d", "a") || merged.EdgeCount() != 2 {
+		t.Errorf("Unexpected directed contraction %v", merged.GetEdges())
+	}
+}
+
+func TestGraphShortestPathsFrom(t *testing.T) {
+	graph := NewGraph[string](true)
+	graph.AddWeightedEdge("a", "b", 1)
+	graph.AddWeightedEdge("b", "c", 1)
+	graph.AddWeightedEdge("a", "c", 5)
+	graph.AddWeightedEdge("c", "d", 2)
+	graph.AddNode("unreachable")
+
+	tree, ok := graph.ShortestPathsFrom("a")
+	if !ok {
+		t.Fatal("Expected shortest paths from a")
+	}
+	if d, _ := tree.DistanceTo("d"); d != 4 {
+		t.Errorf("Expected distance 4 to d, got %v", d)
+	}
+	path, ok := tree.PathTo("d")
+	if !ok || len(path) != 4 || path[0] != "a" || path[1] != "b" || path[3] != "d" {
+		t.Errorf("Expected path [a b c d], got %v", path)
+	}
+	if path, ok := tree.PathTo("a"); !ok || len(path) != 1 {
+		t.Errorf("Expected path [a] to the source, got %v", path)
+	}
+	if _, ok := tree.PathTo("unreachable"); ok {
+		t.Error("Unreachable node should have no path")
+	}
+	if pred := tree.Predecessors["c"]; pred != "b" {
+		t.Errorf("Expected predecessor b for c, got %v", pred)
+	}
+
+	if _, ok := graph.ShortestPathsFrom("missing"); ok {
+		t.Error("Missing start should return false")
+	}
+	graph.AddWeightedEdge("d", "a", -1)
+	if _, ok := graph.ShortestPathsFrom("a"); ok {
+		t.Error("Negative weights should return false")
+	}
+}
+
+func TestGraphShortestPathLongChain(t *testing.T) {
+	graph := NewGraph[int](false)
+	for i := 0; i < 10000; i++ {
+		graph.AddEdge(i, i+1)
+	}
+
+	path, ok := graph.ShortestPath(0, 10000)
+	if !ok || len(path) != 10001 || path[0] != 0 || path[10000] != 10000 {
+		t.Errorf("Expected chain path of 10001 nodes, got %d nodes", len(path))
+	}
+}
+
+func TestGraphAllTopologicalSorts(t *testing.T) {
+	// a and b must precede c; a and b may come in either order
+	graph := NewGraphFromEdges([][2]string{{"a", "c"}, {"b", "c"}, {"c", "d"}}, true)
+
+	orders, ok := graph.AllTopologicalSorts(0)
+	if !ok || len(orders) != 2 {
+		t.Fatalf("Expected 2 orderings, got %v", orders)
+	}
+	expected := [][]string{{"a", "b", "c", "d"}, {"b", "a", "c", "d"}}
+	for i := range expected {
+		for j := range expected[i] {
+			if orders[i][j] != expected[i][j] {
+				t.Errorf("Expected %v, got %v", expected, orders)
+			}
+		}
+	}
+
+	// Five independent nodes have 120 orderings
+	independent := NewGraph[int](true)
+	for i := 0; i < 5; i++ {
+		independent.AddNode(i)
+	}
+	if orders, _ := independent.AllTopologicalSorts(0); len(orders) != 120 {
+		t.Errorf("Expected 120 orderings, got %d", len(orders))
+	}
+	if orders, _ := independent.AllTopologicalSorts(7); len(orders) != 7 {
+		t.Errorf("Expected limit of 7 orderings, got %d", len(orders))
+	}
+
+	graph.AddEdge("d", "a")
+	if _, ok := graph.AllTopologicalSorts(0); ok {
+		t.Error("Cyclic graph should have no topological orderings")
+	}
+}
+
+func TestGraphFloydWarshall(t *testing.T) {
+	g := NewGraph[string](true)
+	g.AddWeightedEdge("a", "b", 4)
+	g.AddWeightedEdge("a", "c", 1)
+	g.AddWeightedEdge("c", "b", 2)
+	g.AddWeightedEdge("b", "d", -1)
+	g.AddNode("e")
+
+	dist, nodes := g.FloydWarshall()
+	index := map[string]int{}
+	for i, node := range nodes {
+		index[node] = i
+	}
+	at := func(from, to string) float64 {
+		value, _ := dist.Get(index[from], index[to])
+		return value
+	}
+
+	if d := at("a", "d"); d != 2 {
+		t.Errorf("Expected distance 2 from a to d, got %v", d)
+	}
+	if d := at("a", "b"); d != 3 {
+		t.Errorf("Expected distance 3 from a to b, got %v", d)
+	}
+	if d := at("d", "a"); !math.IsInf(d, 1) {
+		t.Errorf("Expected d to a to be unreachable, got %v", d)
+	}
+	if d := at("e", "e"); d != 0 {
+		t.Errorf("Expected zero diagonal, got %v", d)
+	}
+
+	adjacency, order := g.AdjacencyMatrix()
+	if len(order) != 5 {
+		t.Fatalf("Expected 5 nodes, got %d", len(order))
+	}
+	position := map[string]int{}
+	for i, node := range order {
+		position[node] = i
+	}
+	if weight, _ := adjacency.Get(position["b"], position["d"]); weight != -1 {
+		t.Errorf("Expected weight -1, got %v", weight)
+	}
+}
+
+func BenchmarkGraphHasEdgeHub(b *testing.B) {
+	graph := NewGraph[int](false)
+	for i := 1; i <= 10000; i++ {
+		graph.AddEdge(0, i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		graph.HasEdge(0, i%10000+1)
+	}
+}
+
 // Helper function for tests.
 func containsNode[T comparable](nodes []T, target T) bool {
 	for _, node := range nodes {
@@ -189,3 +809,27 @@ func containsNode[T comparable](nodes []T, target T) bool {
 	}
 	return false
 }
+
+// isCycle reports whether consecutive nodes, wrapping around, are joined by edges.
+func isCycle[T comparable](graph *Graph[T], cycle []T) bool {
+	for i, node := range cycle {
+		if !graph.HasEdge(node, cycle[(i+1)%len(cycle)]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestGraphAll(t *testing.T) {
+	graph := NewGraph[string](false)
+	graph.AddEdge("a", "b")
+	graph.AddNode("c")
+
+	nodes := NewSet[string]()
+	for node := range graph.All() {
+		nodes.Add(node)
+	}
+	if nodes.Size() != 3 || !nodes.Contains("c") {
+		t.Errorf("Expected nodes a, b, c, got %v", nodes)
+	}
+}
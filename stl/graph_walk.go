@@ -0,0 +1,85 @@
+package stl
+
+import "math/rand"
+
+// RandomWalk performs a random walk of up to steps moves from start, choosing each next node
+// uniformly among the neighbors of the current one. The returned walk begins with start and ends
+// early at a node without outgoing edges. A nil r uses a time-seeded generator. It returns nil if
+// start is not in the graph.
+func (g *Graph[T]) RandomWalk(start T, steps int, r *rand.Rand) []T {
+	if !g.HasNode(start) {
+		return nil
+	}
+	if r == nil {
+		r = newRand(nil)
+	}
+
+	walk := []T{start}
+	current := start
+	for i := 0; i < steps; i++ {
+		neighbors := g.adjacency[current]
+		if len(neighbors) == 0 {
+			break
+		}
+		current = neighbors[r.Intn(len(neighbors))]
+		walk = append(walk, current)
+	}
+
+	return walk
+}
+
+// WeightedRandomWalk performs a random walk like RandomWalk, but moves along each edge with
+// probability proportional to its weight. Edges with non-positive weight are never taken, and the
+// walk ends early at a node whose outgoing edges all have non-positive weight.
+func (g *Graph[T]) WeightedRandomWalk(start T, steps int, r *rand.Rand) []T {
+	if !g.HasNode(start) {
+		return nil
+	}
+	if r == nil {
+		r = newRand(nil)
+	}
+
+	walk := []T{start}
+	current := start
+	for i := 0; i < steps; i++ {
+		next, ok := g.weightedNeighbor(current, r)
+		if !ok {
+			break
+		}
+		current = next
+		walk = append(walk, current)
+	}
+
+	return walk
+}
+
+// weightedNeighbor picks a neighbor of node with probability proportional to the edge weight.
+func (g *Graph[T]) weightedNeighbor(node T, r *rand.Rand) (T, bool) {
+	total := 0.0
+	for _, neighbor := range g.adjacency[node] {
+		if w := g.edgeWeight(node, neighbor); w > 0 {
+			total += w
+		}
+	}
+	if total <= 0 {
+		var zero T
+		return zero, false
+	}
+
+	target := r.Float64() * total
+	var last T
+	for _, neighbor := range g.adjacency[node] {
+		w := g.edgeWeight(node, neighbor)
+		if w <= 0 {
+			continue
+		}
+		last = neighbor
+		if target < w {
+			return neighbor, true
+		}
+		target -= w
+	}
+
+	// Rounding can leave a sliver of target after the last positive edge
+	return last, true
+}
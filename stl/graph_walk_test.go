@@ -0,0 +1,68 @@
+package stl
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestGraphRandomWalk(t *testing.T) {
+	graph := GenerateGridGraph(4, 4)
+
+	walk := graph.RandomWalk(0, 20, rand.New(rand.NewSource(7)))
+	if len(walk) != 21 || walk[0] != 0 {
+		t.Fatalf("Expected 21-node walk from 0, got %v", walk)
+	}
+	for i := 0; i+1 < len(walk); i++ {
+		if !graph.HasEdge(walk[i], walk[i+1]) {
+			t.Errorf("Walk step %d -> %d is not an edge", walk[i], walk[i+1])
+		}
+	}
+
+	again := graph.RandomWalk(0, 20, rand.New(rand.NewSource(7)))
+	for i := range walk {
+		if walk[i] != again[i] {
+			t.Errorf("Expected identical walks for the same seed, got %v and %v", walk, again)
+			break
+		}
+	}
+
+	// Walks stop at nodes without outgoing edges
+	chain := NewGraphFromEdges([][2]string{{"a", "b"}, {"b", "c"}}, true)
+	if walk := chain.RandomWalk("a", 10, nil); len(walk) != 3 || walk[2] != "c" {
+		t.Errorf("Expected walk [a b c], got %v", walk)
+	}
+
+	if walk := chain.RandomWalk("missing", 10, nil); walk != nil {
+		t.Errorf("Expected nil walk for missing start, got %v", walk)
+	}
+}
+
+func TestGraphWeightedRandomWalk(t *testing.T) {
+	graph := NewGraph[string](true)
+	graph.AddWeightedEdge("hub", "heavy", 99)
+	graph.AddWeightedEdge("hub", "light", 1)
+	graph.AddWeightedEdge("hub", "never", 0)
+	graph.AddWeightedEdge("heavy", "hub", 1)
+	graph.AddWeightedEdge("light", "hub", 1)
+
+	counts := make(map[string]int)
+	walk := graph.WeightedRandomWalk("hub", 2000, rand.New(rand.NewSource(1)))
+	for i := 0; i+1 < len(walk); i++ {
+		if walk[i] == "hub" {
+			counts[walk[i+1]]++
+		}
+	}
+
+	if counts["never"] != 0 {
+		t.Errorf("Zero-weight edge should never be taken, got %d", counts["never"])
+	}
+	if counts["heavy"] < 10*counts["light"] {
+		t.Errorf("Heavy edge should dominate, got %v", counts)
+	}
+
+	dead := NewGraph[int](true)
+	dead.AddWeightedEdge(1, 2, 0)
+	if walk := dead.WeightedRandomWalk(1, 5, nil); len(walk) != 1 {
+		t.Errorf("Expected walk to stop at node with only zero-weight edges, got %v", walk)
+	}
+}
@@ -0,0 +1,168 @@
+package stl
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// hashEntry is a key-value pair stored in a HashMap bucket.
+type hashEntry[K, V any] struct {
+	key   K
+	value V
+}
+
+// HashMap is an unordered map whose keys are hashed and compared by user-supplied functions,
+// so keys need not be comparable: slices, maps, and structs containing them all work. Keys
+// that are equal must have the same hash; keys with colliding hashes are told apart by equals.
+type HashMap[K, V any] struct {
+	buckets map[uint64][]hashEntry[K, V]
+	hash    func(K) uint64
+	equals  func(K, K) bool
+	size    int
+}
+
+// NewMapWithHasher creates a new empty HashMap that uses hash and equals for its keys. It
+// honors WithCapacity. concurrent.SafeHashMap wraps it for concurrent use.
+func NewMapWithHasher[K, V any](hash func(K) uint64, equals func(K, K) bool, opts ...Option) *HashMap[K, V] {
+	return &HashMap[K, V]{
+		buckets: make(map[uint64][]hashEntry[K, V], applyOptions(opts).capacity),
+		hash:    hash,
+		equals:  equals,
+	}
+}
+
+// find returns the hash of key and its index in that bucket, or -1 if it is absent.
+func (m *HashMap[K, V]) find(key K) (uint64, int) {
+	h := m.hash(key)
+	for i, entry := range m.buckets[h] {
+		if m.equals(entry.key, key) {
+			return h, i
+		}
+	}
+	return h, -1
+}
+
+// Put associates a value with a key, replacing any previous value.
+func (m *HashMap[K, V]) Put(key K, value V) {
+	h, i := m.find(key)
+	if i >= 0 {
+		m.buckets[h][i].value = value
+		return
+	}
+	m.buckets[h] = append(m.buckets[h], hashEntry[K, V]{key: key, value: value})
+	m.size++
+}
+
+// Get returns the value associated with a key.
+func (m *HashMap[K, V]) Get(key K) (V, bool) {
+	h, i := m.find(key)
+	if i < 0 {
+		var zero V
+		return zero, false
+	}
+	return m.buckets[h][i].value, true
+}
+
+// ContainsKey checks if a key exists in the map.
+func (m *HashMap[K, V]) ContainsKey(key K) bool {
+	_, i := m.find(key)
+	return i >= 0
+}
+
+// Remove deletes a key and returns true if it was present.
+func (m *HashMap[K, V]) Remove(key K) bool {
+	h, i := m.find(key)
+	if i < 0 {
+		return false
+	}
+	bucket := m.buckets[h]
+	last := len(bucket) - 1
+	bucket[i] = bucket[last]
+	bucket[last] = hashEntry[K, V]{}
+	if last == 0 {
+		delete(m.buckets, h)
+	} else {
+		m.buckets[h] = bucket[:last]
+	}
+	m.size--
+	return true
+}
+
+// Size returns the number of entries in the map.
+func (m *HashMap[K, V]) Size() int {
+	return m.size
+}
+
+// IsEmpty checks if the map is empty.
+func (m *HashMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Clear removes all entries from the map.
+func (m *HashMap[K, V]) Clear() {
+	clear(m.buckets)
+	m.size = 0
+}
+
+// Keys returns the keys in no particular order.
+func (m *HashMap[K, V]) Keys() []K {
+	return m.AppendKeys(nil)
+}
+
+// AppendKeys appends the keys to dst and returns the extended slice.
+func (m *HashMap[K, V]) AppendKeys(dst []K) []K {
+	dst = slices.Grow(dst, m.size)
+	for _, bucket := range m.buckets {
+		for _, entry := range bucket {
+			dst = append(dst, entry.key)
+		}
+	}
+	return dst
+}
+
+// Values returns the values in no particular order.
+func (m *HashMap[K, V]) Values() []V {
+	return m.AppendValues(nil)
+}
+
+// AppendValues appends the values to dst and returns the extended slice.
+func (m *HashMap[K, V]) AppendValues(dst []V) []V {
+	dst = slices.Grow(dst, m.size)
+	for _, bucket := range m.buckets {
+		for _, entry := range bucket {
+			dst = append(dst, entry.value)
+		}
+	}
+	return dst
+}
+
+// ForEach applies a function to each entry in the map.
+func (m *HashMap[K, V]) ForEach(fn func(K, V)) {
+	for key, value := range m.All() {
+		fn(key, value)
+	}
+}
+
+// All returns an iterator over the entries in no particular order.
+func (m *HashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, bucket := range m.buckets {
+			for _, entry := range bucket {
+				if !yield(entry.key, entry.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// String returns a string representation of the map.
+func (m *HashMap[K, V]) String() string {
+	var parts []string
+	m.ForEach(func(key K, value V) {
+		parts = append(parts, fmt.Sprintf("%v:%v", key, value))
+	})
+	return "HashMap[" + strings.Join(parts, " ") + "]"
+}
@@ -0,0 +1,68 @@
+package stl
+
+import (
+	"slices"
+	"testing"
+)
+
+// sumHash is a deliberately weak hash so that distinct keys collide.
+func sumHash(key []int) uint64 {
+	var h uint64
+	for _, x := range key {
+		h += uint64(x)
+	}
+	return h
+}
+
+func TestHashMapBasicOperations(t *testing.T) {
+	m := NewMapWithHasher[[]int, string](sumHash, slices.Equal[[]int])
+
+	// [1 2] and [3] collide but are distinct keys
+	m.Put([]int{1, 2}, "a")
+	m.Put([]int{3}, "b")
+	m.Put([]int{1, 2}, "c")
+	if m.Size() != 2 {
+		t.Errorf("Expected size 2, got %d", m.Size())
+	}
+	if value, ok := m.Get([]int{1, 2}); !ok || value != "c" {
+		t.Errorf("Expected c, got %v", value)
+	}
+	if value, ok := m.Get([]int{3}); !ok || value != "b" {
+		t.Errorf("Expected b, got %v", value)
+	}
+	if m.ContainsKey([]int{2, 1}) {
+		t.Error("Expected [2 1] to be absent despite the hash collision")
+	}
+
+	if !m.Remove([]int{1, 2}) || m.Remove([]int{1, 2}) {
+		t.Error("Expected [1 2] to be removed exactly once")
+	}
+	if !m.ContainsKey([]int{3}) || m.Size() != 1 {
+		t.Errorf("Expected only [3] to remain, got %v", m)
+	}
+
+	m.Clear()
+	if !m.IsEmpty() || len(m.Keys()) != 0 {
+		t.Errorf("Expected empty map after Clear, got %v", m)
+	}
+}
+
+func TestHashMapIteration(t *testing.T) {
+	m := NewMapWithHasher[[]int, int](sumHash, slices.Equal[[]int])
+	m.Put([]int{1}, 1)
+	m.Put([]int{2}, 2)
+	m.Put([]int{0, 2}, 3)
+
+	total := 0
+	for key, value := range m.All() {
+		total += value
+		if got, _ := m.Get(key); got != value {
+			t.Errorf("Expected All to pair %v with %d, got %d", key, got, value)
+		}
+	}
+	values := m.Values()
+	slices.Sort(values)
+	if total != 6 || !slices.Equal(values, []int{1, 2, 3}) || len(m.Keys()) != 3 {
+		t.Errorf("Expected three entries summing to 6, got %v", m)
+	}
+}
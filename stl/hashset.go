@@ -0,0 +1,91 @@
+package stl
+
+import (
+	"fmt"
+	"iter"
+)
+
+// HashSet is an unordered set whose elements are hashed and compared by user-supplied
+// functions, so it can hold elements that are not comparable. It is built on HashMap.
+type HashSet[T any] struct {
+	m *HashMap[T, struct{}]
+}
+
+// NewSetWithHasher creates a new empty HashSet. Elements that are equal must have the same
+// hash; elements with colliding hashes are told apart by equals. It honors the options of
+// NewMapWithHasher.
+func NewSetWithHasher[T any](hash func(T) uint64, equals func(T, T) bool, opts ...Option) *HashSet[T] {
+	return &HashSet[T]{m: NewMapWithHasher[T, struct{}](hash, equals, opts...)}
+}
+
+// NewHashSetFromSlice creates a HashSet from a slice, removing duplicates.
+func NewHashSetFromSlice[T any](slice []T, hash func(T) uint64, equals func(T, T) bool) *HashSet[T] {
+	s := NewSetWithHasher(hash, equals)
+	for _, item := range slice {
+		s.Add(item)
+	}
+	return s
+}
+
+// Add adds an element to the set.
+func (s *HashSet[T]) Add(element T) {
+	s.m.Put(element, struct{}{})
+}
+
+// Remove removes an element from the set.
+func (s *HashSet[T]) Remove(element T) {
+	s.m.Remove(element)
+}
+
+// Contains checks if an element exists in the set.
+func (s *HashSet[T]) Contains(element T) bool {
+	return s.m.ContainsKey(element)
+}
+
+// Size returns the number of elements in the set.
+func (s *HashSet[T]) Size() int {
+	return s.m.Size()
+}
+
+// IsEmpty checks if the set is empty.
+func (s *HashSet[T]) IsEmpty() bool {
+	return s.m.IsEmpty()
+}
+
+// Clear removes all elements from the set.
+func (s *HashSet[T]) Clear() {
+	s.m.Clear()
+}
+
+// ToSlice converts the set to a slice.
+func (s *HashSet[T]) ToSlice() []T {
+	return s.m.Keys()
+}
+
+// AppendTo appends the elements to dst and returns the extended slice.
+func (s *HashSet[T]) AppendTo(dst []T) []T {
+	return s.m.AppendKeys(dst)
+}
+
+// ForEach applies a function to each element in the set.
+func (s *HashSet[T]) ForEach(fn func(T)) {
+	s.m.ForEach(func(element T, _ struct{}) {
+		fn(element)
+	})
+}
+
+// All returns an iterator over the elements in no particular order.
+func (s *HashSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for element := range s.m.All() {
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// String returns a string representation of the set.
+func (s *HashSet[T]) String() string {
+	return fmt.Sprintf("HashSet%v", s.ToSlice())
+}
@@ -0,0 +1,35 @@
+package stl
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestHashSetBasicOperations(t *testing.T) {
+	set := NewHashSetFromSlice([][]int{{1, 2}, {3}, {1, 2}}, sumHash, slices.Equal[[]int])
+	if set.Size() != 2 {
+		t.Errorf("Expected duplicates to be removed, got %v", set)
+	}
+	if !set.Contains([]int{3}) || set.Contains([]int{2, 1}) {
+		t.Error("Expected [3] to be present and [2 1] absent")
+	}
+
+	set.Add([]int{4})
+	set.Remove([]int{1, 2})
+	set.Remove([]int{9})
+	count := 0
+	for element := range set.All() {
+		if !set.Contains(element) {
+			t.Errorf("Expected %v from All to be in the set", element)
+		}
+		count++
+	}
+	if count != 2 || len(set.ToSlice()) != 2 {
+		t.Errorf("Expected {[3] [4]}, got %v", set)
+	}
+
+	set.Clear()
+	if !set.IsEmpty() {
+		t.Errorf("Expected empty set after Clear, got %v", set)
+	}
+}
@@ -0,0 +1,315 @@
+package stl
+
+import (
+	"fmt"
+	"hash/maphash"
+	"math/bits"
+	"strings"
+)
+
+const (
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+)
+
+// hamtSlot is an occupied position of a hamtNode: either a child node or a leaf holding the
+// entries whose keys share one full hash.
+type hamtSlot[K comparable, V any] struct {
+	child  *hamtNode[K, V]
+	hash   uint64
+	bucket []Entry[K, V]
+}
+
+// hamtNode is an immutable node of a hash array mapped trie. The bitmap marks which of the
+// 32 possible positions are occupied; slots stores only those, in position order.
+type hamtNode[K comparable, V any] struct {
+	bitmap uint32
+	slots  []hamtSlot[K, V]
+}
+
+// locate returns the bit for hash at shift and the index its slot has, or would have.
+func (n *hamtNode[K, V]) locate(hash uint64, shift uint) (uint32, int) {
+	bit := uint32(1) << ((hash >> shift) & hamtMask)
+	return bit, bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+// withSlot returns a copy of n with the slot at pos replaced.
+func (n *hamtNode[K, V]) withSlot(pos int, slot hamtSlot[K, V]) *hamtNode[K, V] {
+	slots := make([]hamtSlot[K, V], len(n.slots))
+	copy(slots, n.slots)
+	slots[pos] = slot
+	return &hamtNode[K, V]{bitmap: n.bitmap, slots: slots}
+}
+
+// withInserted returns a copy of n with a new slot at pos for bit.
+func (n *hamtNode[K, V]) withInserted(bit uint32, pos int, slot hamtSlot[K, V]) *hamtNode[K, V] {
+	slots := make([]hamtSlot[K, V], len(n.slots)+1)
+	copy(slots, n.slots[:pos])
+	slots[pos] = slot
+	copy(slots[pos+1:], n.slots[pos:])
+	return &hamtNode[K, V]{bitmap: n.bitmap | bit, slots: slots}
+}
+
+// withRemoved returns a copy of n without the slot at pos for bit.
+func (n *hamtNode[K, V]) withRemoved(bit uint32, pos int) *hamtNode[K, V] {
+	slots := make([]hamtSlot[K, V], 0, len(n.slots)-1)
+	slots = append(slots, n.slots[:pos]...)
+	slots = append(slots, n.slots[pos+1:]...)
+	return &hamtNode[K, V]{bitmap: n.bitmap &^ bit, slots: slots}
+}
+
+// hamtGet looks up key below node.
+func hamtGet[K comparable, V any](node *hamtNode[K, V], hash uint64, key K) (V, bool) {
+	for shift := uint(0); ; shift += hamtBits {
+		bit, pos := node.locate(hash, shift)
+		if node.bitmap&bit == 0 {
+			break
+		}
+		slot := node.slots[pos]
+		if slot.child != nil {
+			node = slot.child
+			continue
+		}
+		if slot.hash == hash {
+			for _, entry := range slot.bucket {
+				if entry.Key == key {
+					return entry.Value, true
+				}
+			}
+		}
+		break
+	}
+	var zero V
+	return zero, false
+}
+
+// hamtPut returns a copy of node with key set to value and reports whether the key was new.
+func hamtPut[K comparable, V any](node *hamtNode[K, V], shift uint, hash uint64, key K, value V) (*hamtNode[K, V], bool) {
+	bit, pos := node.locate(hash, shift)
+	if node.bitmap&bit == 0 {
+		leaf := hamtSlot[K, V]{hash: hash, bucket: []Entry[K, V]{{Key: key, Value: value}}}
+		return node.withInserted(bit, pos, leaf), true
+	}
+
+	slot := node.slots[pos]
+	switch {
+	case slot.child != nil:
+		child, added := hamtPut(slot.child, shift+hamtBits, hash, key, value)
+		return node.withSlot(pos, hamtSlot[K, V]{child: child}), added
+	case slot.hash == hash:
+		bucket := make([]Entry[K, V], len(slot.bucket), len(slot.bucket)+1)
+		copy(bucket, slot.bucket)
+		for i := range bucket {
+			if bucket[i].Key == key {
+				bucket[i].Value = value
+				return node.withSlot(pos, hamtSlot[K, V]{hash: hash, bucket: bucket}), false
+			}
+		}
+		bucket = append(bucket, Entry[K, V]{Key: key, Value: value})
+		return node.withSlot(pos, hamtSlot[K, V]{hash: hash, bucket: bucket}), true
+	default:
+		leaf := hamtSlot[K, V]{hash: hash, bucket: []Entry[K, V]{{Key: key, Value: value}}}
+		child := hamtMerge(slot, leaf, shift+hamtBits)
+		return node.withSlot(pos, hamtSlot[K, V]{child: child}), true
+	}
+}
+
+// hamtMerge builds the smallest subtree at shift holding two leaves with different hashes.
+func hamtMerge[K comparable, V any](a, b hamtSlot[K, V], shift uint) *hamtNode[K, V] {
+	indexA := (a.hash >> shift) & hamtMask
+	indexB := (b.hash >> shift) & hamtMask
+	if indexA == indexB {
+		child := hamtMerge(a, b, shift+hamtBits)
+		return &hamtNode[K, V]{bitmap: 1 << indexA, slots: []hamtSlot[K, V]{{child: child}}}
+	}
+	if indexA > indexB {
+		a, b = b, a
+		indexA, indexB = indexB, indexA
+	}
+	return &hamtNode[K, V]{bitmap: 1<<indexA | 1<<indexB, slots: []hamtSlot[K, V]{a, b}}
+}
+
+// hamtRemove returns a copy of node without key and reports whether it was present. Subtrees
+// left holding a single leaf are collapsed into their parent.
+func hamtRemove[K comparable, V any](node *hamtNode[K, V], shift uint, hash uint64, key K) (*hamtNode[K, V], bool) {
+	bit, pos := node.locate(hash, shift)
+	if node.bitmap&bit == 0 {
+		return node, false
+	}
+
+	slot := node.slots[pos]
+	if slot.child != nil {
+		child, removed := hamtRemove(slot.child, shift+hamtBits, hash, key)
+		if !removed {
+			return node, false
+		}
+		if len(child.slots) == 1 && child.slots[0].child == nil {
+			return node.withSlot(pos, child.slots[0]), true
+		}
+		return node.withSlot(pos, hamtSlot[K, V]{child: child}), true
+	}
+
+	if slot.hash != hash {
+		return node, false
+	}
+	for i, entry := range slot.bucket {
+		if entry.Key != key {
+			continue
+		}
+		if len(slot.bucket) == 1 {
+			return node.withRemoved(bit, pos), true
+		}
+		bucket := make([]Entry[K, V], 0, len(slot.bucket)-1)
+		bucket = append(bucket, slot.bucket[:i]...)
+		bucket = append(bucket, slot.bucket[i+1:]...)
+		return node.withSlot(pos, hamtSlot[K, V]{hash: hash, bucket: bucket}), true
+	}
+	return node, false
+}
+
+// hamtForEach visits every entry below node until fn returns false.
+func hamtForEach[K comparable, V any](node *hamtNode[K, V], fn func(K, V) bool) bool {
+	for _, slot := range node.slots {
+		if slot.child != nil {
+			if !hamtForEach(slot.child, fn) {
+				return false
+			}
+			continue
+		}
+		for _, entry := range slot.bucket {
+			if !fn(entry.Key, entry.Value) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ImmutableMap represents a persistent hash map. Put and Remove return new versions that share
+// unchanged structure with the original (a hash array mapped trie), so every version stays
+// valid and can be shared across goroutines without locks or copying.
+type ImmutableMap[K comparable, V any] struct {
+	root *hamtNode[K, V]
+	size int
+	seed maphash.Seed
+}
+
+// NewImmutableMap creates a new empty immutable map.
+func NewImmutableMap[K comparable, V any]() *ImmutableMap[K, V] {
+	return &ImmutableMap[K, V]{
+		root: &hamtNode[K, V]{},
+		seed: maphash.MakeSeed(),
+	}
+}
+
+// NewImmutableMapFromMap creates an immutable map holding the entries of a Go map.
+func NewImmutableMapFromMap[K comparable, V any](m map[K]V) *ImmutableMap[K, V] {
+	result := NewImmutableMap[K, V]()
+	for key, value := range m {
+		result.root, _ = hamtPut(result.root, 0, result.hash(key), key, value)
+	}
+	result.size = len(m)
+	return result
+}
+
+// hash returns the hash of key for this family of versions.
+func (m *ImmutableMap[K, V]) hash(key K) uint64 {
+	return maphash.Comparable(m.seed, key)
+}
+
+// Get returns the value associated with a key.
+func (m *ImmutableMap[K, V]) Get(key K) (V, bool) {
+	return hamtGet(m.root, m.hash(key), key)
+}
+
+// GetOrDefault returns the value associated with a key or a default value if not found.
+func (m *ImmutableMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if value, exists := m.Get(key); exists {
+		return value
+	}
+	return defaultValue
+}
+
+// ContainsKey checks if a key exists in the map.
+func (m *ImmutableMap[K, V]) ContainsKey(key K) bool {
+	_, exists := m.Get(key)
+	return exists
+}
+
+// Put returns a new version of the map with the key associated with value.
+func (m *ImmutableMap[K, V]) Put(key K, value V) *ImmutableMap[K, V] {
+	root, added := hamtPut(m.root, 0, m.hash(key), key, value)
+	result := &ImmutableMap[K, V]{root: root, size: m.size, seed: m.seed}
+	if added {
+		result.size++
+	}
+	return result
+}
+
+// Remove returns a version of the map without the key. It returns m itself if the key is absent.
+func (m *ImmutableMap[K, V]) Remove(key K) *ImmutableMap[K, V] {
+	root, removed := hamtRemove(m.root, 0, m.hash(key), key)
+	if !removed {
+		return m
+	}
+	return &ImmutableMap[K, V]{root: root, size: m.size - 1, seed: m.seed}
+}
+
+// Size returns the number of entries in the map.
+func (m *ImmutableMap[K, V]) Size() int {
+	return m.size
+}
+
+// IsEmpty checks if the map is empty.
+func (m *ImmutableMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// ForEach applies a function to each key-value pair in unspecified order.
+func (m *ImmutableMap[K, V]) ForEach(fn func(K, V)) {
+	hamtForEach(m.root, func(key K, value V) bool {
+		fn(key, value)
+		return true
+	})
+}
+
+// Keys returns all keys in unspecified order.
+func (m *ImmutableMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.size)
+	m.ForEach(func(key K, _ V) {
+		keys = append(keys, key)
+	})
+	return keys
+}
+
+// Values returns all values in unspecified order.
+func (m *ImmutableMap[K, V]) Values() []V {
+	values := make([]V, 0, m.size)
+	m.ForEach(func(_ K, value V) {
+		values = append(values, value)
+	})
+	return values
+}
+
+// Entries returns all key-value pairs in unspecified order.
+func (m *ImmutableMap[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, m.size)
+	m.ForEach(func(key K, value V) {
+		entries = append(entries, Entry[K, V]{Key: key, Value: value})
+	})
+	return entries
+}
+
+// ToMap converts the immutable map to a regular Go map.
+func (m *ImmutableMap[K, V]) ToMap() map[K]V {
+	result := make(map[K]V, m.size)
+	m.ForEach(func(key K, value V) {
+		result[key] = value
+	})
+	return result
+}
+
+// String returns a string representation of the map.
+func (m *ImmutableMap[K, V]) String() string {
+	return "ImmutableMap" + strings.TrimPrefix(fmt.Sprint(m.ToMap()), "map")
+}
@@ -0,0 +1,129 @@
+package stl
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+func TestImmutableMapVersions(t *testing.T) {
+	empty := NewImmutableMap[string, int]()
+	one := empty.Put("a", 1)
+	two := one.Put("b", 2)
+	updated := two.Put("a", 10)
+	removed := updated.Remove("b")
+
+	if !empty.IsEmpty() || one.Size() != 1 || two.Size() != 2 || updated.Size() != 2 || removed.Size() != 1 {
+		t.Error("Each version should keep its own size")
+	}
+	if value, _ := two.Get("a"); value != 1 {
+		t.Errorf("Older version should keep 1, got %d", value)
+	}
+	if value, _ := updated.Get("a"); value != 10 {
+		t.Errorf("Expected 10, got %d", value)
+	}
+	if removed.ContainsKey("b") || !updated.ContainsKey("b") {
+		t.Error("Remove should only affect the new version")
+	}
+	if removed.Remove("missing") != removed {
+		t.Error("Removing a missing key should return the same version")
+	}
+	if removed.GetOrDefault("z", -1) != -1 {
+		t.Error("Expected the default for a missing key")
+	}
+	if updated.String() != "ImmutableMap[a:10 b:2]" {
+		t.Errorf("Unexpected map %s", updated.String())
+	}
+}
+
+func TestImmutableMapMatchesMap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	m := NewImmutableMap[int, int]()
+	expected := map[int]int{}
+	var snapshots []*ImmutableMap[int, int]
+	var snapshotSizes []int
+
+	for i := 0; i < 5000; i++ {
+		key := r.Intn(2000)
+		if r.Intn(3) == 0 {
+			m = m.Remove(key)
+			delete(expected, key)
+		} else {
+			m = m.Put(key, i)
+			expected[key] = i
+		}
+		if i%1000 == 0 {
+			snapshots = append(snapshots, m)
+			snapshotSizes = append(snapshotSizes, len(expected))
+		}
+	}
+
+	if m.Size() != len(expected) {
+		t.Fatalf("Expected size %d, got %d", len(expected), m.Size())
+	}
+	for key, value := range expected {
+		if got, ok := m.Get(key); !ok || got != value {
+			t.Fatalf("Expected %d for key %d, got %d", value, key, got)
+		}
+	}
+	if len(m.Entries()) != len(expected) {
+		t.Errorf("Expected %d entries, got %d", len(expected), len(m.Entries()))
+	}
+	for i, snapshot := range snapshots {
+		if len(snapshot.Keys()) != snapshotSizes[i] {
+			t.Errorf("Snapshot %d changed size", i)
+		}
+	}
+}
+
+func TestImmutableMapHashCollisions(t *testing.T) {
+	// Force keys into one bucket and into a long shared hash prefix
+	root := &hamtNode[string, int]{}
+	root, _ = hamtPut(root, 0, 42, "a", 1)
+	root, _ = hamtPut(root, 0, 42, "b", 2)
+	root, _ = hamtPut(root, 0, 42|1<<60, "c", 3)
+
+	for key, expected := range map[string]int{"a": 1, "b": 2, "c": 3} {
+		hash := uint64(42)
+		if key == "c" {
+			hash |= 1 << 60
+		}
+		if value, ok := hamtGet(root, hash, key); !ok || value != expected {
+			t.Errorf("Expected %d for %s, got %d", expected, key, value)
+		}
+	}
+
+	root, _ = hamtRemove(root, 0, 42, "a")
+	root, _ = hamtRemove(root, 0, 42, "b")
+	if _, ok := hamtGet(root, 42, "b"); ok {
+		t.Error("Expected b to be removed")
+	}
+	if len(root.slots) != 1 || root.slots[0].child != nil {
+		t.Error("Expected the remaining leaf to collapse into the root")
+	}
+}
+
+func TestImmutableMapConcurrentReaders(t *testing.T) {
+	m := NewImmutableMap[int, int]()
+	for i := 0; i < 1000; i++ {
+		m = m.Put(i, i*i)
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(version *ImmutableMap[int, int]) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				version = version.Put(i, -1)
+			}
+		}(m)
+	}
+	wg.Wait()
+
+	for i := 0; i < 1000; i++ {
+		if value, _ := m.Get(i); value != i*i {
+			t.Fatalf("Shared version changed at %d", i)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package stl
+
+import (
+	"fmt"
+)
+
+// ImmutableSet represents a persistent set. Add and Remove return new versions that share
+// structure with the original, so every version can be shared freely across goroutines.
+type ImmutableSet[T comparable] struct {
+	m *ImmutableMap[T, struct{}]
+}
+
+// NewImmutableSet creates a new empty immutable set.
+func NewImmutableSet[T comparable]() *ImmutableSet[T] {
+	return &ImmutableSet[T]{m: NewImmutableMap[T, struct{}]()}
+}
+
+// NewImmutableSetFromSlice creates an immutable set from a slice, removing duplicates.
+func NewImmutableSetFromSlice[T comparable](slice []T) *ImmutableSet[T] {
+	m := NewImmutableMap[T, struct{}]()
+	for _, item := range slice {
+		var added bool
+		m.root, added = hamtPut(m.root, 0, m.hash(item), item, struct{}{})
+		if added {
+			m.size++
+		}
+	}
+	return &ImmutableSet[T]{m: m}
+}
+
+// Add returns a new version of the set containing the element.
+func (s *ImmutableSet[T]) Add(element T) *ImmutableSet[T] {
+	if s.Contains(element) {
+		return s
+	}
+	return &ImmutableSet[T]{m: s.m.Put(element, struct{}{})}
+}
+
+// Remove returns a version of the set without the element.
+func (s *ImmutableSet[T]) Remove(element T) *ImmutableSet[T] {
+	m := s.m.Remove(element)
+	if m == s.m {
+		return s
+	}
+	return &ImmutableSet[T]{m: m}
+}
+
+// Contains checks if an element exists in the set.
+func (s *ImmutableSet[T]) Contains(element T) bool {
+	return s.m.ContainsKey(element)
+}
+
+// Size returns the number of elements in the set.
+func (s *ImmutableSet[T]) Size() int {
+	return s.m.Size()
+}
+
+// IsEmpty checks if the set is empty.
+func (s *ImmutableSet[T]) IsEmpty() bool {
+	return s.m.IsEmpty()
+}
+
+// ForEach applies a function to each element in unspecified order.
+func (s *ImmutableSet[T]) ForEach(fn func(T)) {
+	s.m.ForEach(func(element T, _ struct{}) {
+		fn(element)
+	})
+}
+
+// ToSlice converts the set to a slice.
+func (s *ImmutableSet[T]) ToSlice() []T {
+	return s.m.Keys()
+}
+
+// Union returns a set containing all elements from both sets.
+func (s *ImmutableSet[T]) Union(other *ImmutableSet[T]) *ImmutableSet[T] {
+	result := s
+	other.ForEach(func(element T) {
+		result = result.Add(element)
+	})
+	return result
+}
+
+// Intersection returns a set containing elements present in both sets.
+func (s *ImmutableSet[T]) Intersection(other *ImmutableSet[T]) *ImmutableSet[T] {
+	result := s
+	s.ForEach(func(element T) {
+		if !other.Contains(element) {
+			result = result.Remove(element)
+		}
+	})
+	return result
+}
+
+// Difference returns a set containing elements in s but not in other.
+func (s *ImmutableSet[T]) Difference(other *ImmutableSet[T]) *ImmutableSet[T] {
+	result := s
+	other.ForEach(func(element T) {
+		result = result.Remove(element)
+	})
+	return result
+}
+
+// IsSubset checks if s is a subset of other.
+func (s *ImmutableSet[T]) IsSubset(other *ImmutableSet[T]) bool {
+	subset := true
+	hamtForEach(s.m.root, func(element T, _ struct{}) bool {
+		subset = other.Contains(element)
+		return subset
+	})
+	return subset
+}
+
+// Equals checks if two sets contain the same elements.
+func (s *ImmutableSet[T]) Equals(other *ImmutableSet[T]) bool {
+	return s.Size() == other.Size() && s.IsSubset(other)
+}
+
+// ToSet converts the immutable set to a mutable Set.
+func (s *ImmutableSet[T]) ToSet() *Set[T] {
+	return NewSetFromSlice(s.ToSlice())
+}
+
+// String returns a string representation of the set.
+func (s *ImmutableSet[T]) String() string {
+	return fmt.Sprintf("ImmutableSet%v", s.ToSlice())
+}
@@ -0,0 +1,41 @@
+package stl
+
+import (
+	"testing"
+)
+
+func TestImmutableSetVersions(t *testing.T) {
+	base := NewImmutableSetFromSlice([]int{1, 2, 3, 2})
+	added := base.Add(4)
+	removed := added.Remove(1)
+
+	if base.Size() != 3 || added.Size() != 4 || removed.Size() != 3 {
+		t.Error("Each version should keep its own size")
+	}
+	if base.Contains(4) || !added.Contains(1) || removed.Contains(1) {
+		t.Error("Changes should only affect the new version")
+	}
+	if base.Add(1) != base || base.Remove(9) != base {
+		t.Error("No-op changes should return the same version")
+	}
+
+	other := NewImmutableSetFromSlice([]int{3, 4, 5})
+	if union := base.Union(other); union.Size() != 5 {
+		t.Errorf("Expected 5 elements, got %v", union.ToSlice())
+	}
+	if inter := base.Intersection(other); !inter.Equals(NewImmutableSetFromSlice([]int{3})) {
+		t.Errorf("Unexpected intersection %v", inter.ToSlice())
+	}
+	if diff := base.Difference(other); !diff.Equals(NewImmutableSetFromSlice([]int{1, 2})) {
+		t.Errorf("Unexpected difference %v", diff.ToSlice())
+	}
+	if !base.IsSubset(added) || added.IsSubset(base) {
+		t.Error("IsSubset returned an unexpected result")
+	}
+	if !base.ToSet().Equals(NewSetFromSlice([]int{1, 2, 3})) {
+		t.Error("ToSet should hold the same elements")
+	}
+	if !NewImmutableSet[int]().IsEmpty() {
+		t.Error("New set should be empty")
+	}
+}
@@ -0,0 +1,217 @@
+package stl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IndexedPriorityQueue is a priority queue of unique keys whose priorities can be changed or
+// removed in O(log n) by key, as needed by Dijkstra, A*, and task rescheduling.
+type IndexedPriorityQueue[K comparable, P any] struct {
+	less  func(P, P) bool
+	heap  []Entry[K, P]
+	index map[K]int
+}
+
+// NewIndexedPriorityQueue creates an indexed priority queue; the key with the priority that
+// is less than all others according to less is dequeued first.
+func NewIndexedPriorityQueue[K comparable, P any](less func(P, P) bool) *IndexedPriorityQueue[K, P] {
+	return &IndexedPriorityQueue[K, P]{
+		less:  less,
+		index: make(map[K]int),
+	}
+}
+
+// Push adds a key with the given priority, or updates its priority if the key is already
+// queued. It returns true if the key was newly added.
+func (pq *IndexedPriorityQueue[K, P]) Push(key K, priority P) bool {
+	if _, exists := pq.index[key]; exists {
+		pq.UpdatePriority(key, priority)
+		return false
+	}
+
+	pq.heap = append(pq.heap, Entry[K, P]{Key: key, Value: priority})
+	pq.index[key] = len(pq.heap) - 1
+	pq.up(len(pq.heap) - 1)
+	return true
+}
+
+// Pop removes and returns the key with the highest priority.
+func (pq *IndexedPriorityQueue[K, P]) Pop() (K, P, bool) {
+	if len(pq.heap) == 0 {
+		var zeroK K
+		var zeroP P
+		return zeroK, zeroP, false
+	}
+
+	top := pq.heap[0]
+	pq.removeAt(0)
+	return top.Key, top.Value, true
+}
+
+// Peek returns the key with the highest priority without removing it.
+func (pq *IndexedPriorityQueue[K, P]) Peek() (K, P, bool) {
+	if len(pq.heap) == 0 {
+		var zeroK K
+		var zeroP P
+		return zeroK, zeroP, false
+	}
+	return pq.heap[0].Key, pq.heap[0].Value, true
+}
+
+// Contains checks if the key is queued.
+func (pq *IndexedPriorityQueue[K, P]) Contains(key K) bool {
+	_, exists := pq.index[key]
+	return exists
+}
+
+// Priority returns the current priority of a key.
+func (pq *IndexedPriorityQueue[K, P]) Priority(key K) (P, bool) {
+	i, exists := pq.index[key]
+	if !exists {
+		var zero P
+		return zero, false
+	}
+	return pq.heap[i].Value, true
+}
+
+// UpdatePriority changes the priority of a queued key in either direction. It returns false
+// if the key is not queued.
+func (pq *IndexedPriorityQueue[K, P]) UpdatePriority(key K, priority P) bool {
+	i, exists := pq.index[key]
+	if !exists {
+		return false
+	}
+
+	pq.heap[i].Value = priority
+	pq.fix(i)
+	return true
+}
+
+// DecreaseKey lowers the priority value of a queued key, moving it closer to the front. It
+// returns false and leaves the queue unchanged if the key is not queued or priority is not
+// less than its current priority, which makes it a direct fit for edge relaxation.
+func (pq *IndexedPriorityQueue[K, P]) DecreaseKey(key K, priority P) bool {
+	i, exists := pq.index[key]
+	if !exists || !pq.less(priority, pq.heap[i].Value) {
+		return false
+	}
+
+	pq.heap[i].Value = priority
+	pq.up(i)
+	return true
+}
+
+// Remove removes a key from the queue and returns its priority.
+func (pq *IndexedPriorityQueue[K, P]) Remove(key K) (P, bool) {
+	i, exists := pq.index[key]
+	if !exists {
+		var zero P
+		return zero, false
+	}
+
+	priority := pq.heap[i].Value
+	pq.removeAt(i)
+	return priority, true
+}
+
+// Size returns the number of queued keys.
+func (pq *IndexedPriorityQueue[K, P]) Size() int {
+	return len(pq.heap)
+}
+
+// IsEmpty returns true if the queue is empty.
+func (pq *IndexedPriorityQueue[K, P]) IsEmpty() bool {
+	return len(pq.heap) == 0
+}
+
+// Clear removes all keys from the queue.
+func (pq *IndexedPriorityQueue[K, P]) Clear() {
+	pq.heap = pq.heap[:0]
+	clear(pq.index)
+}
+
+// Keys returns the queued keys in heap order.
+func (pq *IndexedPriorityQueue[K, P]) Keys() []K {
+	keys := make([]K, len(pq.heap))
+	for i, entry := range pq.heap {
+		keys[i] = entry.Key
+	}
+	return keys
+}
+
+// String returns a string representation of the queue in heap order.
+func (pq *IndexedPriorityQueue[K, P]) String() string {
+	parts := make([]string, len(pq.heap))
+	for i, entry := range pq.heap {
+		parts[i] = fmt.Sprintf("%v:%v", entry.Key, entry.Value)
+	}
+	return "IndexedPriorityQueue[" + strings.Join(parts, " ") + "]"
+}
+
+// removeAt removes the entry at heap position i.
+func (pq *IndexedPriorityQueue[K, P]) removeAt(i int) {
+	last := len(pq.heap) - 1
+	delete(pq.index, pq.heap[i].Key)
+
+	if i != last {
+		pq.heap[i] = pq.heap[last]
+		pq.index[pq.heap[i].Key] = i
+	}
+	pq.heap[last] = Entry[K, P]{}
+	pq.heap = pq.heap[:last]
+
+	if i < len(pq.heap) {
+		pq.fix(i)
+	}
+}
+
+// fix restores the heap property after the priority at i changed in either direction.
+func (pq *IndexedPriorityQueue[K, P]) fix(i int) {
+	if !pq.up(i) {
+		pq.down(i)
+	}
+}
+
+// swap exchanges two heap positions and keeps the index in sync.
+func (pq *IndexedPriorityQueue[K, P]) swap(i, j int) {
+	pq.heap[i], pq.heap[j] = pq.heap[j], pq.heap[i]
+	pq.index[pq.heap[i].Key] = i
+	pq.index[pq.heap[j].Key] = j
+}
+
+// up moves an entry toward the root and reports whether it moved.
+func (pq *IndexedPriorityQueue[K, P]) up(i int) bool {
+	start := i
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(pq.heap[i].Value, pq.heap[parent].Value) {
+			break
+		}
+		pq.swap(i, parent)
+		i = parent
+	}
+	return i != start
+}
+
+// down moves an entry toward the leaves.
+func (pq *IndexedPriorityQueue[K, P]) down(i int) {
+	for {
+		left := 2*i + 1
+		right := left + 1
+		smallest := i
+
+		if left < len(pq.heap) && pq.less(pq.heap[left].Value, pq.heap[smallest].Value) {
+			smallest = left
+		}
+		if right < len(pq.heap) && pq.less(pq.heap[right].Value, pq.heap[smallest].Value) {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+
+		pq.swap(i, smallest)
+		i = smallest
+	}
+}
@@ -0,0 +1,90 @@
+package stl
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestIndexedPriorityQueueBasicOperations(t *testing.T) {
+	pq := NewIndexedPriorityQueue[string, int](lessInt)
+
+	if !pq.Push("a", 5) || !pq.Push("b", 3) || !pq.Push("c", 8) {
+		t.Error("Expected new keys to be added")
+	}
+	if pq.Push("a", 9) {
+		t.Error("Pushing an existing key should update it")
+	}
+	if priority, _ := pq.Priority("a"); priority != 9 {
+		t.Errorf("Expected priority 9, got %d", priority)
+	}
+
+	if !pq.DecreaseKey("c", 1) || pq.DecreaseKey("c", 4) || pq.DecreaseKey("z", 0) {
+		t.Error("DecreaseKey returned an unexpected result")
+	}
+	if key, priority, _ := pq.Peek(); key != "c" || priority != 1 {
+		t.Errorf("Expected c:1 at the front, got %s:%d", key, priority)
+	}
+
+	if !pq.UpdatePriority("c", 10) || pq.UpdatePriority("z", 1) {
+		t.Error("UpdatePriority returned an unexpected result")
+	}
+	if priority, ok := pq.Remove("b"); !ok || priority != 3 {
+		t.Errorf("Expected to remove b:3, got %d", priority)
+	}
+	if pq.Contains("b") || pq.Size() != 2 {
+		t.Error("Removed key should no longer be queued")
+	}
+
+	var order []string
+	for !pq.IsEmpty() {
+		key, _, _ := pq.Pop()
+		order = append(order, key)
+	}
+	if len(order) != 2 || order[0] != "a" || order[1] != "c" {
+		t.Errorf("Expected order [a c], got %v", order)
+	}
+	if _, _, ok := pq.Pop(); ok {
+		t.Error("Pop on an empty queue should fail")
+	}
+}
+
+func TestIndexedPriorityQueueRandomUpdates(t *testing.T) {
+	pq := NewIndexedPriorityQueue[int, int](lessInt)
+	expected := make(map[int]int)
+	r := rand.New(rand.NewSource(7))
+
+	for i := 0; i < 2000; i++ {
+		key := r.Intn(200)
+		switch r.Intn(3) {
+		case 0:
+			priority := r.Intn(1000)
+			pq.Push(key, priority)
+			expected[key] = priority
+		case 1:
+			priority := r.Intn(1000)
+			if pq.UpdatePriority(key, priority) {
+				expected[key] = priority
+			}
+		default:
+			pq.Remove(key)
+			delete(expected, key)
+		}
+	}
+
+	var want []int
+	for _, priority := range expected {
+		want = append(want, priority)
+	}
+	sort.Ints(want)
+
+	for i := range want {
+		key, priority, _ := pq.Pop()
+		if priority != want[i] || expected[key] != priority {
+			t.Fatalf("Pop %d: expected priority %d, got %d for key %d", i, want[i], priority, key)
+		}
+	}
+	if !pq.IsEmpty() {
+		t.Errorf("Expected an empty queue, got %d keys", pq.Size())
+	}
+}
@@ -0,0 +1,361 @@
+package stl
+
+import "iter"
+
+// Iterator is a cursor over the elements of a container. A new iterator is positioned
+// before the first element; call Next to advance to it.
+type Iterator[T any] interface {
+	// Next moves to the next element and reports whether one exists.
+	Next() bool
+	// Valid reports whether the iterator is positioned at an element.
+	Valid() bool
+	// Value returns the current element, or the zero value if the iterator is not valid.
+	Value() T
+	// Remove deletes the current element from the container. The iterator stays between its
+	// neighbors, so moving continues from the removed element. It returns false if the
+	// iterator is not valid.
+	Remove() bool
+}
+
+// BidirectionalIterator is an Iterator that can also move backwards and jump to either end.
+type BidirectionalIterator[T any] interface {
+	Iterator[T]
+	// Prev moves to the previous element and reports whether one exists.
+	Prev() bool
+	// First moves to the first element and reports whether the container is non-empty.
+	First() bool
+	// Last moves to the last element and reports whether the container is non-empty.
+	Last() bool
+}
+
+// SeekableIterator is a BidirectionalIterator over a sorted container.
+type SeekableIterator[T any] interface {
+	BidirectionalIterator[T]
+	// Seek moves to the smallest element greater than or equal to target and reports whether
+	// one exists. If none does, the iterator is positioned after the last element.
+	Seek(target T) bool
+}
+
+// MapIterator is a cursor over the entries of a map-like container, positioned before the
+// first entry when created.
+type MapIterator[K, V any] interface {
+	// Next moves to the next entry and reports whether one exists.
+	Next() bool
+	// Valid reports whether the iterator is positioned at an entry.
+	Valid() bool
+	// Key returns the key of the current entry, or the zero value if the iterator is not valid.
+	Key() K
+	// Value returns the value of the current entry, or the zero value if the iterator is not
+	// valid.
+	Value() V
+	// Remove deletes the current entry from the container. It returns false if the iterator
+	// is not valid.
+	Remove() bool
+}
+
+// BidirectionalMapIterator is a MapIterator that can also move backwards and jump to either
+// end.
+type BidirectionalMapIterator[K, V any] interface {
+	MapIterator[K, V]
+	Prev() bool
+	First() bool
+	Last() bool
+}
+
+// SeekableMapIterator is a BidirectionalMapIterator over a map sorted by key.
+type SeekableMapIterator[K, V any] interface {
+	BidirectionalMapIterator[K, V]
+	// Seek moves to the entry with the smallest key greater than or equal to key and reports
+	// whether one exists.
+	Seek(key K) bool
+}
+
+// IteratorSeq adapts an iterator to a range-over-func sequence that advances it with Next.
+func IteratorSeq[T any](it Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it.Next() {
+			if !yield(it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// MapIteratorSeq adapts a map iterator to a range-over-func sequence that advances it with
+// Next.
+func MapIteratorSeq[K, V any](it MapIterator[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for it.Next() {
+			if !yield(it.Key(), it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// indexIterator is a bidirectional cursor over positions 0 through size()-1 of an indexed
+// container.
+type indexIterator[T any] struct {
+	at       func(int) T
+	size     func() int
+	removeAt func(int)
+	index    int
+	removed  bool
+}
+
+// newIndexIterator returns an index iterator positioned before the first element.
+func newIndexIterator[T any](at func(int) T, size func() int, removeAt func(int)) *indexIterator[T] {
+	return &indexIterator[T]{at: at, size: size, removeAt: removeAt, index: -1}
+}
+
+// Next moves to the next position and reports whether it holds an element.
+func (it *indexIterator[T]) Next() bool {
+	// After a removal the next element has already shifted into the current index
+	if !it.removed {
+		it.index++
+	}
+	it.removed = false
+	it.index = min(it.index, it.size())
+	return it.Valid()
+}
+
+// Prev moves to the previous position and reports whether it holds an element.
+func (it *indexIterator[T]) Prev() bool {
+	it.removed = false
+	it.index = max(min(it.index, it.size())-1, -1)
+	return it.Valid()
+}
+
+// First moves to the first element.
+func (it *indexIterator[T]) First() bool {
+	it.index, it.removed = 0, false
+	return it.Valid()
+}
+
+// Last moves to the last element.
+func (it *indexIterator[T]) Last() bool {
+	it.index, it.removed = it.size()-1, false
+	return it.Valid()
+}
+
+// Valid reports whether the iterator is positioned at an element.
+func (it *indexIterator[T]) Valid() bool {
+	return !it.removed && it.index >= 0 && it.index < it.size()
+}
+
+// Value returns the current element.
+func (it *indexIterator[T]) Value() T {
+	if !it.Valid() {
+		var zero T
+		return zero
+	}
+	return it.at(it.index)
+}
+
+// Remove deletes the current element.
+func (it *indexIterator[T]) Remove() bool {
+	if !it.Valid() {
+		return false
+	}
+	it.removeAt(it.index)
+	it.removed = true
+	return true
+}
+
+// snapshotIterator returns an iterator over a copy of items, for containers without a stable
+// order. remove deletes an item from the underlying container.
+func snapshotIterator[T any](items []T, remove func(T)) *indexIterator[T] {
+	return newIndexIterator(
+		func(i int) T { return items[i] },
+		func() int { return len(items) },
+		func(i int) {
+			remove(items[i])
+			items = append(items[:i], items[i+1:]...)
+		},
+	)
+}
+
+// listIterator is a bidirectional cursor over the elements of a LinkedList.
+type listIterator[T any] struct {
+	list     *LinkedList[T]
+	remove   func(*Element[T])
+	current  *Element[T]
+	prev     *Element[T] // neighbors of a removed element
+	next     *Element[T]
+	position iteratorPosition
+}
+
+// Next moves to the next element.
+func (it *listIterator[T]) Next() bool {
+	switch it.position {
+	case beforeFirst:
+		return it.First()
+	case afterLast:
+		return false
+	case removedEntry:
+		return it.moveTo(it.next, afterLast)
+	}
+	return it.moveTo(it.current.Next(), afterLast)
+}
+
+// Prev moves to the previous element.
+func (it *listIterator[T]) Prev() bool {
+	switch it.position {
+	case afterLast:
+		return it.Last()
+	case beforeFirst:
+		return false
+	case removedEntry:
+		return it.moveTo(it.prev, beforeFirst)
+	}
+	return it.moveTo(it.current.Prev(), beforeFirst)
+}
+
+// First moves to the front of the list.
+func (it *listIterator[T]) First() bool {
+	return it.moveTo(it.list.Front(), afterLast)
+}
+
+// Last moves to the back of the list.
+func (it *listIterator[T]) Last() bool {
+	return it.moveTo(it.list.Back(), beforeFirst)
+}
+
+// moveTo positions the iterator at e, or at the end position if e is nil.
+func (it *listIterator[T]) moveTo(e *Element[T], end iteratorPosition) bool {
+	it.current, it.prev, it.next = e, nil, nil
+	if e == nil {
+		it.position = end
+		return false
+	}
+	it.position = atEntry
+	return true
+}
+
+// Valid reports whether the iterator is positioned at an element.
+func (it *listIterator[T]) Valid() bool {
+	return it.position == atEntry
+}
+
+// Value returns the current element.
+func (it *listIterator[T]) Value() T {
+	if !it.Valid() {
+		var zero T
+		return zero
+	}
+	return it.current.Value
+}
+
+// Remove deletes the current element.
+func (it *listIterator[T]) Remove() bool {
+	if !it.Valid() {
+		return false
+	}
+	it.prev, it.next = it.current.Prev(), it.current.Next()
+	it.remove(it.current)
+	it.current, it.position = nil, removedEntry
+	return true
+}
+
+// orderedIterator is a seekable cursor over a sorted container that navigates by value, so
+// the container may be modified while iterating.
+type orderedIterator[T any] struct {
+	min, max      func() (T, bool)
+	higher, lower func(T) (T, bool)
+	ceiling       func(T) (T, bool)
+	remove        func(T)
+	value         T
+	position      iteratorPosition
+}
+
+// Next moves to the next larger element.
+func (it *orderedIterator[T]) Next() bool {
+	switch it.position {
+	case beforeFirst:
+		return it.First()
+	case afterLast:
+		return false
+	}
+	value, ok := it.higher(it.value)
+	return it.moveTo(value, ok, afterLast)
+}
+
+// Prev moves to the next smaller element.
+func (it *orderedIterator[T]) Prev() bool {
+	switch it.position {
+	case afterLast:
+		return it.Last()
+	case beforeFirst:
+		return false
+	}
+	value, ok := it.lower(it.value)
+	return it.moveTo(value, ok, beforeFirst)
+}
+
+// First moves to the smallest element.
+func (it *orderedIterator[T]) First() bool {
+	value, ok := it.min()
+	return it.moveTo(value, ok, afterLast)
+}
+
+// Last moves to the largest element.
+func (it *orderedIterator[T]) Last() bool {
+	value, ok := it.max()
+	return it.moveTo(value, ok, beforeFirst)
+}
+
+// Seek moves to the smallest element greater than or equal to target.
+func (it *orderedIterator[T]) Seek(target T) bool {
+	value, ok := it.ceiling(target)
+	return it.moveTo(value, ok, afterLast)
+}
+
+// moveTo positions the iterator at value, or at the end position if ok is false.
+func (it *orderedIterator[T]) moveTo(value T, ok bool, end iteratorPosition) bool {
+	if !ok {
+		var zero T
+		it.value, it.position = zero, end
+		return false
+	}
+	it.value, it.position = value, atEntry
+	return true
+}
+
+// Valid reports whether the iterator is positioned at an element.
+func (it *orderedIterator[T]) Valid() bool {
+	return it.position == atEntry
+}
+
+// Value returns the current element.
+func (it *orderedIterator[T]) Value() T {
+	if !it.Valid() {
+		var zero T
+		return zero
+	}
+	return it.value
+}
+
+// Remove deletes the current element.
+func (it *orderedIterator[T]) Remove() bool {
+	if !it.Valid() {
+		return false
+	}
+	it.remove(it.value)
+	it.position = removedEntry
+	return true
+}
+
+// entryIterator presents an iterator over entries as a map iterator.
+type entryIterator[K comparable, V any] struct {
+	BidirectionalIterator[Entry[K, V]]
+}
+
+// Key returns the key of the current entry.
+func (it entryIterator[K, V]) Key() K {
+	return it.BidirectionalIterator.Value().Key
+}
+
+// Value returns the value of the current entry.
+func (it entryIterator[K, V]) Value() V {
+	return it.BidirectionalIterator.Value().Value
+}
@@ -0,0 +1,141 @@
+package stl
+
+import (
+	"slices"
+	"testing"
+)
+
+// removeEvens is a generic algorithm written against Iterator alone.
+func removeEvens(it Iterator[int]) {
+	for it.Next() {
+		if it.Value()%2 == 0 {
+			it.Remove()
+		}
+	}
+}
+
+func TestIteratorRemoveWhileIterating(t *testing.T) {
+	stack := NewStack[int]()
+	stack.PushAll([]int{1, 2, 2, 3, 4})
+	queue := NewQueue[int]()
+	queue.EnqueueAll([]int{1, 2, 2, 3, 4})
+	deque := NewDequeFromSlice([]int{1, 2, 2, 3, 4})
+	list := NewLinkedListFromSlice([]int{1, 2, 2, 3, 4})
+	set := NewSetFromSlice([]int{1, 2, 3, 4})
+	treeSet := NewTreeSetFromSlice([]int{1, 2, 3, 4}, lessInt)
+	bst := NewBSTFromSlice([]int{3, 1, 2, 4}, lessInt)
+
+	iterators := map[string]Iterator[int]{
+		"Stack":      stack.Iterator(),
+		"Queue":      queue.Iterator(),
+		"Deque":      deque.Iterator(),
+		"LinkedList": list.Iterator(),
+		"Set":        set.Iterator(),
+		"TreeSet":    treeSet.Iterator(),
+		"BST":        bst.Iterator(),
+	}
+	for name, it := range iterators {
+		removeEvens(it)
+		if it.Valid() || it.Next() {
+			t.Errorf("%s: expected iterator to be exhausted", name)
+		}
+	}
+
+	for name, got := range map[string][]int{
+		"Stack":      stack.ToSlice(),
+		"Queue":      queue.ToSlice(),
+		"Deque":      deque.ToSlice(),
+		"LinkedList": list.ToSlice(),
+		"TreeSet":    treeSet.ToSlice(),
+		"BST":        bst.InOrder(),
+	} {
+		if !slices.Equal(got, []int{1, 3}) {
+			t.Errorf("%s: expected [1 3] after removing evens, got %v", name, got)
+		}
+	}
+	if set.Size() != 2 || set.Contains(2) || set.Contains(4) {
+		t.Errorf("Set: expected {1, 3} after removing evens, got %v", set)
+	}
+}
+
+func TestBidirectionalIterator(t *testing.T) {
+	for name, it := range map[string]BidirectionalIterator[int]{
+		"Deque":      NewDequeFromSlice([]int{1, 2, 3}).Iterator(),
+		"LinkedList": NewLinkedListFromSlice([]int{1, 2, 3}).Iterator(),
+		"TreeSet":    NewTreeSetFromSlice([]int{3, 1, 2}, lessInt).Iterator(),
+	} {
+		if it.Prev() {
+			t.Errorf("%s: Prev before the first element should fail", name)
+		}
+		var backwards []int
+		for it.Last(); it.Valid(); it.Prev() {
+			backwards = append(backwards, it.Value())
+		}
+		if !slices.Equal(backwards, []int{3, 2, 1}) {
+			t.Errorf("%s: expected [3 2 1] backwards, got %v", name, backwards)
+		}
+
+		// Removing the middle element leaves the iterator between its neighbors
+		it.First()
+		it.Next()
+		it.Remove()
+		if it.Valid() || !it.Prev() || it.Value() != 1 {
+			t.Errorf("%s: expected Prev after Remove to reach 1, got %v", name, it.Value())
+		}
+		if !it.Next() || it.Value() != 3 {
+			t.Errorf("%s: expected Next to reach 3, got %v", name, it.Value())
+		}
+	}
+}
+
+func TestSeekableIterator(t *testing.T) {
+	for name, it := range map[string]SeekableIterator[int]{
+		"TreeSet": NewTreeSetFromSlice([]int{10, 20, 30}, lessInt).Iterator(),
+		"BST":     NewBSTFromSlice([]int{20, 10, 30}, lessInt).Iterator(),
+	} {
+		if !it.Seek(15) || it.Value() != 20 {
+			t.Errorf("%s: expected Seek(15) to land on 20, got %v", name, it.Value())
+		}
+		got := slices.Collect(IteratorSeq[int](it))
+		if !slices.Equal(got, []int{30}) {
+			t.Errorf("%s: expected IteratorSeq to continue with [30], got %v", name, got)
+		}
+		if it.Seek(31) || it.Valid() {
+			t.Errorf("%s: expected Seek past the end to fail", name)
+		}
+	}
+}
+
+func TestMapIterators(t *testing.T) {
+	lhm := NewLinkedHashMap[string, int](false)
+	lhm.Put("a", 1)
+	lhm.Put("b", 2)
+	lhm.Put("c", 3)
+
+	it := lhm.Iterator()
+	var keys []string
+	for key, value := range MapIteratorSeq[string, int](it) {
+		keys = append(keys, key)
+		if value == 2 {
+			it.Remove()
+		}
+	}
+	if !slices.Equal(keys, []string{"a", "b", "c"}) || lhm.ContainsKey("b") {
+		t.Errorf("Expected to visit a, b, c and remove b, got %v and %v", keys, lhm)
+	}
+	if !it.Last() || it.Key() != "c" || !it.Prev() || it.Key() != "a" {
+		t.Error("Expected LinkedHashMap iterator to move backwards from c to a")
+	}
+
+	mm := NewMultiMap[string, int]()
+	mm.PutAll("x", []int{1, 2, 3})
+	mmIt := mm.Iterator()
+	for mmIt.Next() {
+		if mmIt.Key() == "x" && mmIt.Value() == 2 {
+			mmIt.Remove()
+		}
+	}
+	if mm.Size() != 2 || mm.ContainsEntry("x", 2) {
+		t.Errorf("Expected x:2 to be removed, got %v", mm)
+	}
+}
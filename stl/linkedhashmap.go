@@ -0,0 +1,215 @@
+package stl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LinkedHashMap represents a hash map that iterates in a predictable order. By default the
+// order is insertion order; in access-order mode every Put and Get moves the entry to the
+// back, so the front holds the least recently used entry, as in an LRU cache.
+type LinkedHashMap[K comparable, V any] struct {
+	index       map[K]*Element[Entry[K, V]]
+	order       *LinkedList[Entry[K, V]]
+	accessOrder bool
+}
+
+// NewLinkedHashMap creates a new empty linked hash map, ordered by access instead of
+// insertion if accessOrder is true.
+func NewLinkedHashMap[K comparable, V any](accessOrder bool) *LinkedHashMap[K, V] {
+	return &LinkedHashMap[K, V]{
+		index:       make(map[K]*Element[Entry[K, V]]),
+		order:       NewLinkedList[Entry[K, V]](),
+		accessOrder: accessOrder,
+	}
+}
+
+// Put associates a value with a key. In insertion-order mode an existing key keeps its
+// position; in access-order mode it moves to the back.
+func (m *LinkedHashMap[K, V]) Put(key K, value V) {
+	if e, exists := m.index[key]; exists {
+		e.Value.Value = value
+		if m.accessOrder {
+			m.order.MoveToBack(e)
+		}
+		return
+	}
+	m.index[key] = m.order.PushBack(Entry[K, V]{Key: key, Value: value})
+}
+
+// Get returns the value associated with a key. In access-order mode the entry moves to the back.
+func (m *LinkedHashMap[K, V]) Get(key K) (V, bool) {
+	e, exists := m.index[key]
+	if !exists {
+		var zero V
+		return zero, false
+	}
+	if m.accessOrder {
+		m.order.MoveToBack(e)
+	}
+	return e.Value.Value, true
+}
+
+// Peek returns the value associated with a key without changing the order.
+func (m *LinkedHashMap[K, V]) Peek(key K) (V, bool) {
+	if e, exists := m.index[key]; exists {
+		return e.Value.Value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// GetOrDefault returns the value associated with a key or a default value if not found.
+func (m *LinkedHashMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if value, exists := m.Get(key); exists {
+		return value
+	}
+	return defaultValue
+}
+
+// Remove removes a key and reports whether it was present.
+func (m *LinkedHashMap[K, V]) Remove(key K) bool {
+	e, exists := m.index[key]
+	if !exists {
+		return false
+	}
+	m.order.Remove(e)
+	delete(m.index, key)
+	return true
+}
+
+// ContainsKey checks if a key exists in the map without changing the order.
+func (m *LinkedHashMap[K, V]) ContainsKey(key K) bool {
+	_, exists := m.index[key]
+	return exists
+}
+
+// Oldest returns the entry at the front: the first inserted, or the least recently used
+// in access-order mode.
+func (m *LinkedHashMap[K, V]) Oldest() (K, V, bool) {
+	return m.entryAt(m.order.Front())
+}
+
+// Newest returns the entry at the back: the last inserted, or the most recently used
+// in access-order mode.
+func (m *LinkedHashMap[K, V]) Newest() (K, V, bool) {
+	return m.entryAt(m.order.Back())
+}
+
+// RemoveOldest removes and returns the entry at the front, for example to evict the least
+// recently used entry.
+func (m *LinkedHashMap[K, V]) RemoveOldest() (K, V, bool) {
+	key, value, ok := m.Oldest()
+	if ok {
+		m.Remove(key)
+	}
+	return key, value, ok
+}
+
+// entryAt unpacks an element, or reports false for nil.
+func (m *LinkedHashMap[K, V]) entryAt(e *Element[Entry[K, V]]) (K, V, bool) {
+	if e == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	return e.Value.Key, e.Value.Value, true
+}
+
+// Size returns the number of entries in the map.
+func (m *LinkedHashMap[K, V]) Size() int {
+	return len(m.index)
+}
+
+// IsEmpty checks if the map is empty.
+func (m *LinkedHashMap[K, V]) IsEmpty() bool {
+	return len(m.index) == 0
+}
+
+// Clear removes all entries from the map.
+func (m *LinkedHashMap[K, V]) Clear() {
+	m.index = make(map[K]*Element[Entry[K, V]])
+	m.order.Clear()
+}
+
+// IsAccessOrder checks if the map is ordered by access instead of insertion.
+func (m *LinkedHashMap[K, V]) IsAccessOrder() bool {
+	return m.accessOrder
+}
+
+// Keys returns all keys in order.
+func (m *LinkedHashMap[K, V]) Keys() []K {
+	return m.AppendKeys(make([]K, 0, len(m.index)))
+}
+
+// AppendKeys appends the keys in order to dst and returns the extended slice.
+func (m *LinkedHashMap[K, V]) AppendKeys(dst []K) []K {
+	m.order.ForEach(func(entry Entry[K, V]) {
+		dst = append(dst, entry.Key)
+	})
+	return dst
+}
+
+// Values returns all values in key order.
+func (m *LinkedHashMap[K, V]) Values() []V {
+	return m.AppendValues(make([]V, 0, len(m.index)))
+}
+
+// AppendValues appends the values in key order to dst and returns the extended slice.
+func (m *LinkedHashMap[K, V]) AppendValues(dst []V) []V {
+	m.order.ForEach(func(entry Entry[K, V]) {
+		dst = append(dst, entry.Value)
+	})
+	return dst
+}
+
+// Entries returns all key-value pairs in order.
+func (m *LinkedHashMap[K, V]) Entries() []Entry[K, V] {
+	return m.order.ToSlice()
+}
+
+// ForEach applies a function to each key-value pair in order without changing the order.
+func (m *LinkedHashMap[K, V]) ForEach(fn func(K, V)) {
+	m.order.ForEach(func(entry Entry[K, V]) {
+		fn(entry.Key, entry.Value)
+	})
+}
+
+// Iterator returns a bidirectional iterator over the entries in the map's order. Moving the
+// iterator does not count as an access in access-order mode.
+func (m *LinkedHashMap[K, V]) Iterator() BidirectionalMapIterator[K, V] {
+	return entryIterator[K, V]{&listIterator[Entry[K, V]]{
+		list:   m.order,
+		remove: func(e *Element[Entry[K, V]]) { m.Remove(e.Value.Key) },
+	}}
+}
+
+// Clone creates a copy of the map with the same order and mode.
+func (m *LinkedHashMap[K, V]) Clone() *LinkedHashMap[K, V] {
+	result := NewLinkedHashMap[K, V](m.accessOrder)
+	m.order.ForEach(func(entry Entry[K, V]) {
+		result.index[entry.Key] = result.order.PushBack(entry)
+	})
+	return result
+}
+
+// CloneWith creates a copy of the map with the same order and mode whose keys are copied by
+// cloneKey and values by cloneValue. A nil function copies as is.
+func (m *LinkedHashMap[K, V]) CloneWith(cloneKey func(K) K, cloneValue func(V) V) *LinkedHashMap[K, V] {
+	cloneKey, cloneValue = orIdentity(cloneKey), orIdentity(cloneValue)
+	result := NewLinkedHashMap[K, V](m.accessOrder)
+	m.order.ForEach(func(entry Entry[K, V]) {
+		clone := Entry[K, V]{Key: cloneKey(entry.Key), Value: cloneValue(entry.Value)}
+		result.index[clone.Key] = result.order.PushBack(clone)
+	})
+	return result
+}
+
+// String returns a string representation of the map.
+func (m *LinkedHashMap[K, V]) String() string {
+	parts := make([]string, 0, len(m.index))
+	m.order.ForEach(func(entry Entry[K, V]) {
+		parts = append(parts, fmt.Sprintf("%v:%v", entry.Key, entry.Value))
+	})
+	return "LinkedHashMap[" + strings.Join(parts, " ") + "]"
+}
@@ -0,0 +1,72 @@
+package stl
+
+import (
+	"testing"
+)
+
+func TestLinkedHashMapInsertionOrder(t *testing.T) {
+	m := NewLinkedHashMap[string, int](false)
+	m.Put("b", 2)
+	m.Put("a", 1)
+	m.Put("c", 3)
+	m.Put("b", 20)
+	m.Get("a")
+
+	if m.String() != "LinkedHashMap[b:20 a:1 c:3]" || m.Size() != 3 {
+		t.Errorf("Unexpected map %s", m.String())
+	}
+	keys := m.Keys()
+	if len(keys) != 3 || keys[0] != "b" || keys[2] != "c" {
+		t.Errorf("Expected [b a c], got %v", keys)
+	}
+	if values := m.Values(); values[0] != 20 {
+		t.Errorf("Expected updated value first, got %v", values)
+	}
+	if entries := m.Entries(); entries[1].Key != "a" || entries[1].Value != 1 {
+		t.Errorf("Unexpected entries %v", entries)
+	}
+
+	if !m.Remove("a") || m.Remove("a") || m.ContainsKey("a") {
+		t.Error("Expected Remove to succeed once")
+	}
+	if value := m.GetOrDefault("z", -1); value != -1 {
+		t.Errorf("Expected default -1, got %d", value)
+	}
+
+	clone := m.Clone()
+	m.Clear()
+	if !m.IsEmpty() || clone.String() != "LinkedHashMap[b:20 c:3]" {
+		t.Errorf("Clone should be independent, got %s", clone.String())
+	}
+}
+
+func TestLinkedHashMapAccessOrder(t *testing.T) {
+	m := NewLinkedHashMap[int, string](true)
+	for i := 1; i <= 4; i++ {
+		m.Put(i, "v")
+	}
+	m.Get(1)
+	m.Put(2, "w")
+	m.Peek(3)
+
+	if key, _, _ := m.Oldest(); key != 3 {
+		t.Errorf("Expected least recently used 3, got %d", key)
+	}
+	if key, value, _ := m.Newest(); key != 2 || value != "w" {
+		t.Errorf("Expected most recently used 2, got %d", key)
+	}
+
+	// Evict down to two entries, LRU first
+	for m.Size() > 2 {
+		m.RemoveOldest()
+	}
+	keys := m.Keys()
+	if len(keys) != 2 || keys[0] != 1 || keys[1] != 2 {
+		t.Errorf("Expected [1 2], got %v", keys)
+	}
+
+	m.Clear()
+	if _, _, ok := m.RemoveOldest(); ok {
+		t.Error("RemoveOldest on an empty map should fail")
+	}
+}
@@ -0,0 +1,379 @@
+package stl
+
+import (
+	"fmt"
+)
+
+// Element is a handle to a value stored in a LinkedList. It stays valid until the value is
+// removed, so insertions, moves, and removals through it take O(1) time.
+type Element[T any] struct {
+	Value T
+	next  *Element[T]
+	prev  *Element[T]
+	owner *listOwner[T]
+}
+
+// listOwner records which list an element belongs to. Splicing a list forwards its owner to
+// the receiving list instead of rewriting every element, keeping splices O(1).
+type listOwner[T any] struct {
+	list    *LinkedList[T]
+	forward *listOwner[T]
+}
+
+// resolve returns the list the owner currently stands for, compressing forwarding chains.
+func (o *listOwner[T]) resolve() *LinkedList[T] {
+	root := o
+	for root.forward != nil {
+		root = root.forward
+	}
+	for o != root {
+		next := o.forward
+		o.forward = root
+		o = next
+	}
+	return root.list
+}
+
+// Next returns the next element or nil.
+func (e *Element[T]) Next() *Element[T] {
+	if e.owner == nil {
+		return nil
+	}
+	if list := e.owner.resolve(); list != nil && e.next != &list.root {
+		return e.next
+	}
+	return nil
+}
+
+// Prev returns the previous element or nil.
+func (e *Element[T]) Prev() *Element[T] {
+	if e.owner == nil {
+		return nil
+	}
+	if list := e.owner.resolve(); list != nil && e.prev != &list.root {
+		return e.prev
+	}
+	return nil
+}
+
+// LinkedList represents a doubly linked list with element handles.
+type LinkedList[T any] struct {
+	root   Element[T] // sentinel; root.next is the front and root.prev the back
+	size   int
+	owner  *listOwner[T]
+	equals func(T, T) bool
+}
+
+// NewLinkedList creates a new empty linked list. It honors WithEquals to replace the default
+// comparison of values with reflect.DeepEqual in Contains.
+func NewLinkedList[T any](opts ...Option) *LinkedList[T] {
+	l := new(LinkedList[T]).init()
+	l.equals = equalsOf[T](applyOptions(opts), nil)
+	return l
+}
+
+// init empties the list in place, detaching it from any elements it held.
+func (l *LinkedList[T]) init() *LinkedList[T] {
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.size = 0
+	l.owner = &listOwner[T]{list: l}
+	return l
+}
+
+// NewLinkedListFromSlice creates a linked list holding the slice elements in order.
+func NewLinkedListFromSlice[T any](slice []T) *LinkedList[T] {
+	l := NewLinkedList[T]()
+	for _, item := range slice {
+		l.PushBack(item)
+	}
+	return l
+}
+
+// owns checks if an element belongs to this list.
+func (l *LinkedList[T]) owns(e *Element[T]) bool {
+	return e != nil && e.owner != nil && e.owner.resolve() == l
+}
+
+// insertAfter links a new element holding value after at.
+func (l *LinkedList[T]) insertAfter(value T, at *Element[T]) *Element[T] {
+	e := &Element[T]{Value: value, owner: l.owner}
+	l.link(e, at)
+	l.size++
+	return e
+}
+
+// link places e after at.
+func (l *LinkedList[T]) link(e, at *Element[T]) {
+	e.prev = at
+	e.next = at.next
+	at.next.prev = e
+	at.next = e
+}
+
+// unlink detaches e from its neighbours.
+func (l *LinkedList[T]) unlink(e *Element[T]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+}
+
+// Front returns the first element or nil if the list is empty.
+func (l *LinkedList[T]) Front() *Element[T] {
+	if l.size == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+// Back returns the last element or nil if the list is empty.
+func (l *LinkedList[T]) Back() *Element[T] {
+	if l.size == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+// PushFront adds a value at the front and returns its element.
+func (l *LinkedList[T]) PushFront(value T) *Element[T] {
+	return l.insertAfter(value, &l.root)
+}
+
+// PushBack adds a value at the back and returns its element.
+func (l *LinkedList[T]) PushBack(value T) *Element[T] {
+	return l.insertAfter(value, l.root.prev)
+}
+
+// InsertBefore adds a value just before mark and returns its element.
+// It returns nil if mark does not belong to the list.
+func (l *LinkedList[T]) InsertBefore(value T, mark *Element[T]) *Element[T] {
+	if !l.owns(mark) {
+		return nil
+	}
+	return l.insertAfter(value, mark.prev)
+}
+
+// InsertAfter adds a value just after mark and returns its element.
+// It returns nil if mark does not belong to the list.
+func (l *LinkedList[T]) InsertAfter(value T, mark *Element[T]) *Element[T] {
+	if !l.owns(mark) {
+		return nil
+	}
+	return l.insertAfter(value, mark)
+}
+
+// Remove removes an element from the list and reports whether it belonged to it.
+func (l *LinkedList[T]) Remove(e *Element[T]) bool {
+	if !l.owns(e) {
+		return false
+	}
+	l.unlink(e)
+	e.next, e.prev, e.owner = nil, nil, nil
+	l.size--
+	return true
+}
+
+// PopFront removes and returns the first value.
+func (l *LinkedList[T]) PopFront() (T, bool) {
+	e := l.Front()
+	if e == nil {
+		var zero T
+		return zero, false
+	}
+	l.Remove(e)
+	return e.Value, true
+}
+
+// PopBack removes and returns the last value.
+func (l *LinkedList[T]) PopBack() (T, bool) {
+	e := l.Back()
+	if e == nil {
+		var zero T
+		return zero, false
+	}
+	l.Remove(e)
+	return e.Value, true
+}
+
+// move relinks an element of the list after at.
+func (l *LinkedList[T]) move(e, at *Element[T]) {
+	if e == at {
+		return
+	}
+	l.unlink(e)
+	l.link(e, at)
+}
+
+// MoveToFront moves an element to the front and reports whether it belonged to the list.
+func (l *LinkedList[T]) MoveToFront(e *Element[T]) bool {
+	if !l.owns(e) {
+		return false
+	}
+	l.move(e, &l.root)
+	return true
+}
+
+// MoveToBack moves an element to the back and reports whether it belonged to the list.
+func (l *LinkedList[T]) MoveToBack(e *Element[T]) bool {
+	if !l.owns(e) {
+		return false
+	}
+	l.move(e, l.root.prev)
+	return true
+}
+
+// MoveBefore moves e just before mark. Both must belong to the list.
+func (l *LinkedList[T]) MoveBefore(e, mark *Element[T]) bool {
+	if !l.owns(e) || !l.owns(mark) {
+		return false
+	}
+	if e != mark {
+		l.move(e, mark.prev)
+	}
+	return true
+}
+
+// MoveAfter moves e just after mark. Both must belong to the list.
+func (l *LinkedList[T]) MoveAfter(e, mark *Element[T]) bool {
+	if !l.owns(e) || !l.owns(mark) {
+		return false
+	}
+	l.move(e, mark)
+	return true
+}
+
+// splice moves every element of other after at in O(1), leaving other empty.
+func (l *LinkedList[T]) splice(other *LinkedList[T], at *Element[T]) {
+	if other == l || other.size == 0 {
+		return
+	}
+
+	first, last := other.root.next, other.root.prev
+	first.prev = at
+	last.next = at.next
+	at.next.prev = last
+	at.next = first
+	l.size += other.size
+
+	// Existing handles of other now resolve to l
+	other.owner.list = nil
+	other.owner.forward = l.owner
+	other.owner = &listOwner[T]{list: other}
+	other.root.next = &other.root
+	other.root.prev = &other.root
+	other.size = 0
+}
+
+// SpliceFront moves every element of other to the front of the list in O(1), leaving other
+// empty. Element handles from other stay valid and now belong to this list.
+func (l *LinkedList[T]) SpliceFront(other *LinkedList[T]) {
+	l.splice(other, &l.root)
+}
+
+// SpliceBack moves every element of other to the back of the list in O(1), leaving other
+// empty. Element handles from other stay valid and now belong to this list.
+func (l *LinkedList[T]) SpliceBack(other *LinkedList[T]) {
+	l.splice(other, l.root.prev)
+}
+
+// PushBackList appends a copy of every value of other.
+func (l *LinkedList[T]) PushBackList(other *LinkedList[T]) {
+	for i, e := other.size, other.Front(); i > 0; i, e = i-1, e.Next() {
+		l.PushBack(e.Value)
+	}
+}
+
+// Size returns the number of elements in the list.
+func (l *LinkedList[T]) Size() int {
+	return l.size
+}
+
+// IsEmpty checks if the list is empty.
+func (l *LinkedList[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+// Clear removes all elements in O(1). Existing handles no longer belong to any list.
+func (l *LinkedList[T]) Clear() {
+	l.owner.list = nil
+	l.owner = &listOwner[T]{list: l}
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.size = 0
+}
+
+// Find returns the first element whose value satisfies the predicate, or nil.
+func (l *LinkedList[T]) Find(predicate func(T) bool) *Element[T] {
+	for e := l.root.next; e != &l.root; e = e.next {
+		if predicate(e.Value) {
+			return e
+		}
+	}
+	return nil
+}
+
+// Contains checks if the list contains a value.
+func (l *LinkedList[T]) Contains(value T) bool {
+	return l.Find(func(v T) bool {
+		return elementsEqual(l.equals, v, value)
+	}) != nil
+}
+
+// ToSlice returns the values from front to back.
+func (l *LinkedList[T]) ToSlice() []T {
+	return l.AppendTo(make([]T, 0, l.size))
+}
+
+// AppendTo appends the values from front to back to dst and returns the extended slice.
+func (l *LinkedList[T]) AppendTo(dst []T) []T {
+	for e := l.root.next; e != &l.root; e = e.next {
+		dst = append(dst, e.Value)
+	}
+	return dst
+}
+
+// ForEach applies a function to each value from front to back.
+func (l *LinkedList[T]) ForEach(fn func(T)) {
+	for e := l.root.next; e != &l.root; e = e.next {
+		fn(e.Value)
+	}
+}
+
+// Iterator returns a bidirectional iterator over the values from front to back.
+func (l *LinkedList[T]) Iterator() BidirectionalIterator[T] {
+	return &listIterator[T]{list: l, remove: func(e *Element[T]) { l.Remove(e) }}
+}
+
+// Reverse reverses the order of the elements in place.
+func (l *LinkedList[T]) Reverse() {
+	e := &l.root
+	for {
+		e.next, e.prev = e.prev, e.next
+		e = e.prev
+		if e == &l.root {
+			return
+		}
+	}
+}
+
+// Clone creates a copy of the list with new elements.
+func (l *LinkedList[T]) Clone() *LinkedList[T] {
+	result := NewLinkedList[T]()
+	result.equals = l.equals
+	result.PushBackList(l)
+	return result
+}
+
+// CloneWith creates a copy of the list whose values are copied by cloneElem.
+func (l *LinkedList[T]) CloneWith(cloneElem func(T) T) *LinkedList[T] {
+	result := NewLinkedList[T]()
+	result.equals = l.equals
+	for e := l.root.next; e != &l.root; e = e.next {
+		result.PushBack(cloneElem(e.Value))
+	}
+	return result
+}
+
+// String returns a string representation of the list.
+func (l *LinkedList[T]) String() string {
+	return fmt.Sprintf("LinkedList%v", l.ToSlice())
+}
@@ -0,0 +1,114 @@
+package stl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLinkedListBasicOperations(t *testing.T) {
+	l := NewLinkedList[int]()
+	two := l.PushBack(2)
+	l.PushFront(1)
+	four := l.PushBack(4)
+	l.InsertAfter(3, two)
+	l.InsertBefore(0, l.Front())
+
+	if l.String() != "LinkedList[0 1 2 3 4]" || l.Size() != 5 {
+		t.Errorf("Unexpected list %s", l.String())
+	}
+	if two.Next().Value != 3 || two.Prev().Value != 1 {
+		t.Error("Element navigation returned unexpected neighbours")
+	}
+	if four.Next() != nil || l.Front().Prev() != nil {
+		t.Error("Ends of the list should have no outer neighbours")
+	}
+
+	if !l.Remove(two) || l.Remove(two) {
+		t.Error("Expected Remove to succeed once")
+	}
+	if two.Next() != nil || l.InsertAfter(9, two) != nil {
+		t.Error("A removed element should be detached")
+	}
+
+	l.MoveToFront(four)
+	l.MoveToBack(l.Find(func(v int) bool { return v == 0 }))
+	if l.String() != "LinkedList[4 1 3 0]" {
+		t.Errorf("Unexpected list after moves %s", l.String())
+	}
+	l.MoveAfter(four, l.Back())
+	l.MoveBefore(l.Back(), l.Front())
+	if l.String() != "LinkedList[4 1 3 0]" {
+		t.Errorf("Unexpected list after relative moves %s", l.String())
+	}
+
+	if value, _ := l.PopFront(); value != 4 {
+		t.Errorf("Expected 4, got %d", value)
+	}
+	if value, _ := l.PopBack(); value != 0 {
+		t.Errorf("Expected 0, got %d", value)
+	}
+
+	l.Reverse()
+	if l.String() != "LinkedList[3 1]" {
+		t.Errorf("Unexpected reversed list %s", l.String())
+	}
+
+	l.Clear()
+	if !l.IsEmpty() || l.Front() != nil {
+		t.Error("List should be empty after Clear")
+	}
+	if _, ok := l.PopBack(); ok {
+		t.Error("PopBack on an empty list should fail")
+	}
+}
+
+func TestLinkedListSplice(t *testing.T) {
+	a := NewLinkedListFromSlice([]int{1, 2})
+	b := NewLinkedListFromSlice([]int{3, 4})
+	c := NewLinkedListFromSlice([]int{0})
+	three := b.Front()
+
+	a.SpliceBack(b)
+	a.SpliceFront(c)
+	if a.String() != "LinkedList[0 1 2 3 4]" || a.Size() != 5 {
+		t.Errorf("Unexpected spliced list %s", a.String())
+	}
+	if !b.IsEmpty() || !c.IsEmpty() {
+		t.Error("Spliced lists should be empty")
+	}
+
+	// Handles from b now belong to a, including after a further splice
+	d := NewLinkedList[int]()
+	d.SpliceBack(a)
+	if b.Remove(three) || !d.Remove(three) {
+		t.Error("Spliced handles should belong to the receiving list")
+	}
+	if d.String() != "LinkedList[0 1 2 4]" {
+		t.Errorf("Unexpected list %s", d.String())
+	}
+
+	// The emptied list is still usable on its own
+	b.PushBack(7)
+	if b.String() != "LinkedList[7]" || d.Size() != 4 {
+		t.Error("Spliced-out list should be independent")
+	}
+
+	clone := d.Clone()
+	clone.PushBack(5)
+	if d.Size() != 4 || clone.Size() != 5 {
+		t.Error("Clone should not share elements")
+	}
+}
+
+func TestLinkedListContainsEquality(t *testing.T) {
+	l := NewLinkedListFromSlice([]any{1, []int{2}})
+	if l.Contains("1") || !l.Contains(1) || !l.Contains([]int{2}) {
+		t.Errorf("Expected deep equality by default")
+	}
+
+	folded := NewLinkedList[string](WithEquals(strings.EqualFold))
+	folded.PushBack("Go")
+	if !folded.Contains("GO") || !folded.Clone().Contains("go") {
+		t.Errorf("Expected WithEquals to be honored by Contains and kept by Clone")
+	}
+}
@@ -0,0 +1,246 @@
+package stl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Number is the set of built-in integer and floating-point types, including named types
+// derived from them.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Matrix represents a dense rows x cols matrix of numbers stored in row-major order.
+type Matrix[T Number] struct {
+	rows int
+	cols int
+	data []T
+}
+
+// NewMatrix creates a rows x cols matrix of zeros. Negative dimensions are treated as zero.
+func NewMatrix[T Number](rows, cols int) *Matrix[T] {
+	rows, cols = max(rows, 0), max(cols, 0)
+	return &Matrix[T]{rows: rows, cols: cols, data: make([]T, rows*cols)}
+}
+
+// NewIdentityMatrix creates an n x n identity matrix.
+func NewIdentityMatrix[T Number](n int) *Matrix[T] {
+	m := NewMatrix[T](n, n)
+	for i := 0; i < m.rows; i++ {
+		m.data[i*m.cols+i] = 1
+	}
+	return m
+}
+
+// NewMatrixFromRows creates a matrix from a slice of rows. It returns false if the rows
+// have different lengths.
+func NewMatrixFromRows[T Number](rows [][]T) (*Matrix[T], bool) {
+	cols := 0
+	if len(rows) > 0 {
+		cols = len(rows[0])
+	}
+	m := NewMatrix[T](len(rows), cols)
+	for i, row := range rows {
+		if len(row) != cols {
+			return nil, false
+		}
+		copy(m.data[i*cols:], row)
+	}
+	return m, true
+}
+
+// Rows returns the number of rows.
+func (m *Matrix[T]) Rows() int {
+	return m.rows
+}
+
+// Cols returns the number of columns.
+func (m *Matrix[T]) Cols() int {
+	return m.cols
+}
+
+// IsSquare checks if the matrix has as many rows as columns.
+func (m *Matrix[T]) IsSquare() bool {
+	return m.rows == m.cols
+}
+
+// inBounds checks if (i, j) is a valid cell.
+func (m *Matrix[T]) inBounds(i, j int) bool {
+	return i >= 0 && i < m.rows && j >= 0 && j < m.cols
+}
+
+// Get returns the value at row i and column j.
+func (m *Matrix[T]) Get(i, j int) (T, bool) {
+	if !m.inBounds(i, j) {
+		var zero T
+		return zero, false
+	}
+	return m.data[i*m.cols+j], true
+}
+
+// Set stores a value at row i and column j and reports whether the cell exists.
+func (m *Matrix[T]) Set(i, j int, value T) bool {
+	if !m.inBounds(i, j) {
+		return false
+	}
+	m.data[i*m.cols+j] = value
+	return true
+}
+
+// Row returns row i as a view: writes to the returned slice change the matrix.
+// It returns nil if the row does not exist.
+func (m *Matrix[T]) Row(i int) []T {
+	if i < 0 || i >= m.rows {
+		return nil
+	}
+	start := i * m.cols
+	return m.data[start : start+m.cols : start+m.cols]
+}
+
+// Column returns a copy of column j, or nil if the column does not exist.
+func (m *Matrix[T]) Column(j int) []T {
+	if j < 0 || j >= m.cols {
+		return nil
+	}
+	result := make([]T, m.rows)
+	for i := range result {
+		result[i] = m.data[i*m.cols+j]
+	}
+	return result
+}
+
+// sameShape checks if two matrices have the same dimensions.
+func (m *Matrix[T]) sameShape(other *Matrix[T]) bool {
+	return m.rows == other.rows && m.cols == other.cols
+}
+
+// Add returns the element-wise sum. It returns false if the shapes differ.
+func (m *Matrix[T]) Add(other *Matrix[T]) (*Matrix[T], bool) {
+	if !m.sameShape(other) {
+		return nil, false
+	}
+	result := NewMatrix[T](m.rows, m.cols)
+	for i := range m.data {
+		result.data[i] = m.data[i] + other.data[i]
+	}
+	return result, true
+}
+
+// Subtract returns the element-wise difference. It returns false if the shapes differ.
+func (m *Matrix[T]) Subtract(other *Matrix[T]) (*Matrix[T], bool) {
+	if !m.sameShape(other) {
+		return nil, false
+	}
+	result := NewMatrix[T](m.rows, m.cols)
+	for i := range m.data {
+		result.data[i] = m.data[i] - other.data[i]
+	}
+	return result, true
+}
+
+// Scale returns the matrix with every element multiplied by factor.
+func (m *Matrix[T]) Scale(factor T) *Matrix[T] {
+	result := NewMatrix[T](m.rows, m.cols)
+	for i, value := range m.data {
+		result.data[i] = value * factor
+	}
+	return result
+}
+
+// Multiply returns the matrix product m x other. It returns false if the number of columns
+// of m differs from the number of rows of other.
+func (m *Matrix[T]) Multiply(other *Matrix[T]) (*Matrix[T], bool) {
+	if m.cols != other.rows {
+		return nil, false
+	}
+	result := NewMatrix[T](m.rows, other.cols)
+	for i := 0; i < m.rows; i++ {
+		row := result.data[i*result.cols : (i+1)*result.cols]
+		// i-k-j order walks both operands row by row
+		for k := 0; k < m.cols; k++ {
+			a := m.data[i*m.cols+k]
+			if a == 0 {
+				continue
+			}
+			for j, b := range other.data[k*other.cols : (k+1)*other.cols] {
+				row[j] += a * b
+			}
+		}
+	}
+	return result, true
+}
+
+// Transpose returns the cols x rows transpose.
+func (m *Matrix[T]) Transpose() *Matrix[T] {
+	result := NewMatrix[T](m.cols, m.rows)
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.cols; j++ {
+			result.data[j*result.cols+i] = m.data[i*m.cols+j]
+		}
+	}
+	return result
+}
+
+// Equals checks if two matrices have the same shape and elements.
+func (m *Matrix[T]) Equals(other *Matrix[T]) bool {
+	if !m.sameShape(other) {
+		return false
+	}
+	for i := range m.data {
+		if m.data[i] != other.data[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone creates a copy of the matrix.
+func (m *Matrix[T]) Clone() *Matrix[T] {
+	return &Matrix[T]{rows: m.rows, cols: m.cols, data: append([]T(nil), m.data...)}
+}
+
+// ToSlice returns the rows as a slice of independent slices.
+func (m *Matrix[T]) ToSlice() [][]T {
+	result := make([][]T, m.rows)
+	for i := range result {
+		result[i] = append([]T(nil), m.Row(i)...)
+	}
+	return result
+}
+
+// ToGraph interprets a square matrix as a weighted adjacency matrix over nodes 0..n-1 and
+// returns the corresponding graph, with an edge for every non-zero entry. Undirected graphs
+// read only the entries on or above the diagonal. It returns nil if the matrix is not square.
+func (m *Matrix[T]) ToGraph(directed bool) *Graph[int] {
+	if !m.IsSquare() {
+		return nil
+	}
+	graph := NewGraph[int](directed)
+	for i := 0; i < m.rows; i++ {
+		graph.AddNode(i)
+	}
+	for i := 0; i < m.rows; i++ {
+		start := 0
+		if !directed {
+			start = i
+		}
+		for j := start; j < m.cols; j++ {
+			if value := m.data[i*m.cols+j]; value != 0 {
+				graph.AddWeightedEdge(i, j, float64(value))
+			}
+		}
+	}
+	return graph
+}
+
+// String returns a string representation of the matrix.
+func (m *Matrix[T]) String() string {
+	rows := make([]string, m.rows)
+	for i := range rows {
+		rows[i] = fmt.Sprint(m.Row(i))
+	}
+	return "Matrix[" + strings.Join(rows, " ") + "]"
+}
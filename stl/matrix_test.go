@@ -0,0 +1,100 @@
+package stl
+
+import (
+	"testing"
+)
+
+func TestMatrixArithmetic(t *testing.T) {
+	a, _ := NewMatrixFromRows([][]int{{1, 2, 3}, {4, 5, 6}})
+	b, _ := NewMatrixFromRows([][]int{{7, 8}, {9, 10}, {11, 12}})
+
+	product, ok := a.Multiply(b)
+	if !ok || product.String() != "Matrix[[58 64] [139 154]]" {
+		t.Errorf("Unexpected product %v", product)
+	}
+	if _, ok := a.Multiply(a); ok {
+		t.Error("Multiplying incompatible shapes should fail")
+	}
+
+	sum, _ := a.Add(a)
+	if !sum.Equals(a.Scale(2)) {
+		t.Errorf("Expected a+a == 2a, got %s", sum.String())
+	}
+	if diff, _ := sum.Subtract(a); !diff.Equals(a) {
+		t.Errorf("Expected 2a-a == a, got %s", diff.String())
+	}
+	if _, ok := a.Add(b); ok {
+		t.Error("Adding different shapes should fail")
+	}
+
+	if transposed := a.Transpose(); transposed.Rows() != 3 || transposed.String() != "Matrix[[1 4] [2 5] [3 6]]" {
+		t.Errorf("Unexpected transpose %s", transposed.String())
+	}
+
+	identity := NewIdentityMatrix[int](2)
+	if same, _ := identity.Multiply(product); !same.Equals(product) {
+		t.Error("Multiplying by the identity should not change the matrix")
+	}
+
+	if _, ok := NewMatrixFromRows([][]int{{1, 2}, {3}}); ok {
+		t.Error("Ragged rows should be rejected")
+	}
+}
+
+func TestMatrixAccessors(t *testing.T) {
+	m := NewMatrix[float64](2, 3)
+	if !m.Set(1, 2, 4.5) || m.Set(2, 0, 1) {
+		t.Error("Set returned an unexpected result")
+	}
+	if value, _ := m.Get(1, 2); value != 4.5 {
+		t.Errorf("Expected 4.5, got %v", value)
+	}
+	if _, ok := m.Get(-1, 0); ok {
+		t.Error("Get out of bounds should fail")
+	}
+
+	// Rows are views, columns are copies
+	m.Row(0)[1] = 7
+	if value, _ := m.Get(0, 1); value != 7 {
+		t.Errorf("Expected row writes to reach the matrix, got %v", value)
+	}
+	column := m.Column(2)
+	column[1] = 0
+	if value, _ := m.Get(1, 2); value != 4.5 {
+		t.Error("Column should return a copy")
+	}
+	if m.Row(5) != nil || m.Column(5) != nil {
+		t.Error("Missing rows and columns should be nil")
+	}
+
+	clone := m.Clone()
+	clone.Set(0, 0, 1)
+	if m.Equals(clone) || len(m.ToSlice()) != 2 {
+		t.Error("Clone should not share storage")
+	}
+}
+
+func TestMatrixToGraph(t *testing.T) {
+	m, _ := NewMatrixFromRows([][]int{
+		{0, 2, 0},
+		{0, 0, 3},
+		{1, 0, 0},
+	})
+
+	directed := m.ToGraph(true)
+	if directed.EdgeCount() != 3 || !directed.HasEdge(2, 0) || directed.HasEdge(0, 2) {
+		t.Errorf("Unexpected directed graph %v", directed.GetEdges())
+	}
+	if weight, _ := directed.GetWeight(1, 2); weight != 3 {
+		t.Errorf("Expected weight 3, got %v", weight)
+	}
+
+	undirected := m.ToGraph(false)
+	if undirected.EdgeCount() != 2 || !undirected.HasEdge(1, 0) {
+		t.Errorf("Unexpected undirected graph %v", undirected.GetEdges())
+	}
+
+	if NewMatrix[int](2, 3).ToGraph(true) != nil {
+		t.Error("Non-square matrices should not convert")
+	}
+}
@@ -0,0 +1,232 @@
+package stl
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// MedianHeap tracks the running median of a stream of numbers. By default it keeps every
+// value in two heaps and reports the exact median; created with NewApproxMedianHeap it keeps a
+// bounded-size t-digest instead and can answer arbitrary quantiles approximately.
+type MedianHeap[T Number] struct {
+	lower  *PriorityQueue[T] // max-heap of the smaller half
+	upper  *PriorityQueue[T] // min-heap of the larger half
+	digest *tDigest
+	size   int
+}
+
+// NewMedianHeap creates an exact running-median tracker.
+func NewMedianHeap[T Number]() *MedianHeap[T] {
+	return &MedianHeap[T]{
+		lower: NewPriorityQueue[T](func(a, b T) bool { return a > b }),
+		upper: NewPriorityQueue[T](func(a, b T) bool { return a < b }),
+	}
+}
+
+// NewApproxMedianHeap creates a tracker backed by a t-digest with the given compression.
+// Memory stays proportional to compression regardless of stream length; larger values
+// trade memory for accuracy, and 100 is a common choice. Non-positive values use 100.
+func NewApproxMedianHeap[T Number](compression float64) *MedianHeap[T] {
+	if compression <= 0 {
+		compression = 100
+	}
+	return &MedianHeap[T]{digest: newTDigest(compression)}
+}
+
+// Add records a value.
+func (mh *MedianHeap[T]) Add(x T) {
+	mh.size++
+	if mh.digest != nil {
+		mh.digest.add(float64(x))
+		return
+	}
+
+	if top, ok := mh.lower.Peek(); !ok || x <= top {
+		mh.lower.Enqueue(x)
+	} else {
+		mh.upper.Enqueue(x)
+	}
+
+	// Keep the lower half equal in size to the upper half or one larger
+	if mh.lower.Size() > mh.upper.Size()+1 {
+		value, _ := mh.lower.Dequeue()
+		mh.upper.Enqueue(value)
+	} else if mh.upper.Size() > mh.lower.Size() {
+		value, _ := mh.upper.Dequeue()
+		mh.lower.Enqueue(value)
+	}
+}
+
+// Median returns the median of the values added so far. For an even count in exact mode it
+// is the mean of the two middle values.
+func (mh *MedianHeap[T]) Median() (float64, bool) {
+	if mh.size == 0 {
+		return 0, false
+	}
+	if mh.digest != nil {
+		return mh.digest.quantile(0.5), true
+	}
+
+	low, _ := mh.lower.Peek()
+	if mh.size%2 == 1 {
+		return float64(low), true
+	}
+	high, _ := mh.upper.Peek()
+	return (float64(low) + float64(high)) / 2, true
+}
+
+// Quantile returns the approximate q-quantile (0 <= q <= 1) of the values added so far. It
+// is only available in t-digest mode and returns false otherwise.
+func (mh *MedianHeap[T]) Quantile(q float64) (float64, bool) {
+	if mh.digest == nil || mh.size == 0 || q < 0 || q > 1 {
+		return 0, false
+	}
+	return mh.digest.quantile(q), true
+}
+
+// IsApprox returns true if the tracker uses a t-digest.
+func (mh *MedianHeap[T]) IsApprox() bool {
+	return mh.digest != nil
+}
+
+// Size returns the number of values added.
+func (mh *MedianHeap[T]) Size() int {
+	return mh.size
+}
+
+// IsEmpty returns true if no values have been added.
+func (mh *MedianHeap[T]) IsEmpty() bool {
+	return mh.size == 0
+}
+
+// Clear removes all values.
+func (mh *MedianHeap[T]) Clear() {
+	mh.size = 0
+	if mh.digest != nil {
+		mh.digest = newTDigest(mh.digest.compression)
+		return
+	}
+	mh.lower.Clear()
+	mh.upper.Clear()
+}
+
+// String returns a string representation of the tracker.
+func (mh *MedianHeap[T]) String() string {
+	median, ok := mh.Median()
+	if !ok {
+		return "MedianHeap{Size: 0}"
+	}
+	return fmt.Sprintf("MedianHeap{Size: %d, Median: %v}", mh.size, median)
+}
+
+// tDigestCentroid is a cluster of nearby values summarized by their mean and count.
+type tDigestCentroid struct {
+	mean   float64
+	weight float64
+}
+
+// tDigest is a merging t-digest: values are buffered, then merged into centroids whose
+// size is bounded by the k1 scale function, keeping clusters small near the tails.
+type tDigest struct {
+	compression float64
+	centroids   []tDigestCentroid
+	buffer      []float64
+	total       float64
+	min, max    float64
+}
+
+// newTDigest creates an empty digest.
+func newTDigest(compression float64) *tDigest {
+	return &tDigest{
+		compression: compression,
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+// add buffers a value, merging when the buffer fills.
+func (td *tDigest) add(x float64) {
+	td.buffer = append(td.buffer, x)
+	td.min = min(td.min, x)
+	td.max = max(td.max, x)
+	if len(td.buffer) >= int(5*td.compression) {
+		td.flush()
+	}
+}
+
+// scale maps a quantile onto the k1 scale, where each centroid may span at most one unit.
+func (td *tDigest) scale(q float64) float64 {
+	return td.compression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+// flush merges buffered values into the centroid list.
+func (td *tDigest) flush() {
+	if len(td.buffer) == 0 {
+		return
+	}
+
+	merged := make([]tDigestCentroid, 0, len(td.centroids)+len(td.buffer))
+	merged = append(merged, td.centroids...)
+	for _, x := range td.buffer {
+		merged = append(merged, tDigestCentroid{mean: x, weight: 1})
+	}
+	td.total += float64(len(td.buffer))
+	td.buffer = td.buffer[:0]
+	sort.Slice(merged, func(i, j int) bool { return merged[i].mean < merged[j].mean })
+
+	result := merged[:1]
+	seen := 0.0
+	for _, next := range merged[1:] {
+		current := &result[len(result)-1]
+		q0 := seen / td.total
+		q2 := (seen + current.weight + next.weight) / td.total
+		if td.scale(q2)-td.scale(q0) <= 1 {
+			weight := current.weight + next.weight
+			current.mean += (next.mean - current.mean) * next.weight / weight
+			current.weight = weight
+			continue
+		}
+		seen += current.weight
+		result = append(result, next)
+	}
+	td.centroids = result
+}
+
+// quantile estimates the q-quantile by interpolating between centroid centers.
+func (td *tDigest) quantile(q float64) float64 {
+	td.flush()
+	if len(td.centroids) == 1 || q <= 0 {
+		if q >= 1 {
+			return td.max
+		}
+		if q <= 0 {
+			return td.min
+		}
+		return td.centroids[0].mean
+	}
+	if q >= 1 {
+		return td.max
+	}
+
+	target := q * td.total
+	first := td.centroids[0]
+	if target < first.weight/2 {
+		// Between the minimum and the first centroid's center
+		return td.min + (first.mean-td.min)*target/(first.weight/2)
+	}
+
+	cumulative := first.weight / 2
+	for i := 1; i < len(td.centroids); i++ {
+		prev, next := td.centroids[i-1], td.centroids[i]
+		step := (prev.weight + next.weight) / 2
+		if target < cumulative+step {
+			return prev.mean + (next.mean-prev.mean)*(target-cumulative)/step
+		}
+		cumulative += step
+	}
+
+	last := td.centroids[len(td.centroids)-1]
+	remaining := last.weight / 2
+	return last.mean + (td.max-last.mean)*(target-cumulative)/remaining
+}
@@ -0,0 +1,69 @@
+package stl
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestMedianHeapExact(t *testing.T) {
+	mh := NewMedianHeap[int]()
+	if _, ok := mh.Median(); ok {
+		t.Error("Median of an empty stream should fail")
+	}
+
+	steps := []struct {
+		value  int
+		median float64
+	}{
+		{5, 5}, {15, 10}, {1, 5}, {3, 4}, {8, 5}, {7, 6}, {9, 7},
+	}
+	for _, step := range steps {
+		mh.Add(step.value)
+		if median, _ := mh.Median(); median != step.median {
+			t.Errorf("After adding %d expected median %v, got %v", step.value, step.median, median)
+		}
+	}
+
+	if _, ok := mh.Quantile(0.9); ok || mh.IsApprox() {
+		t.Error("Exact mode should not report quantiles")
+	}
+	if mh.String() != "MedianHeap{Size: 7, Median: 7}" {
+		t.Errorf("Unexpected string %s", mh.String())
+	}
+
+	mh.Clear()
+	if !mh.IsEmpty() {
+		t.Error("Tracker should be empty after Clear")
+	}
+}
+
+func TestMedianHeapApprox(t *testing.T) {
+	mh := NewApproxMedianHeap[float64](100)
+	r := rand.New(rand.NewSource(3))
+
+	const n = 100000
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = r.NormFloat64()
+		mh.Add(values[i])
+	}
+	sort.Float64s(values)
+
+	if median, _ := mh.Median(); math.Abs(median-values[n/2]) > 0.02 {
+		t.Errorf("Expected median near %v, got %v", values[n/2], median)
+	}
+	for _, q := range []float64{0.01, 0.25, 0.75, 0.99} {
+		exact := values[int(q*n)]
+		if estimate, _ := mh.Quantile(q); math.Abs(estimate-exact) > 0.05 {
+			t.Errorf("Quantile %v: expected near %v, got %v", q, exact, estimate)
+		}
+	}
+	if low, _ := mh.Quantile(0); low != values[0] {
+		t.Errorf("Expected exact minimum %v, got %v", values[0], low)
+	}
+	if len(mh.digest.centroids) > 300 {
+		t.Errorf("Expected a bounded digest, got %d centroids", len(mh.digest.centroids))
+	}
+}
@@ -0,0 +1,103 @@
+package stl
+
+import "fmt"
+
+// MinStack is a LIFO stack that reports its minimum and maximum in O(1) by keeping the
+// running extrema in auxiliary stacks alongside the values.
+type MinStack[T any] struct {
+	less func(T, T) bool
+	data []T
+	mins []T // mins[i] is the minimum of data[:i+1]
+	maxs []T // maxs[i] is the maximum of data[:i+1]
+}
+
+// NewMinStack creates an empty stack ordered by less.
+func NewMinStack[T any](less func(T, T) bool) *MinStack[T] {
+	return &MinStack[T]{less: less}
+}
+
+// Push adds an element to the top of the stack.
+func (s *MinStack[T]) Push(item T) {
+	low, high := item, item
+	if n := len(s.data); n > 0 {
+		if s.less(s.mins[n-1], item) {
+			low = s.mins[n-1]
+		}
+		if s.less(item, s.maxs[n-1]) {
+			high = s.maxs[n-1]
+		}
+	}
+
+	s.data = append(s.data, item)
+	s.mins = append(s.mins, low)
+	s.maxs = append(s.maxs, high)
+}
+
+// Pop removes and returns the top element from the stack.
+func (s *MinStack[T]) Pop() (T, bool) {
+	n := len(s.data)
+	if n == 0 {
+		var zero T
+		return zero, false
+	}
+
+	item := s.data[n-1]
+	s.data = s.data[:n-1]
+	s.mins = s.mins[:n-1]
+	s.maxs = s.maxs[:n-1]
+	return item, true
+}
+
+// Peek returns the top element without removing it.
+func (s *MinStack[T]) Peek() (T, bool) {
+	if len(s.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.data[len(s.data)-1], true
+}
+
+// Min returns the smallest element on the stack.
+func (s *MinStack[T]) Min() (T, bool) {
+	if len(s.mins) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.mins[len(s.mins)-1], true
+}
+
+// Max returns the largest element on the stack.
+func (s *MinStack[T]) Max() (T, bool) {
+	if len(s.maxs) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.maxs[len(s.maxs)-1], true
+}
+
+// Size returns the number of elements in the stack.
+func (s *MinStack[T]) Size() int {
+	return len(s.data)
+}
+
+// IsEmpty returns true if the stack is empty.
+func (s *MinStack[T]) IsEmpty() bool {
+	return len(s.data) == 0
+}
+
+// Clear removes all elements from the stack.
+func (s *MinStack[T]) Clear() {
+	s.data = s.data[:0]
+	s.mins = s.mins[:0]
+	s.maxs = s.maxs[:0]
+}
+
+// ToSlice returns a copy of the stack as a slice, bottom to top.
+func (s *MinStack[T]) ToSlice() []T {
+	return append([]T(nil), s.data...)
+}
+
+// String returns a string representation of the stack.
+func (s *MinStack[T]) String() string {
+	return fmt.Sprintf("MinStack%v", s.data)
+}
@@ -0,0 +1,42 @@
+package stl
+
+import "testing"
+
+func TestMinStack(t *testing.T) {
+	s := NewMinStack(lessInt)
+	if _, ok := s.Min(); ok {
+		t.Error("Min of an empty stack should fail")
+	}
+
+	steps := []struct{ push, min, max int }{
+		{5, 5, 5}, {3, 3, 5}, {7, 3, 7}, {3, 3, 7}, {1, 1, 7},
+	}
+	for _, step := range steps {
+		s.Push(step.push)
+		low, _ := s.Min()
+		high, _ := s.Max()
+		if low != step.min || high != step.max {
+			t.Errorf("After pushing %d expected min/max %d/%d, got %d/%d", step.push, step.min, step.max, low, high)
+		}
+	}
+
+	// Pop back through the same states in reverse
+	for i := len(steps) - 1; i > 0; i-- {
+		if value, _ := s.Pop(); value != steps[i].push {
+			t.Fatalf("Expected %d, got %d", steps[i].push, value)
+		}
+		low, _ := s.Min()
+		high, _ := s.Max()
+		if low != steps[i-1].min || high != steps[i-1].max {
+			t.Errorf("Expected min/max %d/%d, got %d/%d", steps[i-1].min, steps[i-1].max, low, high)
+		}
+	}
+
+	if s.String() != "MinStack[5]" || s.Size() != 1 {
+		t.Errorf("Unexpected stack %s", s.String())
+	}
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Error("Stack should be empty after Clear")
+	}
+}
@@ -0,0 +1,217 @@
+package stl
+
+import "fmt"
+
+// MonotonicQueue is a FIFO queue that reports its minimum and maximum in O(1). Alongside
+// the values it keeps two monotonic deques of candidates, making it the standard tool for
+// sliding-window minimum and maximum.
+type MonotonicQueue[T any] struct {
+	less   func(T, T) bool
+	values *Deque[T]
+	mins   *Deque[T] // non-decreasing from front to back
+	maxs   *Deque[T] // non-increasing from front to back
+}
+
+// NewMonotonicQueue creates an empty monotonic queue ordered by less.
+func NewMonotonicQueue[T any](less func(T, T) bool) *MonotonicQueue[T] {
+	return &MonotonicQueue[T]{
+		less:   less,
+		values: NewDeque[T](0),
+		mins:   NewDeque[T](0),
+		maxs:   NewDeque[T](0),
+	}
+}
+
+// Push adds a value to the back of the queue in amortized O(1).
+func (mq *MonotonicQueue[T]) Push(x T) {
+	mq.values.PushBack(x)
+
+	// Candidates strictly worse than x can never be the extreme again; equal ones stay so
+	// that popping a duplicate leaves its twin behind
+	for back, ok := mq.mins.Back(); ok && mq.less(x, back); back, ok = mq.mins.Back() {
+		mq.mins.PopBack()
+	}
+	mq.mins.PushBack(x)
+
+	for back, ok := mq.maxs.Back(); ok && mq.less(back, x); back, ok = mq.maxs.Back() {
+		mq.maxs.PopBack()
+	}
+	mq.maxs.PushBack(x)
+}
+
+// Pop removes and returns the value at the front of the queue.
+func (mq *MonotonicQueue[T]) Pop() (T, bool) {
+	x, ok := mq.values.PopFront()
+	if !ok {
+		return x, false
+	}
+
+	if front, _ := mq.mins.Front(); !mq.less(front, x) {
+		mq.mins.PopFront()
+	}
+	if front, _ := mq.maxs.Front(); !mq.less(x, front) {
+		mq.maxs.PopFront()
+	}
+	return x, true
+}
+
+// Front returns the value at the front of the queue without removing it.
+func (mq *MonotonicQueue[T]) Front() (T, bool) {
+	return mq.values.Front()
+}
+
+// Min returns the smallest value in the queue.
+func (mq *MonotonicQueue[T]) Min() (T, bool) {
+	return mq.mins.Front()
+}
+
+// Max returns the largest value in the queue.
+func (mq *MonotonicQueue[T]) Max() (T, bool) {
+	return mq.maxs.Front()
+}
+
+// Size returns the number of values in the queue.
+func (mq *MonotonicQueue[T]) Size() int {
+	return mq.values.Size()
+}
+
+// IsEmpty returns true if the queue is empty.
+func (mq *MonotonicQueue[T]) IsEmpty() bool {
+	return mq.values.IsEmpty()
+}
+
+// Clear removes all values from the queue.
+func (mq *MonotonicQueue[T]) Clear() {
+	mq.values.Clear()
+	mq.mins.Clear()
+	mq.maxs.Clear()
+}
+
+// ToSlice returns the values in FIFO order.
+func (mq *MonotonicQueue[T]) ToSlice() []T {
+	return mq.values.ToSlice()
+}
+
+// String returns a string representation of the queue.
+func (mq *MonotonicQueue[T]) String() string {
+	return fmt.Sprintf("MonotonicQueue%v", mq.values.ToSlice())
+}
+
+// SlidingWindowMax returns the maximum of every window of k consecutive values, using the
+// order defined by less. It returns nil if k is not in [1, len(values)].
+func SlidingWindowMax[T any](values []T, k int, less func(T, T) bool) []T {
+	return slidingWindow(values, k, less, (*MonotonicQueue[T]).Max)
+}
+
+// SlidingWindowMin returns the minimum of every window of k consecutive values, using the
+// order defined by less. It returns nil if k is not in [1, len(values)].
+func SlidingWindowMin[T any](values []T, k int, less func(T, T) bool) []T {
+	return slidingWindow(values, k, less, (*MonotonicQueue[T]).Min)
+}
+
+// slidingWindow slides a window of k values and collects extreme for each position.
+func slidingWindow[T any](values []T, k int, less func(T, T) bool, extreme func(*MonotonicQueue[T]) (T, bool)) []T {
+	if k < 1 || k > len(values) {
+		return nil
+	}
+
+	window := NewMonotonicQueue(less)
+	result := make([]T, 0, len(values)-k+1)
+	for i, value := range values {
+		window.Push(value)
+		if i >= k {
+			window.Pop()
+		}
+		if i >= k-1 {
+			best, _ := extreme(window)
+			result = append(result, best)
+		}
+	}
+	return result
+}
+
+// MonotonicStack is a stack kept strictly increasing from bottom to top according to less:
+// pushing a value first pops every value that is not less than it. The popped values are
+// returned, which is how "next smaller element" style algorithms find their answers. Pass a
+// reversed comparator for a decreasing stack.
+type MonotonicStack[T any] struct {
+	less func(T, T) bool
+	data []T
+}
+
+// NewMonotonicStack creates an empty monotonic stack ordered by less.
+func NewMonotonicStack[T any](less func(T, T) bool) *MonotonicStack[T] {
+	return &MonotonicStack[T]{less: less}
+}
+
+// Push pops every value not less than x, pushes x, and returns the popped values from the
+// top of the stack down.
+func (ms *MonotonicStack[T]) Push(x T) []T {
+	var popped []T
+	for len(ms.data) > 0 && !ms.less(ms.data[len(ms.data)-1], x) {
+		popped = append(popped, ms.data[len(ms.data)-1])
+		ms.data = ms.data[:len(ms.data)-1]
+	}
+	ms.data = append(ms.data, x)
+	return popped
+}
+
+// Pop removes and returns the top value.
+func (ms *MonotonicStack[T]) Pop() (T, bool) {
+	if len(ms.data) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	top := ms.data[len(ms.data)-1]
+	ms.data = ms.data[:len(ms.data)-1]
+	return top, true
+}
+
+// Peek returns the top value without removing it.
+func (ms *MonotonicStack[T]) Peek() (T, bool) {
+	if len(ms.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return ms.data[len(ms.data)-1], true
+}
+
+// Min returns the smallest value, which sits at the bottom of the stack.
+func (ms *MonotonicStack[T]) Min() (T, bool) {
+	if len(ms.data) == 0 {
+		var zero T
+		return zero, false
+	}
+	return ms.data[0], true
+}
+
+// Max returns the largest value, which sits at the top of the stack.
+func (ms *MonotonicStack[T]) Max() (T, bool) {
+	return ms.Peek()
+}
+
+// Size returns the number of values on the stack.
+func (ms *MonotonicStack[T]) Size() int {
+	return len(ms.data)
+}
+
+// IsEmpty returns true if the stack is empty.
+func (ms *MonotonicStack[T]) IsEmpty() bool {
+	return len(ms.data) == 0
+}
+
+// Clear removes all values from the stack.
+func (ms *MonotonicStack[T]) Clear() {
+	ms.data = ms.data[:0]
+}
+
+// ToSlice returns the values from bottom to top.
+func (ms *MonotonicStack[T]) ToSlice() []T {
+	return append([]T(nil), ms.data...)
+}
+
+// String returns a string representation of the stack from bottom to top.
+func (ms *MonotonicStack[T]) String() string {
+	return fmt.Sprintf("MonotonicStack%v", ms.data)
+}
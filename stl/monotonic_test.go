@@ -0,0 +1,115 @@
+package stl
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMonotonicQueueExtremes(t *testing.T) {
+	mq := NewMonotonicQueue(lessInt)
+	if _, ok := mq.Max(); ok {
+		t.Error("Max of an empty queue should fail")
+	}
+
+	for _, value := range []int{3, 1, 4, 1, 5} {
+		mq.Push(value)
+	}
+	if low, _ := mq.Min(); low != 1 {
+		t.Errorf("Expected min 1, got %d", low)
+	}
+	if high, _ := mq.Max(); high != 5 {
+		t.Errorf("Expected max 5, got %d", high)
+	}
+
+	// Popping the first 1 must leave its duplicate as the minimum
+	mq.Pop()
+	mq.Pop()
+	if low, _ := mq.Min(); low != 1 {
+		t.Errorf("Expected min 1 after popping a duplicate, got %d", low)
+	}
+	mq.Pop()
+	if low, _ := mq.Min(); low != 1 || mq.String() != "MonotonicQueue[1 5]" {
+		t.Errorf("Unexpected queue %s", mq.String())
+	}
+	mq.Pop()
+	if low, _ := mq.Min(); low != 5 {
+		t.Errorf("Expected min 5, got %d", low)
+	}
+
+	mq.Clear()
+	if !mq.IsEmpty() {
+		t.Error("Queue should be empty after Clear")
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	numbers := []int{1, 3, -1, -3, 5, 3, 6, 7}
+
+	maxima := SlidingWindowMax(numbers, 3, lessInt)
+	expected := []int{3, 3, 5, 5, 6, 7}
+	for i := range expected {
+		if maxima[i] != expected[i] {
+			t.Fatalf("Expected maxima %v, got %v", expected, maxima)
+		}
+	}
+
+	minima := SlidingWindowMin(numbers, 3, lessInt)
+	expected = []int{-1, -3, -3, -3, 3, 3}
+	for i := range expected {
+		if minima[i] != expected[i] {
+			t.Fatalf("Expected minima %v, got %v", expected, minima)
+		}
+	}
+
+	if SlidingWindowMax(numbers, 0, lessInt) != nil || SlidingWindowMax(numbers, 9, lessInt) != nil {
+		t.Error("Invalid window sizes should return nil")
+	}
+
+	// Compare against brute force on random data with many duplicates
+	r := rand.New(rand.NewSource(9))
+	values := make([]int, 500)
+	for i := range values {
+		values[i] = r.Intn(10)
+	}
+	got := SlidingWindowMin(values, 17, lessInt)
+	for i := range got {
+		best := values[i]
+		for _, value := range values[i : i+17] {
+			best = min(best, value)
+		}
+		if got[i] != best {
+			t.Fatalf("Window %d: expected %d, got %d", i, best, got[i])
+		}
+	}
+}
+
+func TestMonotonicStack(t *testing.T) {
+	// Next smaller element: each value is answered by the value whose push pops it
+	values := []int{4, 8, 5, 2, 25}
+	next := make(map[int]int)
+	ms := NewMonotonicStack(lessInt)
+	for _, value := range values {
+		for _, popped := range ms.Push(value) {
+			next[popped] = value
+		}
+	}
+	if next[4] != 2 || next[8] != 5 || next[5] != 2 {
+		t.Errorf("Unexpected next smaller elements %v", next)
+	}
+	if _, ok := next[25]; ok {
+		t.Error("25 should have no smaller successor")
+	}
+
+	if ms.String() != "MonotonicStack[2 25]" {
+		t.Errorf("Unexpected stack %s", ms.String())
+	}
+	if low, _ := ms.Min(); low != 2 {
+		t.Errorf("Expected min 2, got %d", low)
+	}
+	if high, _ := ms.Max(); high != 25 {
+		t.Errorf("Expected max 25, got %d", high)
+	}
+	if popped := ms.Push(2); len(popped) != 2 {
+		t.Errorf("Pushing an equal value should pop it, got %v", popped)
+	}
+}
@@ -0,0 +1,298 @@
+package stl
+
+import (
+	"fmt"
+	"sort"
+)
+
+// EdgeID identifies a single edge instance in a MultiGraph.
+type EdgeID int
+
+// MultiEdge represents one edge instance of a MultiGraph.
+type MultiEdge[T comparable] struct {
+	ID     EdgeID
+	From   T
+	To     T
+	Weight float64
+}
+
+// MultiGraph represents a graph that allows parallel edges and self-loops.
+// Every edge has its own EdgeID, so individual instances can be inspected and removed.
+type MultiGraph[T comparable] struct {
+	edges    map[EdgeID]MultiEdge[T]
+	incident map[T]map[EdgeID]struct{}
+	directed bool
+	nextID   EdgeID
+}
+
+// NewMultiGraph creates a new empty multigraph.
+func NewMultiGraph[T comparable](directed bool) *MultiGraph[T] {
+	return &MultiGraph[T]{
+		edges:    make(map[EdgeID]MultiEdge[T]),
+		incident: make(map[T]map[EdgeID]struct{}),
+		directed: directed,
+	}
+}
+
+// AddNode adds a node to the multigraph.
+func (mg *MultiGraph[T]) AddNode(node T) {
+	if _, exists := mg.incident[node]; !exists {
+		mg.incident[node] = make(map[EdgeID]struct{})
+	}
+}
+
+// AddEdge adds a new edge with weight 1 and returns its ID.
+func (mg *MultiGraph[T]) AddEdge(from, to T) EdgeID {
+	return mg.AddWeightedEdge(from, to, defaultEdgeWeight)
+}
+
+// AddWeightedEdge adds a new edge with the given weight and returns its ID.
+// Existing edges between the same nodes are kept.
+func (mg *MultiGraph[T]) AddWeightedEdge(from, to T, weight float64) EdgeID {
+	mg.AddNode(from)
+	mg.AddNode(to)
+
+	id := mg.nextID
+	mg.nextID++
+
+	mg.edges[id] = MultiEdge[T]{ID: id, From: from, To: to, Weight: weight}
+	mg.incident[from][id] = struct{}{}
+	mg.incident[to][id] = struct{}{}
+
+	return id
+}
+
+// RemoveEdge removes the edge with the given ID.
+func (mg *MultiGraph[T]) RemoveEdge(id EdgeID) bool {
+	edge, exists := mg.edges[id]
+	if !exists {
+		return false
+	}
+
+	delete(mg.edges, id)
+	delete(mg.incident[edge.From], id)
+	delete(mg.incident[edge.To], id)
+	return true
+}
+
+// RemoveEdgesBetween removes all edges between two nodes and returns how many were removed.
+func (mg *MultiGraph[T]) RemoveEdgesBetween(from, to T) int {
+	edges := mg.EdgesBetween(from, to)
+	for _, edge := range edges {
+		mg.RemoveEdge(edge.ID)
+	}
+	return len(edges)
+}
+
+// RemoveNode removes a node and all its incident edges.
+func (mg *MultiGraph[T]) RemoveNode(node T) {
+	for id := range mg.incident[node] {
+		mg.RemoveEdge(id)
+	}
+	delete(mg.incident, node)
+}
+
+// HasNode checks if a node exists in the multigraph.
+func (mg *MultiGraph[T]) HasNode(node T) bool {
+	_, exists := mg.incident[node]
+	return exists
+}
+
+// HasEdge checks if at least one edge exists between two nodes.
+func (mg *MultiGraph[T]) HasEdge(from, to T) bool {
+	for id := range mg.incident[from] {
+		if mg.connects(mg.edges[id], from, to) {
+			return true
+		}
+	}
+	return false
+}
+
+// connects reports whether the edge goes from -> to, in either direction for undirected graphs.
+func (mg *MultiGraph[T]) connects(edge MultiEdge[T], from, to T) bool {
+	if edge.From == from && edge.To == to {
+		return true
+	}
+	return !mg.directed && edge.From == to && edge.To == from
+}
+
+// Edge returns the edge with the given ID.
+func (mg *MultiGraph[T]) Edge(id EdgeID) (MultiEdge[T], bool) {
+	edge, exists := mg.edges[id]
+	return edge, exists
+}
+
+// EdgesBetween returns all edge instances between two nodes, ordered by ID.
+func (mg *MultiGraph[T]) EdgesBetween(from, to T) []MultiEdge[T] {
+	result := []MultiEdge[T]{}
+	for id := range mg.incident[from] {
+		if edge := mg.edges[id]; mg.connects(edge, from, to) {
+			result = append(result, edge)
+		}
+	}
+	sortMultiEdges(result)
+	return result
+}
+
+// Edges returns all edges, ordered by ID.
+func (mg *MultiGraph[T]) Edges() []MultiEdge[T] {
+	result := make([]MultiEdge[T], 0, len(mg.edges))
+	for _, edge := range mg.edges {
+		result = append(result, edge)
+	}
+	sortMultiEdges(result)
+	return result
+}
+
+// sortMultiEdges orders edges by ID.
+func sortMultiEdges[T comparable](edges []MultiEdge[T]) {
+	sort.Slice(edges, func(i, j int) bool {
+		return edges[i].ID < edges[j].ID
+	})
+}
+
+// IncidentEdges returns all edges touching a node, ordered by ID.
+func (mg *MultiGraph[T]) IncidentEdges(node T) []MultiEdge[T] {
+	result := make([]MultiEdge[T], 0, len(mg.incident[node]))
+	for id := range mg.incident[node] {
+		result = append(result, mg.edges[id])
+	}
+	sortMultiEdges(result)
+	return result
+}
+
+// GetNeighbors returns the distinct nodes reachable over a single edge from node.
+func (mg *MultiGraph[T]) GetNeighbors(node T) []T {
+	seen := NewSet[T]()
+	result := []T{}
+	for _, edge := range mg.IncidentEdges(node) {
+		var neighbor T
+		switch {
+		case edge.From == node:
+			neighbor = edge.To
+		case !mg.directed:
+			neighbor = edge.From
+		default:
+			continue
+		}
+		if !seen.Contains(neighbor) {
+			seen.Add(neighbor)
+			result = append(result, neighbor)
+		}
+	}
+	return result
+}
+
+// Degree returns the number of edge endpoints at a node. A self-loop counts twice.
+func (mg *MultiGraph[T]) Degree(node T) int {
+	degree := 0
+	for id := range mg.incident[node] {
+		edge := mg.edges[id]
+		if edge.From == node {
+			degree++
+		}
+		if edge.To == node {
+			degree++
+		}
+	}
+	return degree
+}
+
+// OutDegree returns the number of edges leaving a node (the degree for undirected graphs).
+func (mg *MultiGraph[T]) OutDegree(node T) int {
+	if !mg.directed {
+		return mg.Degree(node)
+	}
+	count := 0
+	for id := range mg.incident[node] {
+		if mg.edges[id].From == node {
+			count++
+		}
+	}
+	return count
+}
+
+// InDegree returns the number of edges entering a node (the degree for undirected graphs).
+func (mg *MultiGraph[T]) InDegree(node T) int {
+	if !mg.directed {
+		return mg.Degree(node)
+	}
+	count := 0
+	for id := range mg.incident[node] {
+		if mg.edges[id].To == node {
+			count++
+		}
+	}
+	return count
+}
+
+// GetNodes returns all nodes in the multigraph.
+func (mg *MultiGraph[T]) GetNodes() []T {
+	nodes := make([]T, 0, len(mg.incident))
+	for node := range mg.incident {
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// NodeCount returns the number of nodes.
+func (mg *MultiGraph[T]) NodeCount() int {
+	return len(mg.incident)
+}
+
+// EdgeCount returns the number of edge instances.
+func (mg *MultiGraph[T]) EdgeCount() int {
+	return len(mg.edges)
+}
+
+// IsDirected checks if the multigraph is directed.
+func (mg *MultiGraph[T]) IsDirected() bool {
+	return mg.directed
+}
+
+// IsEmpty checks if the multigraph has no nodes.
+func (mg *MultiGraph[T]) IsEmpty() bool {
+	return len(mg.incident) == 0
+}
+
+// Clear removes all nodes and edges.
+func (mg *MultiGraph[T]) Clear() {
+	mg.edges = make(map[EdgeID]MultiEdge[T])
+	mg.incident = make(map[T]map[EdgeID]struct{})
+}
+
+// Clone creates a copy of the multigraph that preserves edge IDs.
+func (mg *MultiGraph[T]) Clone() *MultiGraph[T] {
+	result := NewMultiGraph[T](mg.directed)
+	result.nextID = mg.nextID
+	for node, ids := range mg.incident {
+		result.incident[node] = make(map[EdgeID]struct{}, len(ids))
+		for id := range ids {
+			result.incident[node][id] = struct{}{}
+		}
+	}
+	for id, edge := range mg.edges {
+		result.edges[id] = edge
+	}
+	return result
+}
+
+// ToGraph collapses parallel edges into a simple Graph, keeping the smallest weight for
+// each pair of nodes. Self-loops are kept.
+func (mg *MultiGraph[T]) ToGraph() *Graph[T] {
+	graph := NewGraph[T](mg.directed)
+	for node := range mg.incident {
+		graph.AddNode(node)
+	}
+	for _, edge := range mg.Edges() {
+		if weight, exists := graph.GetWeight(edge.From, edge.To); !exists || edge.Weight < weight {
+			graph.AddWeightedEdge(edge.From, edge.To, edge.Weight)
+		}
+	}
+	return graph
+}
+
+// String returns a string representation of the multigraph.
+func (mg *MultiGraph[T]) String() string {
+	return fmt.Sprintf("MultiGraph{Directed: %v, Nodes: %d, Edges: %d}", mg.directed, mg.NodeCount(), mg.EdgeCount())
+}
@@ -0,0 +1,90 @@
+package stl
+
+import (
+	"testing"
+)
+
+func TestMultiGraphParallelEdges(t *testing.T) {
+	mg := NewMultiGraph[string](false)
+	first := mg.AddEdge("A", "B")
+	second := mg.AddWeightedEdge("B", "A", 5)
+	mg.AddEdge("B", "C")
+
+	if first == second {
+		t.Error("Parallel edges should have distinct IDs")
+	}
+	if mg.EdgeCount() != 3 {
+		t.Errorf("Expected 3 edges, got %d", mg.EdgeCount())
+	}
+
+	between := mg.EdgesBetween("A", "B")
+	if len(between) != 2 || between[0].ID != first || between[1].ID != second {
+		t.Errorf("Expected both parallel edges in ID order, got %v", between)
+	}
+	if between[1].Weight != 5 {
+		t.Errorf("Expected weight 5, got %v", between[1].Weight)
+	}
+
+	if !mg.RemoveEdge(first) {
+		t.Error("RemoveEdge should remove existing edge")
+	}
+	if mg.RemoveEdge(first) {
+		t.Error("RemoveEdge should fail for removed edge")
+	}
+	if !mg.HasEdge("A", "B") {
+		t.Error("Second parallel edge should remain")
+	}
+	if mg.Degree("B") != 2 {
+		t.Errorf("Expected degree 2 for B, got %d", mg.Degree("B"))
+	}
+}
+
+func TestMultiGraphSelfLoopsAndDirection(t *testing.T) {
+	mg := NewMultiGraph[int](true)
+	loop := mg.AddEdge(1, 1)
+	mg.AddEdge(1, 2)
+	mg.AddEdge(1, 2)
+
+	if edge, ok := mg.Edge(loop); !ok || edge.From != 1 || edge.To != 1 {
+		t.Errorf("Expected self-loop edge, got %v", edge)
+	}
+	if mg.OutDegree(1) != 3 || mg.InDegree(1) != 1 {
+		t.Errorf("Expected out-degree 3 and in-degree 1, got %d and %d", mg.OutDegree(1), mg.InDegree(1))
+	}
+	if mg.HasEdge(2, 1) {
+		t.Error("Directed multigraph should not have reverse edge")
+	}
+	if neighbors := mg.GetNeighbors(1); len(neighbors) != 2 {
+		t.Errorf("Expected distinct neighbors [1 2], got %v", neighbors)
+	}
+
+	if removed := mg.RemoveEdgesBetween(1, 2); removed != 2 {
+		t.Errorf("Expected 2 removed edges, got %d", removed)
+	}
+
+	mg.RemoveNode(1)
+	if mg.EdgeCount() != 0 || mg.HasNode(1) {
+		t.Error("Removing a node should remove its incident edges")
+	}
+}
+
+func TestMultiGraphCloneAndToGraph(t *testing.T) {
+	mg := NewMultiGraph[string](false)
+	mg.AddWeightedEdge("A", "B", 3)
+	mg.AddWeightedEdge("A", "B", 1)
+	mg.AddEdge("C", "C")
+
+	clone := mg.Clone()
+	clone.AddEdge("A", "C")
+	if mg.EdgeCount() != 3 || clone.EdgeCount() != 4 {
+		t.Error("Clone should be independent of the original")
+	}
+
+	graph := mg.ToGraph()
+	if graph.EdgeCount() != 2 {
+		t.Errorf("Expected 2 collapsed edges, got %d", graph.EdgeCount())
+	}
+	if w, _ := graph.GetWeight("B", "A"); w != 1 {
+		t.Errorf("Expected minimum weight 1, got %v", w)
+	}
+}
@@ -2,28 +2,45 @@ package stl
 
 import (
 	"fmt"
+	"iter"
+	"slices"
 	"sort"
+	"strings"
 )
 
 // MultiMap represents a map that allows multiple values per key.
 type MultiMap[K comparable, V any] struct {
-	data map[K][]V
+	data   map[K][]V
+	equals func(V, V) bool
+	// shared is set while data is also referenced by a snapshot; the next write copies it.
+	shared bool
 }
 
-// NewMultiMap creates a new empty multimap.
-func NewMultiMap[K comparable, V any]() *MultiMap[K, V] {
+// NewMultiMap creates a new empty multimap. It honors WithCapacity as the expected number of
+// keys, and WithEquals to compare values in Remove, ContainsValue, ContainsEntry,
+// UniqueValues, and Equals. Values are compared with reflect.DeepEqual by default.
+func NewMultiMap[K comparable, V any](opts ...Option) *MultiMap[K, V] {
+	o := applyOptions(opts)
 	return &MultiMap[K, V]{
-		data: make(map[K][]V),
+		data:   make(map[K][]V, o.capacity),
+		equals: equalsOf[V](o, nil),
 	}
 }
 
+// newEmpty returns an empty multimap sharing the value equality of mm.
+func (mm *MultiMap[K, V]) newEmpty() *MultiMap[K, V] {
+	return &MultiMap[K, V]{data: make(map[K][]V), equals: mm.equals}
+}
+
 // Put adds a value to the multimap for the given key.
 func (mm *MultiMap[K, V]) Put(key K, value V) {
+	mm.detach()
 	mm.data[key] = append(mm.data[key], value)
 }
 
 // PutAll adds multiple values to the multimap for the given key.
 func (mm *MultiMap[K, V]) PutAll(key K, values []V) {
+	mm.detach()
 	mm.data[key] = append(mm.data[key], values...)
 }
 
@@ -60,7 +77,9 @@ func (mm *MultiMap[K, V]) GetLast(key K) (V, bool) {
 func (mm *MultiMap[K, V]) Remove(key K, value V) bool {
 	if values, exists := mm.data[key]; exists {
 		for i, v := range values {
-			if fmt.Sprintf("%v", v) == fmt.Sprintf("%v", value) {
+			if elementsEqual(mm.equals, v, value) {
+				mm.detach()
+				values = mm.data[key]
 				// Remove the element at index i
 				mm.data[key] = append(values[:i], values[i+1:]...)
 				// If no values left for this key, remove the key
@@ -77,6 +96,7 @@ func (mm *MultiMap[K, V]) Remove(key K, value V) bool {
 // RemoveAll removes all values for the given key.
 func (mm *MultiMap[K, V]) RemoveAll(key K) bool {
 	if _, exists := mm.data[key]; exists {
+		mm.detach()
 		delete(mm.data, key)
 		return true
 	}
@@ -98,7 +118,7 @@ func (mm *MultiMap[K, V]) ContainsKey(key K) bool {
 func (mm *MultiMap[K, V]) ContainsValue(value V) bool {
 	for _, values := range mm.data {
 		for _, v := range values {
-			if fmt.Sprintf("%v", v) == fmt.Sprintf("%v", value) {
+			if elementsEqual(mm.equals, v, value) {
 				return true
 			}
 		}
@@ -110,7 +130,7 @@ func (mm *MultiMap[K, V]) ContainsValue(value V) bool {
 func (mm *MultiMap[K, V]) ContainsEntry(key K, value V) bool {
 	if values, exists := mm.data[key]; exists {
 		for _, v := range values {
-			if fmt.Sprintf("%v", v) == fmt.Sprintf("%v", value) {
+			if elementsEqual(mm.equals, v, value) {
 				return true
 			}
 		}
@@ -148,49 +168,73 @@ func (mm *MultiMap[K, V]) IsEmpty() bool {
 // Clear removes all elements from the multimap.
 func (mm *MultiMap[K, V]) Clear() {
 	mm.data = make(map[K][]V)
+	mm.shared = false
+}
+
+// detach gives the multimap its own copy of data if a snapshot still shares it. Value slices
+// are copied too, since Remove edits them in place, so the first write after a snapshot takes
+// O(n) for n values.
+func (mm *MultiMap[K, V]) detach() {
+	if !mm.shared {
+		return
+	}
+	data := make(map[K][]V, len(mm.data))
+	for key, values := range mm.data {
+		data[key] = slices.Clone(values)
+	}
+	mm.data = data
+	mm.shared = false
+}
+
+// Snapshot returns an immutable view of the multimap in O(1). The view shares storage with
+// the multimap until it is next modified, which copies all entries once in O(n).
+func (mm *MultiMap[K, V]) Snapshot() *MultiMapSnapshot[K, V] {
+	mm.shared = true
+	return &MultiMapSnapshot[K, V]{mm: &MultiMap[K, V]{data: mm.data, equals: mm.equals, shared: true}}
 }
 
 // Keys returns all keys in the multimap.
 func (mm *MultiMap[K, V]) Keys() []K {
-	keys := make([]K, 0, len(mm.data))
+	return mm.AppendKeys(make([]K, 0, len(mm.data)))
+}
+
+// AppendKeys appends the keys to dst and returns the extended slice.
+func (mm *MultiMap[K, V]) AppendKeys(dst []K) []K {
 	for key := range mm.data {
-		keys = append(keys, key)
+		dst = append(dst, key)
 	}
-	return keys
+	return dst
 }
 
 // Values returns all values in the multimap.
 func (mm *MultiMap[K, V]) Values() []V {
-	var values []V
+	return mm.AppendValues(nil)
+}
+
+// AppendValues appends all values to dst and returns the extended slice.
+func (mm *MultiMap[K, V]) AppendValues(dst []V) []V {
 	for _, vals := range mm.data {
-		values = append(values, vals...)
+		dst = append(dst, vals...)
 	}
-	return values
+	return dst
 }
 
 // UniqueValues returns unique values in the multimap.
+// Values are not required to be comparable, so this takes O(n * u) for n values of which u
+// are unique.
 func (mm *MultiMap[K, V]) UniqueValues() []V {
-	valueSet := make(map[string]V)
+	var values []V
 	for _, vals := range mm.data {
 		for _, val := range vals {
-			key := fmt.Sprintf("%v", val)
-			valueSet[key] = val
+			if !slices.ContainsFunc(values, func(u V) bool { return elementsEqual(mm.equals, u, val) }) {
+				values = append(values, val)
+			}
 		}
 	}
-
-	values := make([]V, 0, len(valueSet))
-	for _, val := range valueSet {
-		values = append(values, val)
-	}
 	return values
 }
 
 // Entries returns all key-value pairs as a slice of Entry structs.
-type Entry[K comparable, V any] struct {
-	Key   K
-	Value V
-}
-
 func (mm *MultiMap[K, V]) Entries() []Entry[K, V] {
 	var entries []Entry[K, V]
 	for key, values := range mm.data {
@@ -227,6 +271,16 @@ func (mm *MultiMap[K, V]) String() string {
 	return fmt.Sprintf("MultiMap%v", mm.ToMapOfSlices())
 }
 
+// StringSorted returns a string representation of the multimap in the format of String, with
+// the keys sorted by less. Values keep their insertion order.
+func (mm *MultiMap[K, V]) StringSorted(less func(K, K) bool) string {
+	parts := make([]string, 0, len(mm.data))
+	for _, key := range mm.GetSortedKeys(less) {
+		parts = append(parts, fmt.Sprintf("%v:%v", key, mm.data[key]))
+	}
+	return "MultiMapmap[" + strings.Join(parts, " ") + "]"
+}
+
 // ForEach applies a function to each key-value pair.
 func (mm *MultiMap[K, V]) ForEach(fn func(K, V)) {
 	for key, values := range mm.data {
@@ -236,6 +290,27 @@ func (mm *MultiMap[K, V]) ForEach(fn func(K, V)) {
 	}
 }
 
+// All returns an iterator over every key-value pair.
+func (mm *MultiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, values := range mm.data {
+			for _, value := range values {
+				if !yield(key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Iterator returns an iterator over the key-value pairs present when it is created, in
+// unspecified key order. Remove deletes the current pair from the multimap.
+func (mm *MultiMap[K, V]) Iterator() MapIterator[K, V] {
+	return entryIterator[K, V]{snapshotIterator(mm.Entries(), func(entry Entry[K, V]) {
+		mm.Remove(entry.Key, entry.Value)
+	})}
+}
+
 // ForEachKey applies a function to each key and its associated values.
 func (mm *MultiMap[K, V]) ForEachKey(fn func(K, []V)) {
 	for key, values := range mm.data {
@@ -247,7 +322,7 @@ func (mm *MultiMap[K, V]) ForEachKey(fn func(K, []V)) {
 
 // Filter returns a new multimap containing entries that satisfy the predicate.
 func (mm *MultiMap[K, V]) Filter(predicate func(K, V) bool) *MultiMap[K, V] {
-	result := NewMultiMap[K, V]()
+	result := mm.newEmpty()
 	for key, values := range mm.data {
 		for _, value := range values {
 			if predicate(key, value) {
@@ -260,7 +335,7 @@ func (mm *MultiMap[K, V]) Filter(predicate func(K, V) bool) *MultiMap[K, V] {
 
 // FilterKeys returns a new multimap containing entries with keys that satisfy the predicate.
 func (mm *MultiMap[K, V]) FilterKeys(predicate func(K) bool) *MultiMap[K, V] {
-	result := NewMultiMap[K, V]()
+	result := mm.newEmpty()
 	for key, values := range mm.data {
 		if predicate(key) {
 			for _, value := range values {
@@ -273,7 +348,7 @@ func (mm *MultiMap[K, V]) FilterKeys(predicate func(K) bool) *MultiMap[K, V] {
 
 // FilterValues returns a new multimap containing entries with values that satisfy the predicate.
 func (mm *MultiMap[K, V]) FilterValues(predicate func(V) bool) *MultiMap[K, V] {
-	result := NewMultiMap[K, V]()
+	result := mm.newEmpty()
 	for key, values := range mm.data {
 		for _, value := range values {
 			if predicate(value) {
@@ -284,9 +359,53 @@ func (mm *MultiMap[K, V]) FilterValues(predicate func(V) bool) *MultiMap[K, V] {
 	return result
 }
 
+// MapValues returns a new multimap with fn applied to every value. Each key keeps its values
+// in order.
+func (mm *MultiMap[K, V]) MapValues(fn func(V) V) *MultiMap[K, V] {
+	return MapMultiMapValues(mm, fn)
+}
+
+// MapMultiMapValues returns a new multimap with fn applied to every value of mm, which may
+// change the value type.
+func MapMultiMapValues[K comparable, V, U any](mm *MultiMap[K, V], fn func(V) U) *MultiMap[K, U] {
+	result := NewMultiMap[K, U]()
+	for key, values := range mm.data {
+		mapped := make([]U, len(values))
+		for i, value := range values {
+			mapped[i] = fn(value)
+		}
+		result.data[key] = mapped
+	}
+	return result
+}
+
+// TransformKeys returns a new multimap with fn applied to every key. Keys that transform to
+// the same key have their values merged, in no particular order between the original keys.
+func (mm *MultiMap[K, V]) TransformKeys(fn func(K) K) *MultiMap[K, V] {
+	result := mm.newEmpty()
+	for key, values := range mm.data {
+		result.PutAll(fn(key), values)
+	}
+	return result
+}
+
+// InvertMultiMap returns a new multimap mapping each value of mm to the keys it appears
+// under, for example to turn a document-to-words index into a word-to-documents one. A
+// value that appears n times under a key lists that key n times. It is a function rather
+// than a method because the values must be comparable to become keys.
+func InvertMultiMap[K, V comparable](mm *MultiMap[K, V]) *MultiMap[V, K] {
+	result := NewMultiMap[V, K]()
+	for key, values := range mm.data {
+		for _, value := range values {
+			result.data[value] = append(result.data[value], key)
+		}
+	}
+	return result
+}
+
 // Clone creates a deep copy of the multimap.
 func (mm *MultiMap[K, V]) Clone() *MultiMap[K, V] {
-	result := NewMultiMap[K, V]()
+	result := mm.newEmpty()
 	for key, values := range mm.data {
 		for _, value := range values {
 			result.Put(key, value)
@@ -295,6 +414,20 @@ func (mm *MultiMap[K, V]) Clone() *MultiMap[K, V] {
 	return result
 }
 
+// CloneWith creates a copy of the multimap whose keys are copied by cloneKey and values by
+// cloneValue. A nil function copies as is.
+func (mm *MultiMap[K, V]) CloneWith(cloneKey func(K) K, cloneValue func(V) V) *MultiMap[K, V] {
+	cloneKey, cloneValue = orIdentity(cloneKey), orIdentity(cloneValue)
+	result := mm.newEmpty()
+	for key, values := range mm.data {
+		clone := cloneKey(key)
+		for _, value := range values {
+			result.Put(clone, cloneValue(value))
+		}
+	}
+	return result
+}
+
 // Equals checks if two multimaps contain the same key-value pairs.
 func (mm *MultiMap[K, V]) Equals(other *MultiMap[K, V]) bool {
 	if mm.KeySize() != other.KeySize() {
@@ -302,36 +435,33 @@ func (mm *MultiMap[K, V]) Equals(other *MultiMap[K, V]) bool {
 	}
 
 	for key, values1 := range mm.data {
-		values2 := other.Get(key)
-		if len(values1) != len(values2) {
+		if !mm.sameValues(values1, other.data[key]) {
 			return false
 		}
+	}
 
-		// Create maps to compare values (order doesn't matter)
-		valueMap1 := make(map[string]int)
-		valueMap2 := make(map[string]int)
-
-		for _, v := range values1 {
-			key := fmt.Sprintf("%v", v)
-			valueMap1[key]++
-		}
+	return true
+}
 
-		for _, v := range values2 {
-			key := fmt.Sprintf("%v", v)
-			valueMap2[key]++
+// sameValues reports whether a and b hold the same values with the same multiplicities, in
+// any order. It takes O(len(a) * len(b)), since values need not be comparable.
+func (mm *MultiMap[K, V]) sameValues(a, b []V) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	matched := make([]bool, len(b))
+	for _, v := range a {
+		found := false
+		for i, w := range b {
+			if !matched[i] && elementsEqual(mm.equals, v, w) {
+				matched[i], found = true, true
+				break
+			}
 		}
-
-		if len(valueMap1) != len(valueMap2) {
+		if !found {
 			return false
 		}
-
-		for k, count := range valueMap1 {
-			if valueMap2[k] != count {
-				return false
-			}
-		}
 	}
-
 	return true
 }
 
@@ -352,3 +482,69 @@ func (mm *MultiMap[K, V]) GetSortedValues(key K, less func(V, V) bool) []V {
 	})
 	return values
 }
+
+// MultiMapSnapshot is a read-only view of a MultiMap at the moment Snapshot was called.
+type MultiMapSnapshot[K comparable, V any] struct {
+	mm *MultiMap[K, V]
+}
+
+// Get returns a copy of the values associated with the given key.
+func (ms *MultiMapSnapshot[K, V]) Get(key K) []V {
+	return ms.mm.Get(key)
+}
+
+// ContainsKey checks if the snapshot contains the given key.
+func (ms *MultiMapSnapshot[K, V]) ContainsKey(key K) bool {
+	return ms.mm.ContainsKey(key)
+}
+
+// ContainsEntry checks if the snapshot contains the given key-value pair.
+func (ms *MultiMapSnapshot[K, V]) ContainsEntry(key K, value V) bool {
+	return ms.mm.ContainsEntry(key, value)
+}
+
+// Size returns the total number of key-value pairs in the snapshot.
+func (ms *MultiMapSnapshot[K, V]) Size() int {
+	return ms.mm.Size()
+}
+
+// KeySize returns the number of unique keys in the snapshot.
+func (ms *MultiMapSnapshot[K, V]) KeySize() int {
+	return ms.mm.KeySize()
+}
+
+// IsEmpty checks if the snapshot is empty.
+func (ms *MultiMapSnapshot[K, V]) IsEmpty() bool {
+	return ms.mm.IsEmpty()
+}
+
+// Keys returns all keys in the snapshot.
+func (ms *MultiMapSnapshot[K, V]) Keys() []K {
+	return ms.mm.Keys()
+}
+
+// Entries returns all key-value pairs in the snapshot.
+func (ms *MultiMapSnapshot[K, V]) Entries() []Entry[K, V] {
+	return ms.mm.Entries()
+}
+
+// ForEach applies a function to each key-value pair.
+func (ms *MultiMapSnapshot[K, V]) ForEach(fn func(K, V)) {
+	ms.mm.ForEach(fn)
+}
+
+// All returns an iterator over every key-value pair.
+func (ms *MultiMapSnapshot[K, V]) All() iter.Seq2[K, V] {
+	return ms.mm.All()
+}
+
+// ToMultiMap returns a mutable multimap with the snapshot's entries. It is O(1); the entries
+// are copied when the returned multimap is first modified.
+func (ms *MultiMapSnapshot[K, V]) ToMultiMap() *MultiMap[K, V] {
+	return &MultiMap[K, V]{data: ms.mm.data, equals: ms.mm.equals, shared: true}
+}
+
+// String returns a string representation of the snapshot.
+func (ms *MultiMapSnapshot[K, V]) String() string {
+	return fmt.Sprintf("MultiMapSnapshot%v", ms.mm.ToMapOfSlices())
+}
@@ -1,6 +1,7 @@
 package stl
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -245,3 +246,123 @@ func TestMultiMapFilter(t *testing.T) {
 		t.Errorf("Expected value-filtered size 2, got %d", oddValues.Size())
 	}
 }
+
+func TestMultiMapSnapshot(t *testing.T) {
+	mm := NewMultiMap[string, int]()
+	mm.PutAll("a", []int{1, 2, 3})
+	mm.Put("b", 4)
+	snapshot := mm.Snapshot()
+
+	// Remove edits the value slice in place, which must not leak into the snapshot
+	mm.Remove("a", 1)
+	mm.Put("c", 5)
+	mm.RemoveAll("b")
+
+	if got := snapshot.Get("a"); len(got) != 3 || got[0] != 1 || got[2] != 3 {
+		t.Errorf("Expected snapshot values [1 2 3], got %v", got)
+	}
+	if snapshot.Size() != 4 || snapshot.KeySize() != 2 || snapshot.ContainsKey("c") {
+		t.Errorf("Expected snapshot to be unchanged, got %v", snapshot)
+	}
+	if mm.Size() != 3 || !mm.ContainsEntry("c", 5) || mm.ContainsKey("b") {
+		t.Errorf("Expected multimap to reflect the writes, got %v", mm)
+	}
+
+	copied := snapshot.ToMultiMap()
+	copied.Remove("a", 2)
+	if !snapshot.ContainsEntry("a", 2) || copied.ValueCount("a") != 2 {
+		t.Error("Modifying ToMultiMap result should not affect the snapshot")
+	}
+}
+
+func TestMultiMapAll(t *testing.T) {
+	mm := NewMultiMap[string, int]()
+	mm.PutAll("a", []int{1, 2})
+	mm.Put("b", 3)
+
+	sum := 0
+	for key, value := range mm.All() {
+		if key == "a" {
+			sum += value
+		}
+	}
+	if sum != 3 {
+		t.Errorf("Expected values under a to sum to 3, got %d", sum)
+	}
+}
+
+func TestMultiMapStringSorted(t *testing.T) {
+	mm := NewMultiMap[int, string]()
+	mm.Put(2, "y")
+	mm.Put(1, "x")
+	mm.Put(2, "z")
+	if got := mm.StringSorted(func(a, b int) bool { return a > b }); got != "MultiMapmap[2:[y z] 1:[x]]" {
+		t.Errorf("Expected MultiMapmap[2:[y z] 1:[x]], got %s", got)
+	}
+}
+
+func TestMultiMapTransformations(t *testing.T) {
+	docs := NewMultiMap[string, string]()
+	docs.PutAll("a.txt", []string{"go", "map"})
+	docs.PutAll("b.txt", []string{"go", "set"})
+
+	index := InvertMultiMap(docs)
+	if index.ValueCount("go") != 2 || !index.ContainsEntry("go", "a.txt") || !index.ContainsEntry("set", "b.txt") {
+		t.Errorf("Expected go:[a.txt b.txt] map:[a.txt] set:[b.txt], got %v", index)
+	}
+	if index.Size() != docs.Size() || index.KeySize() != 3 {
+		t.Errorf("Expected 4 pairs under 3 keys, got %v", index)
+	}
+
+	upper := docs.MapValues(strings.ToUpper)
+	if got := upper.Get("a.txt"); len(got) != 2 || got[0] != "GO" || got[1] != "MAP" {
+		t.Errorf("Expected [GO MAP], got %v", got)
+	}
+	lengths := MapMultiMapValues(docs, func(word string) int { return len(word) })
+	if got := lengths.Get("b.txt"); len(got) != 2 || got[0] != 2 || got[1] != 3 {
+		t.Errorf("Expected [2 3], got %v", got)
+	}
+
+	merged := docs.TransformKeys(func(string) string { return "all" })
+	if merged.KeySize() != 1 || merged.ValueCount("all") != 4 {
+		t.Errorf("Expected all 4 values under one key, got %v", merged)
+	}
+	if docs.KeySize() != 2 || docs.ContainsKey("all") {
+		t.Errorf("Expected the source multimap to be unchanged, got %v", docs)
+	}
+}
+
+func TestMultiMapWithEquals(t *testing.T) {
+	mm := NewMultiMap[string, string](WithEquals(strings.EqualFold))
+	mm.PutAll("langs", []string{"Go", "Rust"})
+
+	if !mm.ContainsValue("go") || !mm.ContainsEntry("langs", "RUST") {
+		t.Errorf("Expected values to match case-insensitively")
+	}
+	if !mm.Remove("langs", "rust") || mm.Size() != 1 {
+		t.Errorf("Expected Remove to match case-insensitively, got size %d", mm.Size())
+	}
+
+	clone := mm.Clone()
+	if !clone.ContainsValue("GO") {
+		t.Errorf("Expected Clone to keep the value equality")
+	}
+	other := NewMultiMap[string, string]()
+	other.Put("langs", "go")
+	if !mm.Equals(other) {
+		t.Errorf("Expected multimaps to be equal under WithEquals")
+	}
+
+	// Values that format alike are no longer conflated by default.
+	ptrs := NewMultiMap[string, any]()
+	ptrs.PutAll("k", []any{1, "1", []int{1}})
+	if ptrs.Remove("k", "2") || !ptrs.ContainsValue([]int{1}) {
+		t.Errorf("Expected deep equality by default")
+	}
+	if !ptrs.Remove("k", "1") || ptrs.ContainsValue("1") || !ptrs.ContainsValue(1) {
+		t.Errorf("Expected Remove(\"1\") to leave the int 1, got %v", ptrs.Get("k"))
+	}
+	if got := len(ptrs.UniqueValues()); got != 2 {
+		t.Errorf("Expected 2 unique values, got %d", got)
+	}
+}
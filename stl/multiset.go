@@ -1,19 +1,27 @@
 package stl
 
 import (
+	"bufio"
 	"fmt"
+	"iter"
 	"sort"
+	"strings"
 )
 
 // MultiSet represents a collection that allows duplicate elements with count tracking.
 type MultiSet[T comparable] struct {
 	data map[T]int
+	less func(T, T) bool // orders elements with equal counts; nil leaves their order unspecified
 }
 
-// NewMultiSet creates a new empty multiset.
-func NewMultiSet[T comparable]() *MultiSet[T] {
+// NewMultiSet creates a new empty multiset. It honors WithCapacity for distinct elements, and
+// WithComparator to order elements with equal counts in MostCommon, LeastCommon, and the
+// methods that return counts, making their output deterministic.
+func NewMultiSet[T comparable](opts ...Option) *MultiSet[T] {
+	o := applyOptions(opts)
 	return &MultiSet[T]{
-		data: make(map[T]int),
+		data: make(map[T]int, o.capacity),
+		less: comparatorOf[T](o, nil),
 	}
 }
 
@@ -26,11 +34,36 @@ func NewMultiSetFromSlice[T comparable](slice []T) *MultiSet[T] {
 	return ms
 }
 
+// NewMultiSetFromSeq creates a multiset counting the elements of a sequence, without
+// collecting them into a slice first. It honors the options of NewMultiSet.
+func NewMultiSetFromSeq[T comparable](seq iter.Seq[T], opts ...Option) *MultiSet[T] {
+	ms := NewMultiSet[T](opts...)
+	ms.AddSeq(seq)
+	return ms
+}
+
+// AddFromScanner adds every token of a scanner to a multiset of strings, so word or line
+// frequencies can be counted over inputs of any size. Use scanner.Split to choose the tokens.
+// It returns the scanner's error, if any.
+func AddFromScanner(ms *MultiSet[string], scanner *bufio.Scanner) error {
+	for scanner.Scan() {
+		ms.data[scanner.Text()]++
+	}
+	return scanner.Err()
+}
+
 // Add adds an element to the multiset.
 func (ms *MultiSet[T]) Add(element T) {
 	ms.data[element]++
 }
 
+// AddSeq adds one occurrence of each element of a sequence.
+func (ms *MultiSet[T]) AddSeq(seq iter.Seq[T]) {
+	for element := range seq {
+		ms.data[element]++
+	}
+}
+
 // AddCount adds multiple occurrences of an element.
 func (ms *MultiSet[T]) AddCount(element T, count int) {
 	if count > 0 {
@@ -73,6 +106,42 @@ func (ms *MultiSet[T]) RemoveCount(element T, count int) bool {
 	return false
 }
 
+// SetCount sets the number of occurrences of an element, removing it if count is zero or
+// less.
+func (ms *MultiSet[T]) SetCount(element T, count int) {
+	if count <= 0 {
+		delete(ms.data, element)
+		return
+	}
+	ms.data[element] = count
+}
+
+// AddAll adds the occurrences of every element of other, in place, so counts are summed like
+// Python's Counter.update.
+func (ms *MultiSet[T]) AddAll(other *MultiSet[T]) {
+	for element, count := range other.data {
+		ms.data[element] += count
+	}
+}
+
+// SubtractAll removes the occurrences of every element of other, in place. Counts stop at
+// zero, so elements that other has more of are removed.
+func (ms *MultiSet[T]) SubtractAll(other *MultiSet[T]) {
+	for element, count := range other.data {
+		ms.RemoveCount(element, count)
+	}
+}
+
+// Retain keeps, in place, only the elements that are also in other, each with the smaller of
+// its two counts.
+func (ms *MultiSet[T]) Retain(other *MultiSet[T]) {
+	for element, count := range ms.data {
+		if otherCount := other.data[element]; otherCount < count {
+			ms.SetCount(element, otherCount)
+		}
+	}
+}
+
 // Count returns the number of occurrences of an element.
 func (ms *MultiSet[T]) Count(element T) int {
 	return ms.data[element]
@@ -110,13 +179,17 @@ func (ms *MultiSet[T]) Clear() {
 
 // ToSlice converts the multiset to a slice (with duplicates).
 func (ms *MultiSet[T]) ToSlice() []T {
-	result := make([]T, 0, ms.Size())
+	return ms.AppendTo(make([]T, 0, ms.Size()))
+}
+
+// AppendTo appends the elements, with duplicates, to dst and returns the extended slice.
+func (ms *MultiSet[T]) AppendTo(dst []T) []T {
 	for element, count := range ms.data {
 		for i := 0; i < count; i++ {
-			result = append(result, element)
+			dst = append(dst, element)
 		}
 	}
-	return result
+	return dst
 }
 
 // ToUniqueSlice converts the multiset to a slice of unique elements.
@@ -128,6 +201,17 @@ func (ms *MultiSet[T]) ToUniqueSlice() []T {
 	return result
 }
 
+// Elements returns the distinct elements that occur at least minCount times.
+func (ms *MultiSet[T]) Elements(minCount int) []T {
+	var result []T
+	for element, count := range ms.data {
+		if count >= minCount {
+			result = append(result, element)
+		}
+	}
+	return result
+}
+
 // ToCountMap returns a map of elements to their counts.
 func (ms *MultiSet[T]) ToCountMap() map[T]int {
 	result := make(map[T]int)
@@ -140,6 +224,7 @@ func (ms *MultiSet[T]) ToCountMap() map[T]int {
 // Union returns a new multiset containing elements from both multisets.
 func (ms *MultiSet[T]) Union(other *MultiSet[T]) *MultiSet[T] {
 	result := NewMultiSet[T]()
+	result.less = ms.less
 
 	// Add all elements from current multiset
 	for element, count := range ms.data {
@@ -157,6 +242,7 @@ func (ms *MultiSet[T]) Union(other *MultiSet[T]) *MultiSet[T] {
 // Intersection returns a new multiset containing elements present in both multisets.
 func (ms *MultiSet[T]) Intersection(other *MultiSet[T]) *MultiSet[T] {
 	result := NewMultiSet[T]()
+	result.less = ms.less
 
 	for element, count1 := range ms.data {
 		if count2, exists := other.data[element]; exists {
@@ -174,6 +260,7 @@ func (ms *MultiSet[T]) Intersection(other *MultiSet[T]) *MultiSet[T] {
 // Difference returns a new multiset containing elements in ms but not in other.
 func (ms *MultiSet[T]) Difference(other *MultiSet[T]) *MultiSet[T] {
 	result := NewMultiSet[T]()
+	result.less = ms.less
 
 	for element, count1 := range ms.data {
 		count2 := other.Count(element)
@@ -218,17 +305,61 @@ func (ms *MultiSet[T]) Equals(other *MultiSet[T]) bool {
 // Clone creates a deep copy of the multiset.
 func (ms *MultiSet[T]) Clone() *MultiSet[T] {
 	result := NewMultiSet[T]()
+	result.less = ms.less
 	for element, count := range ms.data {
 		result.AddCount(element, count)
 	}
 	return result
 }
 
+// CloneWith creates a copy of the multiset whose elements are copied by cloneElem.
+func (ms *MultiSet[T]) CloneWith(cloneElem func(T) T) *MultiSet[T] {
+	result := NewMultiSet[T]()
+	result.less = ms.less
+	for element, count := range ms.data {
+		result.AddCount(cloneElem(element), count)
+	}
+	return result
+}
+
 // String returns a string representation of the multiset.
 func (ms *MultiSet[T]) String() string {
 	return fmt.Sprintf("MultiSet%v", ms.ToCountMap())
 }
 
+// StringSorted returns a string representation of the multiset in the format of String, with
+// the elements sorted by less.
+func (ms *MultiSet[T]) StringSorted(less func(T, T) bool) string {
+	parts := make([]string, 0, len(ms.data))
+	for _, element := range ms.sortedDistinct(less) {
+		parts = append(parts, fmt.Sprintf("%v:%d", element, ms.data[element]))
+	}
+	return "MultiSetmap[" + strings.Join(parts, " ") + "]"
+}
+
+// sortedDistinct returns the distinct elements sorted by less.
+func (ms *MultiSet[T]) sortedDistinct(less func(T, T) bool) []T {
+	elements := make([]T, 0, len(ms.data))
+	for element := range ms.data {
+		elements = append(elements, element)
+	}
+	sort.Slice(elements, func(i, j int) bool {
+		return less(elements[i], elements[j])
+	})
+	return elements
+}
+
+// ToSortedSlice returns the elements, with duplicates, sorted by less.
+func (ms *MultiSet[T]) ToSortedSlice(less func(T, T) bool) []T {
+	result := make([]T, 0, ms.Size())
+	for _, element := range ms.sortedDistinct(less) {
+		for i := 0; i < ms.data[element]; i++ {
+			result = append(result, element)
+		}
+	}
+	return result
+}
+
 // ForEach applies a function to each element in the multiset (including duplicates).
 func (ms *MultiSet[T]) ForEach(fn func(T)) {
 	for element, count := range ms.data {
@@ -238,6 +369,25 @@ func (ms *MultiSet[T]) ForEach(fn func(T)) {
 	}
 }
 
+// All returns an iterator over the elements, repeating each as many times as it occurs.
+func (ms *MultiSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for element, count := range ms.data {
+			for i := 0; i < count; i++ {
+				if !yield(element) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Iterator returns an iterator over the occurrences present when it is created, in
+// unspecified order. Remove deletes one occurrence of the current element.
+func (ms *MultiSet[T]) Iterator() Iterator[T] {
+	return snapshotIterator(ms.ToSlice(), func(element T) { ms.Remove(element) })
+}
+
 // ForEachUnique applies a function to each unique element in the multiset.
 func (ms *MultiSet[T]) ForEachUnique(fn func(T, int)) {
 	for element, count := range ms.data {
@@ -245,9 +395,55 @@ func (ms *MultiSet[T]) ForEachUnique(fn func(T, int)) {
 	}
 }
 
+// Reduce folds the elements into a single value, starting from initial and visiting the
+// elements, including duplicates, in no particular order.
+func (ms *MultiSet[T]) Reduce(initial T, fn func(T, T) T) T {
+	result := initial
+	ms.ForEach(func(element T) {
+		result = fn(result, element)
+	})
+	return result
+}
+
+// CountIf returns the number of elements that satisfy the predicate.
+func (ms *MultiSet[T]) CountIf(predicate func(T) bool) int {
+	count := 0
+	ms.ForEach(func(element T) {
+		if predicate(element) {
+			count++
+		}
+	})
+	return count
+}
+
+// Min returns the smallest element according to less, or false if the multiset is empty.
+func (ms *MultiSet[T]) Min(less func(T, T) bool) (T, bool) {
+	var result T
+	found := false
+	ms.ForEach(func(element T) {
+		if !found || less(element, result) {
+			result, found = element, true
+		}
+	})
+	return result, found
+}
+
+// Max returns the largest element according to less, or false if the multiset is empty.
+func (ms *MultiSet[T]) Max(less func(T, T) bool) (T, bool) {
+	var result T
+	found := false
+	ms.ForEach(func(element T) {
+		if !found || less(result, element) {
+			result, found = element, true
+		}
+	})
+	return result, found
+}
+
 // Filter returns a new multiset containing elements that satisfy the predicate.
 func (ms *MultiSet[T]) Filter(predicate func(T) bool) *MultiSet[T] {
 	result := NewMultiSet[T]()
+	result.less = ms.less
 	for element, count := range ms.data {
 		if predicate(element) {
 			result.AddCount(element, count)
@@ -256,41 +452,99 @@ func (ms *MultiSet[T]) Filter(predicate func(T) bool) *MultiSet[T] {
 	return result
 }
 
-// MostCommon returns the most frequently occurring elements
-// and LeastCommon returns the least frequently occurring elements.
-// To avoid code duplication, the core logic is factored into a helper.
-func (ms *MultiSet[T]) mostOrLeastCommon(n int, least bool) []T {
-	if n <= 0 {
-		return []T{}
+// FilterCounts returns a new multiset containing the elements whose counts satisfy the
+// predicate, with those counts. For example, count > 1 drops the singletons.
+func (ms *MultiSet[T]) FilterCounts(predicate func(count int) bool) *MultiSet[T] {
+	result := NewMultiSet[T]()
+	result.less = ms.less
+	for element, count := range ms.data {
+		if predicate(count) {
+			result.data[element] = count
+		}
 	}
-	type elementCount struct {
-		element T
-		count   int
+	return result
+}
+
+// RemoveIf removes, in place, every element for which the predicate, given the element and
+// its count, returns true. It returns the number of distinct elements removed.
+func (ms *MultiSet[T]) RemoveIf(predicate func(T, int) bool) int {
+	removed := 0
+	for element, count := range ms.data {
+		if predicate(element, count) {
+			delete(ms.data, element)
+			removed++
+		}
 	}
-	elements := make([]elementCount, 0, len(ms.data))
+	return removed
+}
+
+// ElementCount is an element of a MultiSet together with its number of occurrences.
+type ElementCount[T any] struct {
+	Element T
+	Count   int
+}
+
+// sortedCounts returns the elements with their counts, ordered by count (ascending if least)
+// and then by the multiset's comparator, if any.
+func (ms *MultiSet[T]) sortedCounts(least bool, keep func(int) bool) []ElementCount[T] {
+	elements := make([]ElementCount[T], 0, len(ms.data))
 	for element, count := range ms.data {
-		elements = append(elements, elementCount{element, count})
+		if keep(count) {
+			elements = append(elements, ElementCount[T]{element, count})
+		}
 	}
-	if least {
-		sort.Slice(elements, func(i, j int) bool {
-			return elements[i].count < elements[j].count
-		})
-	} else {
-		sort.Slice(elements, func(i, j int) bool {
-			return elements[i].count > elements[j].count
-		})
+	sort.Slice(elements, func(i, j int) bool {
+		a, b := elements[i], elements[j]
+		if a.Count != b.Count {
+			return (a.Count < b.Count) == least
+		}
+		return ms.less != nil && ms.less(a.Element, b.Element)
+	})
+	return elements
+}
+
+// mostOrLeastCommon returns the n elements with the highest counts, or the lowest if least.
+func (ms *MultiSet[T]) mostOrLeastCommon(n int, least bool) []ElementCount[T] {
+	if n <= 0 {
+		return []ElementCount[T]{}
 	}
-	result := make([]T, 0, n)
-	for i := 0; i < n && i < len(elements); i++ {
-		result = append(result, elements[i].element)
+	elements := ms.sortedCounts(least, func(int) bool { return true })
+	return elements[:min(n, len(elements))]
+}
+
+// elementsOf drops the counts from element-count pairs.
+func elementsOf[T any](counts []ElementCount[T]) []T {
+	result := make([]T, len(counts))
+	for i, ec := range counts {
+		result[i] = ec.Element
 	}
 	return result
 }
 
+// MostCommon returns the n most frequently occurring elements, most frequent first.
 func (ms *MultiSet[T]) MostCommon(n int) []T {
-	return ms.mostOrLeastCommon(n, false)
+	return elementsOf(ms.mostOrLeastCommon(n, false))
 }
 
+// LeastCommon returns the n least frequently occurring elements, least frequent first.
 func (ms *MultiSet[T]) LeastCommon(n int) []T {
+	return elementsOf(ms.mostOrLeastCommon(n, true))
+}
+
+// MostCommonWithCounts returns the n most frequently occurring elements with their counts,
+// most frequent first.
+func (ms *MultiSet[T]) MostCommonWithCounts(n int) []ElementCount[T] {
+	return ms.mostOrLeastCommon(n, false)
+}
+
+// LeastCommonWithCounts returns the n least frequently occurring elements with their counts,
+// least frequent first.
+func (ms *MultiSet[T]) LeastCommonWithCounts(n int) []ElementCount[T] {
 	return ms.mostOrLeastCommon(n, true)
 }
+
+// CountsAbove returns the elements that occur more than threshold times with their counts,
+// most frequent first.
+func (ms *MultiSet[T]) CountsAbove(threshold int) []ElementCount[T] {
+	return ms.sortedCounts(false, func(count int) bool { return count > threshold })
+}
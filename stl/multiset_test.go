@@ -1,6 +1,9 @@
 package stl
 
 import (
+	"bufio"
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -223,3 +226,178 @@ func TestMultiSetConversions(t *testing.T) {
 		t.Errorf("Expected count map value 1 for 'banana', got %d", countMap["banana"])
 	}
 }
+
+func TestMultiSetAll(t *testing.T) {
+	ms := NewMultiSetFromSlice([]string{"a", "b", "a", "a"})
+
+	counts := map[string]int{}
+	for x := range ms.All() {
+		counts[x]++
+	}
+	if counts["a"] != 3 || counts["b"] != 1 {
+		t.Errorf("Expected a:3 b:1, got %v", counts)
+	}
+}
+
+func TestMultiSetAggregations(t *testing.T) {
+	ms := NewMultiSetFromSlice([]int{2, 2, 5})
+	if sum := ms.Reduce(0, func(a, b int) int { return a + b }); sum != 9 {
+		t.Errorf("Expected duplicates to be included in the sum 9, got %d", sum)
+	}
+	if count := ms.CountIf(func(x int) bool { return x == 2 }); count != 2 {
+		t.Errorf("Expected 2 occurrences of 2, got %d", count)
+	}
+	if largest, ok := ms.Max(lessInt); !ok || largest != 5 {
+		t.Errorf("Expected max 5, got %d", largest)
+	}
+}
+
+func TestMultiSetSortedOutput(t *testing.T) {
+	ms := NewMultiSetFromSlice([]string{"b", "a", "b", "c"})
+	less := func(a, b string) bool { return a < b }
+	got := ms.ToSortedSlice(less)
+	if len(got) != 4 || got[0] != "a" || got[1] != "b" || got[2] != "b" || got[3] != "c" {
+		t.Errorf("Expected [a b b c], got %v", got)
+	}
+	if got := ms.StringSorted(less); got != ms.String() || got != "MultiSetmap[a:1 b:2 c:1]" {
+		t.Errorf("Expected MultiSetmap[a:1 b:2 c:1], got %s", got)
+	}
+	if got := ms.StringSorted(func(a, b string) bool { return a > b }); got != "MultiSetmap[c:1 b:2 a:1]" {
+		t.Errorf("Expected MultiSetmap[c:1 b:2 a:1], got %s", got)
+	}
+}
+
+func TestMultiSetCountedArithmetic(t *testing.T) {
+	inventory := NewMultiSetFromSlice([]string{"apple", "apple", "pear"})
+	delivery := NewMultiSetFromSlice([]string{"apple", "fig", "fig"})
+
+	inventory.AddAll(delivery)
+	if inventory.Count("apple") != 3 || inventory.Count("fig") != 2 || inventory.Size() != 6 {
+		t.Errorf("Expected apple:3 fig:2 pear:1, got %v", inventory)
+	}
+
+	sold := NewMultiSetFromSlice([]string{"apple", "pear", "pear", "kiwi"})
+	inventory.SubtractAll(sold)
+	if inventory.Count("apple") != 2 || inventory.Contains("pear") || inventory.Contains("kiwi") {
+		t.Errorf("Expected apple:2 fig:2 with pear floored at zero, got %v", inventory)
+	}
+
+	inventory.Retain(NewMultiSetFromSlice([]string{"apple", "fig", "fig", "fig"}))
+	if inventory.Count("apple") != 1 || inventory.Count("fig") != 2 {
+		t.Errorf("Expected apple:1 fig:2, got %v", inventory)
+	}
+
+	inventory.SetCount("fig", 5)
+	inventory.SetCount("apple", 0)
+	if inventory.Count("fig") != 5 || inventory.Contains("apple") || inventory.UniqueSize() != 1 {
+		t.Errorf("Expected fig:5, got %v", inventory)
+	}
+
+	inventory.SubtractAll(inventory)
+	if !inventory.IsEmpty() {
+		t.Errorf("Expected subtracting itself to empty the multiset, got %v", inventory)
+	}
+}
+
+func TestMultiSetMostCommonWithCounts(t *testing.T) {
+	ms := NewMultiSet[string](WithComparator(func(a, b string) bool { return a < b }))
+	for _, word := range []string{"c", "b", "a", "b", "c", "d", "d", "d"} {
+		ms.Add(word)
+	}
+
+	// b and c tie at 2 and are ordered by the comparator
+	top := ms.MostCommonWithCounts(3)
+	want := []ElementCount[string]{{"d", 3}, {"b", 2}, {"c", 2}}
+	if len(top) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, top)
+	}
+	for i := range want {
+		if top[i] != want[i] {
+			t.Errorf("Expected %v at %d, got %v", want[i], i, top[i])
+		}
+	}
+	if got := ms.MostCommon(2); got[0] != "d" || got[1] != "b" {
+		t.Errorf("Expected [d b], got %v", got)
+	}
+	if got := ms.LeastCommonWithCounts(1); got[0] != (ElementCount[string]{"a", 1}) {
+		t.Errorf("Expected [{a 1}], got %v", got)
+	}
+
+	above := ms.CountsAbove(1)
+	if len(above) != 3 || above[0].Element != "d" || above[2].Element != "c" {
+		t.Errorf("Expected d, b, and c, got %v", above)
+	}
+	if len(ms.CountsAbove(3)) != 0 || len(ms.MostCommonWithCounts(0)) != 0 {
+		t.Error("Expected no elements above the highest count or for n=0")
+	}
+
+	// Derived multisets keep the tie order
+	if got := ms.Clone().MostCommon(3); got[1] != "b" || got[2] != "c" {
+		t.Errorf("Expected the clone to order ties by the comparator, got %v", got)
+	}
+}
+
+func TestMultiSetFrequencyPredicates(t *testing.T) {
+	ms := NewMultiSetFromSlice([]string{"a", "b", "b", "c", "c", "c", "d"})
+
+	frequent := ms.FilterCounts(func(count int) bool { return count > 1 })
+	if frequent.UniqueSize() != 2 || frequent.Count("c") != 3 || frequent.Contains("a") {
+		t.Errorf("Expected b:2 c:3, got %v", frequent)
+	}
+
+	elements := NewSetFromSlice(ms.Elements(2))
+	if !elements.Equals(NewSetFromSlice([]string{"b", "c"})) || len(ms.Elements(4)) != 0 || len(ms.Elements(0)) != 4 {
+		t.Errorf("Expected {b c} for at least 2 occurrences, got %v", elements)
+	}
+
+	removed := ms.RemoveIf(func(element string, count int) bool {
+		return count == 1 && element != "d"
+	})
+	if removed != 1 || ms.Contains("a") || !ms.Contains("d") || ms.Size() != 6 {
+		t.Errorf("Expected only a to be removed, got %d removed and %v", removed, ms)
+	}
+}
+
+func TestMultiSetFromSeq(t *testing.T) {
+	words := strings.Fields("to be or not to be")
+	ms := NewMultiSetFromSeq(NewDequeFromSlice(words).All())
+	if ms.Count("to") != 2 || ms.Count("be") != 2 || ms.Size() != 6 {
+		t.Errorf("Expected to:2 be:2 or:1 not:1, got %v", ms)
+	}
+
+	ms.AddSeq(NewSetFromSlice([]string{"be", "question"}).All())
+	if ms.Count("be") != 3 || ms.Count("question") != 1 {
+		t.Errorf("Expected be:3 question:1, got %v", ms)
+	}
+}
+
+// failingReader returns some text and then an error.
+type failingReader struct{ done bool }
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.done {
+		return 0, errors.New("read failed")
+	}
+	r.done = true
+	return copy(p, "x y\n"), nil
+}
+
+func TestAddFromScanner(t *testing.T) {
+	ms := NewMultiSet[string]()
+	scanner := bufio.NewScanner(strings.NewReader("the cat\nthe hat\n"))
+	scanner.Split(bufio.ScanWords)
+	if err := AddFromScanner(ms, scanner); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if ms.Count("the") != 2 || ms.Count("hat") != 1 || ms.Size() != 4 {
+		t.Errorf("Expected the:2 cat:1 hat:1, got %v", ms)
+	}
+
+	lines := NewMultiSet[string]()
+	if err := AddFromScanner(lines, bufio.NewScanner(&failingReader{})); err == nil {
+		t.Error("Expected the reader's error")
+	}
+	if lines.Count("x y") != 1 {
+		t.Errorf("Expected the line read before the error to be counted, got %v", lines)
+	}
+}
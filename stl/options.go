@@ -0,0 +1,91 @@
+package stl
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+)
+
+// options holds the settings collected from Option values by a constructor. Each constructor
+// documents the options it honors and ignores the rest.
+type options struct {
+	capacity   int
+	comparator any
+	equals     any
+	source     rand.Source
+}
+
+// Option configures a container when passed to its constructor, so new settings can be added
+// without new constructor variants.
+type Option func(*options)
+
+// WithCapacity preallocates room for n elements.
+func WithCapacity(n int) Option {
+	return func(o *options) {
+		o.capacity = n
+	}
+}
+
+// WithComparator sets the ordering of a container. The element type of less must match the
+// container; a constructor panics otherwise.
+func WithComparator[T any](less func(T, T) bool) Option {
+	return func(o *options) {
+		o.comparator = less
+	}
+}
+
+// WithEquals sets the equality used to compare elements or values, replacing the container's
+// default. The element type of equals must match the container; a constructor panics
+// otherwise.
+func WithEquals[T any](equals func(T, T) bool) Option {
+	return func(o *options) {
+		o.equals = equals
+	}
+}
+
+// WithRandSource sets the source of randomness, for reproducible behavior.
+func WithRandSource(src rand.Source) Option {
+	return func(o *options) {
+		o.source = src
+	}
+}
+
+// applyOptions collects the settings from opts.
+func applyOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// comparatorOf returns the comparator set by WithComparator, or fallback if none was set.
+func comparatorOf[T any](o options, fallback func(T, T) bool) func(T, T) bool {
+	return optionFunc(o.comparator, "WithComparator", fallback)
+}
+
+// equalsOf returns the equality set by WithEquals, or fallback if none was set.
+func equalsOf[T any](o options, fallback func(T, T) bool) func(T, T) bool {
+	return optionFunc(o.equals, "WithEquals", fallback)
+}
+
+// optionFunc asserts that an option value is a func(T, T) bool.
+func optionFunc[T any](value any, name string, fallback func(T, T) bool) func(T, T) bool {
+	if value == nil {
+		return fallback
+	}
+	fn, ok := value.(func(T, T) bool)
+	if !ok {
+		panic(fmt.Sprintf("stl: %s given %T, want %v", name, value, reflect.TypeFor[func(T, T) bool]()))
+	}
+	return fn
+}
+
+// elementsEqual compares two elements with equals, or with reflect.DeepEqual when equals is
+// nil, which is how containers of arbitrary element types compare by default.
+func elementsEqual[T any](equals func(T, T) bool, a, b T) bool {
+	if equals != nil {
+		return equals(a, b)
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,78 @@
+package stl
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestWithCapacity(t *testing.T) {
+	stack := NewStack[int](WithCapacity(32))
+	queue := NewQueue[int](WithCapacity(32))
+	pq := NewPriorityQueue(lessInt, WithCapacity(32))
+	if cap(stack.data) != 32 || cap(queue.data) != 32 || cap(pq.data) != 32 {
+		t.Errorf("Expected capacity 32, got %d, %d, and %d", cap(stack.data), cap(queue.data), cap(pq.data))
+	}
+	if set := NewSet[int](WithCapacity(8)); !set.IsEmpty() {
+		t.Errorf("Expected an empty set, got %v", set)
+	}
+}
+
+func TestWithEquals(t *testing.T) {
+	stack := NewStack[string](WithEquals(strings.EqualFold))
+	stack.PushAll([]string{"Go", "STL"})
+	if !stack.Contains("go") || stack.IndexOf("stl") != 1 {
+		t.Error("Expected case-insensitive lookups on the stack")
+	}
+	if clone := stack.Clone(); !clone.Contains("GO") {
+		t.Error("Expected the clone to keep the custom equality")
+	}
+
+	queue := NewQueue[string](WithEquals(strings.EqualFold))
+	queue.Enqueue("A")
+	deque := NewDeque[string](0, WithEquals(strings.EqualFold))
+	deque.PushBack("A")
+	if !queue.Contains("a") || !deque.Contains("a") || NewQueue[string]().Contains("a") {
+		t.Error("Expected WithEquals to apply only where it was given")
+	}
+
+	// TreeMap uses WithEquals for its values
+	tm := NewTreeMap[int, []int](lessInt, WithEquals(func(a, b []int) bool { return len(a) == len(b) }))
+	tm.Put(1, []int{1, 2})
+	if !tm.ContainsValue([]int{8, 9}) {
+		t.Error("Expected TreeMap to compare values with the supplied equality")
+	}
+}
+
+func TestWithComparatorAndRandSource(t *testing.T) {
+	greater := func(a, b int) bool { return a > b }
+	bst := NewOrderedBST[int](WithComparator(greater))
+	tm := NewOrderedTreeMap[int, string](WithComparator(greater))
+	for _, value := range []int{1, 3, 2} {
+		bst.Insert(value)
+		tm.Put(value, "")
+	}
+	if !slices.Equal(bst.InOrder(), []int{3, 2, 1}) || !slices.Equal(tm.Keys(), []int{3, 2, 1}) {
+		t.Errorf("Expected descending order, got %v and %v", bst.InOrder(), tm.Keys())
+	}
+
+	a := NewTreap(lessInt, WithRandSource(rand.NewSource(7)))
+	b := NewTreap(lessInt, WithRandSource(rand.NewSource(7)))
+	for i := 0; i < 100; i++ {
+		a.Insert(i)
+		b.Insert(i)
+	}
+	if a.root.value != b.root.value {
+		t.Errorf("Expected equal seeds to build the same tree, got roots %d and %d", a.root.value, b.root.value)
+	}
+}
+
+func TestOptionTypeMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a panic for a comparator of the wrong type")
+		}
+	}()
+	NewOrderedBST[int](WithComparator(func(a, b string) bool { return a < b }))
+}
@@ -0,0 +1,225 @@
+package stl
+
+import (
+	"fmt"
+)
+
+// OrderedSet represents a collection of unique elements that iterates in insertion order.
+// Membership tests, insertion, removal, and moves take O(1); index access walks the list.
+type OrderedSet[T comparable] struct {
+	index map[T]*Element[T]
+	order *LinkedList[T]
+}
+
+// NewOrderedSet creates a new empty ordered set.
+func NewOrderedSet[T comparable]() *OrderedSet[T] {
+	return &OrderedSet[T]{
+		index: make(map[T]*Element[T]),
+		order: NewLinkedList[T](),
+	}
+}
+
+// NewOrderedSetFromSlice creates an ordered set from a slice, keeping the first occurrence
+// of each element.
+func NewOrderedSetFromSlice[T comparable](slice []T) *OrderedSet[T] {
+	s := NewOrderedSet[T]()
+	for _, item := range slice {
+		s.Add(item)
+	}
+	return s
+}
+
+// Add appends an element to the set. Adding an existing element keeps its position.
+func (s *OrderedSet[T]) Add(element T) {
+	if _, exists := s.index[element]; !exists {
+		s.index[element] = s.order.PushBack(element)
+	}
+}
+
+// Remove removes an element from the set.
+func (s *OrderedSet[T]) Remove(element T) {
+	if e, exists := s.index[element]; exists {
+		s.order.Remove(e)
+		delete(s.index, element)
+	}
+}
+
+// Contains checks if an element exists in the set.
+func (s *OrderedSet[T]) Contains(element T) bool {
+	_, exists := s.index[element]
+	return exists
+}
+
+// Size returns the number of elements in the set.
+func (s *OrderedSet[T]) Size() int {
+	return len(s.index)
+}
+
+// IsEmpty checks if the set is empty.
+func (s *OrderedSet[T]) IsEmpty() bool {
+	return len(s.index) == 0
+}
+
+// Clear removes all elements from the set.
+func (s *OrderedSet[T]) Clear() {
+	s.index = make(map[T]*Element[T])
+	s.order.Clear()
+}
+
+// First returns the earliest element in the set.
+func (s *OrderedSet[T]) First() (T, bool) {
+	if e := s.order.Front(); e != nil {
+		return e.Value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Last returns the latest element in the set.
+func (s *OrderedSet[T]) Last() (T, bool) {
+	if e := s.order.Back(); e != nil {
+		return e.Value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// At returns the element at the given position. It walks from the nearer end, taking O(n).
+func (s *OrderedSet[T]) At(index int) (T, bool) {
+	size := s.Size()
+	if index < 0 || index >= size {
+		var zero T
+		return zero, false
+	}
+
+	if index < size/2 {
+		e := s.order.Front()
+		for ; index > 0; index-- {
+			e = e.Next()
+		}
+		return e.Value, true
+	}
+	e := s.order.Back()
+	for i := size - 1; i > index; i-- {
+		e = e.Prev()
+	}
+	return e.Value, true
+}
+
+// IndexOf returns the position of an element, or -1 if it is absent.
+func (s *OrderedSet[T]) IndexOf(element T) int {
+	if !s.Contains(element) {
+		return -1
+	}
+	index := 0
+	for e := s.order.Front(); e.Value != element; e = e.Next() {
+		index++
+	}
+	return index
+}
+
+// MoveToFront makes an element the earliest in the set and reports whether it was present.
+func (s *OrderedSet[T]) MoveToFront(element T) bool {
+	e, exists := s.index[element]
+	return exists && s.order.MoveToFront(e)
+}
+
+// MoveToBack makes an element the latest in the set and reports whether it was present.
+func (s *OrderedSet[T]) MoveToBack(element T) bool {
+	e, exists := s.index[element]
+	return exists && s.order.MoveToBack(e)
+}
+
+// ToSlice returns the elements in insertion order.
+func (s *OrderedSet[T]) ToSlice() []T {
+	return s.order.ToSlice()
+}
+
+// AppendTo appends the elements in insertion order to dst and returns the extended slice.
+func (s *OrderedSet[T]) AppendTo(dst []T) []T {
+	return s.order.AppendTo(dst)
+}
+
+// ForEach applies a function to each element in insertion order.
+func (s *OrderedSet[T]) ForEach(fn func(T)) {
+	s.order.ForEach(fn)
+}
+
+// Filter returns a new set with the elements satisfying the predicate, in the same order.
+func (s *OrderedSet[T]) Filter(predicate func(T) bool) *OrderedSet[T] {
+	result := NewOrderedSet[T]()
+	s.order.ForEach(func(element T) {
+		if predicate(element) {
+			result.Add(element)
+		}
+	})
+	return result
+}
+
+// Union returns a new set with the elements of s followed by the new elements of other.
+func (s *OrderedSet[T]) Union(other *OrderedSet[T]) *OrderedSet[T] {
+	result := s.Clone()
+	other.order.ForEach(result.Add)
+	return result
+}
+
+// Intersection returns a new set with the elements of s that are also in other, in the order of s.
+func (s *OrderedSet[T]) Intersection(other *OrderedSet[T]) *OrderedSet[T] {
+	return s.Filter(other.Contains)
+}
+
+// Difference returns a new set with the elements of s that are not in other, in the order of s.
+func (s *OrderedSet[T]) Difference(other *OrderedSet[T]) *OrderedSet[T] {
+	return s.Filter(func(element T) bool {
+		return !other.Contains(element)
+	})
+}
+
+// SymmetricDifference returns a new set with the elements in exactly one set: those of s
+// first, then those of other.
+func (s *OrderedSet[T]) SymmetricDifference(other *OrderedSet[T]) *OrderedSet[T] {
+	result := s.Difference(other)
+	other.order.ForEach(func(element T) {
+		if !s.Contains(element) {
+			result.Add(element)
+		}
+	})
+	return result
+}
+
+// IsSubset checks if s is a subset of other.
+func (s *OrderedSet[T]) IsSubset(other *OrderedSet[T]) bool {
+	for element := range s.index {
+		if !other.Contains(element) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals checks if two sets contain the same elements, regardless of order.
+func (s *OrderedSet[T]) Equals(other *OrderedSet[T]) bool {
+	return s.Size() == other.Size() && s.IsSubset(other)
+}
+
+// Clone creates a copy of the set with the same order.
+func (s *OrderedSet[T]) Clone() *OrderedSet[T] {
+	result := NewOrderedSet[T]()
+	s.order.ForEach(result.Add)
+	return result
+}
+
+// CloneWith creates a copy of the set with the same order whose elements are copied by
+// cloneElem.
+func (s *OrderedSet[T]) CloneWith(cloneElem func(T) T) *OrderedSet[T] {
+	result := NewOrderedSet[T]()
+	s.order.ForEach(func(element T) {
+		result.Add(cloneElem(element))
+	})
+	return result
+}
+
+// String returns a string representation of the set.
+func (s *OrderedSet[T]) String() string {
+	return fmt.Sprintf("OrderedSet%v", s.ToSlice())
+}
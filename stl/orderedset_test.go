@@ -0,0 +1,75 @@
+package stl
+
+import (
+	"testing"
+)
+
+func TestOrderedSetKeepsInsertionOrder(t *testing.T) {
+	s := NewOrderedSetFromSlice([]string{"c", "a", "b", "a"})
+	s.Add("d")
+	s.Add("c")
+
+	if s.String() != "OrderedSet[c a b d]" || s.Size() != 4 {
+		t.Errorf("Unexpected set %s", s.String())
+	}
+	if value, _ := s.At(1); value != "a" {
+		t.Errorf("Expected a at index 1, got %s", value)
+	}
+	if value, _ := s.At(3); value != "d" {
+		t.Errorf("Expected d at index 3, got %s", value)
+	}
+	if s.IndexOf("b") != 2 || s.IndexOf("z") != -1 {
+		t.Error("IndexOf returned an unexpected result")
+	}
+
+	s.MoveToFront("d")
+	s.MoveToBack("c")
+	if s.String() != "OrderedSet[d a b c]" {
+		t.Errorf("Unexpected set after moves %s", s.String())
+	}
+	if s.MoveToFront("z") {
+		t.Error("Moving a missing element should fail")
+	}
+
+	s.Remove("a")
+	if first, _ := s.First(); first != "d" {
+		t.Errorf("Expected first d, got %s", first)
+	}
+	if last, _ := s.Last(); last != "c" {
+		t.Errorf("Expected last c, got %s", last)
+	}
+	if s.Contains("a") || s.Size() != 3 {
+		t.Error("Remove did not delete the element")
+	}
+
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Error("Set should be empty after Clear")
+	}
+	if _, ok := s.First(); ok {
+		t.Error("Empty set should have no first element")
+	}
+}
+
+func TestOrderedSetAlgebraIsStable(t *testing.T) {
+	a := NewOrderedSetFromSlice([]int{5, 1, 4, 2})
+	b := NewOrderedSetFromSlice([]int{3, 2, 6, 5})
+
+	if union := a.Union(b); union.String() != "OrderedSet[5 1 4 2 3 6]" {
+		t.Errorf("Unexpected union %s", union.String())
+	}
+	if inter := a.Intersection(b); inter.String() != "OrderedSet[5 2]" {
+		t.Errorf("Unexpected intersection %s", inter.String())
+	}
+	if diff := a.Difference(b); diff.String() != "OrderedSet[1 4]" {
+		t.Errorf("Unexpected difference %s", diff.String())
+	}
+	if sym := a.SymmetricDifference(b); sym.String() != "OrderedSet[1 4 3 6]" {
+		t.Errorf("Unexpected symmetric difference %s", sym.String())
+	}
+
+	reordered := NewOrderedSetFromSlice([]int{2, 4, 1, 5})
+	if !a.Equals(reordered) || a.Equals(b) {
+		t.Error("Equals should ignore order")
+	}
+}
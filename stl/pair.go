@@ -0,0 +1,119 @@
+package stl
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// Pair holds two values of possibly different types. It encodes to JSON as
+// {"first": ..., "second": ...}.
+type Pair[A, B any] struct {
+	First  A `json:"first"`
+	Second B `json:"second"`
+}
+
+// NewPair creates a pair from two values.
+func NewPair[A, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{First: first, Second: second}
+}
+
+// Values returns both elements of the pair.
+func (p Pair[A, B]) Values() (A, B) {
+	return p.First, p.Second
+}
+
+// Swap returns a pair with the elements in reverse order.
+func (p Pair[A, B]) Swap() Pair[B, A] {
+	return Pair[B, A]{First: p.Second, Second: p.First}
+}
+
+// String returns a string representation of the pair.
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
+
+// ComparePairs orders pairs lexicographically, returning -1, 0, or +1 like cmp.Compare.
+func ComparePairs[A, B cmp.Ordered](x, y Pair[A, B]) int {
+	if c := cmp.Compare(x.First, y.First); c != 0 {
+		return c
+	}
+	return cmp.Compare(x.Second, y.Second)
+}
+
+// PairLess builds a lexicographic less function for pairs from element less functions,
+// suitable for TreeMap, TreeSet, and the other ordered containers.
+func PairLess[A, B any](lessFirst func(A, A) bool, lessSecond func(B, B) bool) func(Pair[A, B], Pair[A, B]) bool {
+	return func(x, y Pair[A, B]) bool {
+		if lessFirst(x.First, y.First) {
+			return true
+		}
+		if lessFirst(y.First, x.First) {
+			return false
+		}
+		return lessSecond(x.Second, y.Second)
+	}
+}
+
+// Triple holds three values of possibly different types. It encodes to JSON as
+// {"first": ..., "second": ..., "third": ...}.
+type Triple[A, B, C any] struct {
+	First  A `json:"first"`
+	Second B `json:"second"`
+	Third  C `json:"third"`
+}
+
+// NewTriple creates a triple from three values.
+func NewTriple[A, B, C any](first A, second B, third C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: first, Second: second, Third: third}
+}
+
+// Values returns all three elements of the triple.
+func (t Triple[A, B, C]) Values() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// String returns a string representation of the triple.
+func (t Triple[A, B, C]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", t.First, t.Second, t.Third)
+}
+
+// CompareTriples orders triples lexicographically, returning -1, 0, or +1 like cmp.Compare.
+func CompareTriples[A, B, C cmp.Ordered](x, y Triple[A, B, C]) int {
+	if c := cmp.Compare(x.First, y.First); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Second, y.Second); c != 0 {
+		return c
+	}
+	return cmp.Compare(x.Third, y.Third)
+}
+
+// TripleLess builds a lexicographic less function for triples from element less functions.
+func TripleLess[A, B, C any](lessFirst func(A, A) bool, lessSecond func(B, B) bool, lessThird func(C, C) bool) func(Triple[A, B, C], Triple[A, B, C]) bool {
+	return func(x, y Triple[A, B, C]) bool {
+		if lessFirst(x.First, y.First) {
+			return true
+		}
+		if lessFirst(y.First, x.First) {
+			return false
+		}
+		if lessSecond(x.Second, y.Second) {
+			return true
+		}
+		if lessSecond(y.Second, x.Second) {
+			return false
+		}
+		return lessThird(x.Third, y.Third)
+	}
+}
+
+// Entry is a key-value pair returned by the map containers' Entries methods.
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// Pair converts the entry into a Pair of key and value.
+func (e Entry[K, V]) Pair() Pair[K, V] {
+	return Pair[K, V]{First: e.Key, Second: e.Value}
+}
@@ -0,0 +1,78 @@
+package stl
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPairBasics(t *testing.T) {
+	p := NewPair("answer", 42)
+
+	if first, second := p.Values(); first != "answer" || second != 42 {
+		t.Errorf("Expected (answer, 42), got (%v, %v)", first, second)
+	}
+	if swapped := p.Swap(); swapped.First != 42 || swapped.Second != "answer" {
+		t.Errorf("Unexpected swap %v", swapped)
+	}
+	if p.String() != "(answer, 42)" {
+		t.Errorf("Expected (answer, 42), got %s", p.String())
+	}
+
+	// Pairs are comparable and usable as map keys
+	seen := map[Pair[string, int]]bool{p: true}
+	if !seen[NewPair("answer", 42)] {
+		t.Error("Equal pairs should hash the same")
+	}
+}
+
+func TestPairOrdering(t *testing.T) {
+	a, b, c := NewPair(1, "b"), NewPair(1, "c"), NewPair(2, "a")
+
+	if ComparePairs(a, b) != -1 || ComparePairs(c, b) != 1 || ComparePairs(a, a) != 0 {
+		t.Error("ComparePairs returned an unexpected result")
+	}
+
+	less := PairLess(lessInt, func(x, y string) bool { return x < y })
+	set := NewTreeSet(less)
+	set.Add(c)
+	set.Add(b)
+	set.Add(a)
+	if values := set.ToSlice(); values[0] != a || values[1] != b || values[2] != c {
+		t.Errorf("Expected lexicographic order, got %v", values)
+	}
+
+	x, y := NewTriple(1, 2, "z"), NewTriple(1, 2, "a")
+	if CompareTriples(x, y) != 1 {
+		t.Error("Expected the third element to break the tie")
+	}
+	tripleLess := TripleLess(lessInt, lessInt, func(x, y string) bool { return x < y })
+	if !tripleLess(y, x) || tripleLess(x, y) || tripleLess(x, x) {
+		t.Error("TripleLess returned an unexpected result")
+	}
+}
+
+func TestPairJSON(t *testing.T) {
+	data, err := json.Marshal(NewTriple("a", 1, true))
+	if err != nil || string(data) != `{"first":"a","second":1,"third":true}` {
+		t.Errorf("Unexpected JSON %s (%v)", data, err)
+	}
+
+	var p Pair[string, []int]
+	if err := json.Unmarshal([]byte(`{"first":"xs","second":[1,2]}`), &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.First != "xs" || len(p.Second) != 2 {
+		t.Errorf("Unexpected decoded pair %v", p)
+	}
+}
+
+func TestEntryPair(t *testing.T) {
+	tm := NewTreeMap[int, string](lessInt)
+	tm.Put(2, "b")
+	tm.Put(1, "a")
+
+	entries := tm.Entries()
+	if pair := entries[0].Pair(); pair != NewPair(1, "a") {
+		t.Errorf("Expected (1, a), got %v", pair)
+	}
+}
@@ -0,0 +1,264 @@
+package stl
+
+import "fmt"
+
+// PairingNode is a handle to a value stored in a PairingHeap, used for DecreaseKey and Remove.
+type PairingNode[T any] struct {
+	value   T
+	child   *PairingNode[T]
+	sibling *PairingNode[T]
+	// prev is the parent for a leftmost child and the left sibling otherwise
+	prev  *PairingNode[T]
+	owner *pairingOwner[T]
+}
+
+// Value returns the value stored in the node.
+func (n *PairingNode[T]) Value() T {
+	return n.value
+}
+
+// pairingOwner records which heap a node belongs to. Melding forwards the absorbed heap's
+// owner to the receiving heap instead of rewriting every node, keeping Meld O(1).
+type pairingOwner[T any] struct {
+	heap    *PairingHeap[T]
+	forward *pairingOwner[T]
+}
+
+// resolve returns the heap the owner currently stands for, compressing forwarding chains.
+func (o *pairingOwner[T]) resolve() *PairingHeap[T] {
+	root := o
+	for root.forward != nil {
+		root = root.forward
+	}
+	for o != root {
+		next := o.forward
+		o.forward = root
+		o = next
+	}
+	return root.heap
+}
+
+// PairingHeap is a self-adjusting heap with O(1) Insert and Meld and O(log n) amortized Pop
+// and DecreaseKey, suited to algorithms that merge many queues.
+type PairingHeap[T any] struct {
+	less  func(T, T) bool
+	root  *PairingNode[T]
+	size  int
+	owner *pairingOwner[T]
+}
+
+// NewPairingHeap creates a new empty pairing heap; the value that is less than all others
+// according to less is popped first.
+func NewPairingHeap[T any](less func(T, T) bool) *PairingHeap[T] {
+	h := &PairingHeap[T]{less: less}
+	h.owner = &pairingOwner[T]{heap: h}
+	return h
+}
+
+// NewPairingHeapFromSlice creates a pairing heap containing the slice elements.
+func NewPairingHeapFromSlice[T any](slice []T, less func(T, T) bool) *PairingHeap[T] {
+	h := NewPairingHeap[T](less)
+	for _, item := range slice {
+		h.Insert(item)
+	}
+	return h
+}
+
+// Insert adds a value to the heap and returns its node handle.
+func (h *PairingHeap[T]) Insert(value T) *PairingNode[T] {
+	node := &PairingNode[T]{value: value, owner: h.owner}
+	h.root = h.link(h.root, node)
+	h.size++
+	return node
+}
+
+// Peek returns the minimum value without removing it.
+func (h *PairingHeap[T]) Peek() (T, bool) {
+	if h.root == nil {
+		var zero T
+		return zero, false
+	}
+	return h.root.value, true
+}
+
+// Pop removes and returns the minimum value.
+func (h *PairingHeap[T]) Pop() (T, bool) {
+	if h.root == nil {
+		var zero T
+		return zero, false
+	}
+
+	top := h.root
+	h.root = h.mergePairs(top.child)
+	h.size--
+	top.child, top.owner = nil, nil
+	return top.value, true
+}
+
+// DecreaseKey replaces the value of a node with a smaller one. It returns false if the node
+// does not belong to this heap or value is not less than the current value.
+func (h *PairingHeap[T]) DecreaseKey(node *PairingNode[T], value T) bool {
+	if !h.owns(node) || !h.less(value, node.value) {
+		return false
+	}
+
+	node.value = value
+	if node != h.root {
+		h.cut(node)
+		h.root = h.link(h.root, node)
+	}
+	return true
+}
+
+// Remove deletes a node from the heap. It returns false if the node does not belong to
+// this heap.
+func (h *PairingHeap[T]) Remove(node *PairingNode[T]) bool {
+	if !h.owns(node) {
+		return false
+	}
+
+	if node == h.root {
+		h.Pop()
+		return true
+	}
+
+	h.cut(node)
+	h.root = h.link(h.root, h.mergePairs(node.child))
+	h.size--
+	node.child, node.owner = nil, nil
+	return true
+}
+
+// Meld moves every value of other into the heap in O(1), leaving other empty. Node handles
+// from other stay valid and now belong to this heap.
+func (h *PairingHeap[T]) Meld(other *PairingHeap[T]) {
+	if other == h || other.root == nil {
+		return
+	}
+
+	h.root = h.link(h.root, other.root)
+	h.size += other.size
+
+	other.owner.heap = nil
+	other.owner.forward = h.owner
+	other.owner = &pairingOwner[T]{heap: other}
+	other.root = nil
+	other.size = 0
+}
+
+// Size returns the number of values in the heap.
+func (h *PairingHeap[T]) Size() int {
+	return h.size
+}
+
+// IsEmpty returns true if the heap is empty.
+func (h *PairingHeap[T]) IsEmpty() bool {
+	return h.size == 0
+}
+
+// Clear removes all values from the heap. Existing node handles become invalid.
+func (h *PairingHeap[T]) Clear() {
+	h.owner.heap = nil
+	h.owner = &pairingOwner[T]{heap: h}
+	h.root = nil
+	h.size = 0
+}
+
+// ForEach calls fn for every value in the heap in unspecified order.
+func (h *PairingHeap[T]) ForEach(fn func(T)) {
+	if h.root == nil {
+		return
+	}
+
+	stack := []*PairingNode[T]{h.root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		fn(node.value)
+		if node.sibling != nil {
+			stack = append(stack, node.sibling)
+		}
+		if node.child != nil {
+			stack = append(stack, node.child)
+		}
+	}
+}
+
+// ToSlice returns the values of the heap in unspecified order.
+func (h *PairingHeap[T]) ToSlice() []T {
+	result := make([]T, 0, h.size)
+	h.ForEach(func(value T) {
+		result = append(result, value)
+	})
+	return result
+}
+
+// String returns a string representation of the heap.
+func (h *PairingHeap[T]) String() string {
+	return fmt.Sprintf("PairingHeap%v", h.ToSlice())
+}
+
+// owns reports whether the node currently belongs to the heap.
+func (h *PairingHeap[T]) owns(node *PairingNode[T]) bool {
+	return node != nil && node.owner != nil && node.owner.resolve() == h
+}
+
+// link makes the larger of two roots the leftmost child of the smaller and returns the
+// new root.
+func (h *PairingHeap[T]) link(a, b *PairingNode[T]) *PairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.less(b.value, a.value) {
+		a, b = b, a
+	}
+
+	b.prev = a
+	b.sibling = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	a.sibling, a.prev = nil, nil
+	return a
+}
+
+// cut detaches a non-root node and its subtree from the tree.
+func (h *PairingHeap[T]) cut(node *PairingNode[T]) {
+	if node.prev.child == node {
+		node.prev.child = node.sibling
+	} else {
+		node.prev.sibling = node.sibling
+	}
+	if node.sibling != nil {
+		node.sibling.prev = node.prev
+	}
+	node.sibling, node.prev = nil, nil
+}
+
+// mergePairs combines a sibling list into one tree using the standard two-pass scheme:
+// link neighbours left to right, then fold the results right to left.
+func (h *PairingHeap[T]) mergePairs(first *PairingNode[T]) *PairingNode[T] {
+	var pairs []*PairingNode[T]
+	for first != nil {
+		a := first
+		b := a.sibling
+		if b == nil {
+			first = nil
+		} else {
+			first = b.sibling
+			b.sibling, b.prev = nil, nil
+		}
+		a.sibling, a.prev = nil, nil
+		pairs = append(pairs, h.link(a, b))
+	}
+
+	var root *PairingNode[T]
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = h.link(pairs[i], root)
+	}
+	return root
+}
@@ -0,0 +1,121 @@
+package stl
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestPairingHeapBasicOperations(t *testing.T) {
+	h := NewPairingHeapFromSlice([]int{5, 3, 8, 1, 9, 2}, lessInt)
+
+	if h.Size() != 6 {
+		t.Errorf("Expected size 6, got %d", h.Size())
+	}
+	if value, _ := h.Peek(); value != 1 {
+		t.Errorf("Expected min 1, got %d", value)
+	}
+
+	var popped []int
+	for !h.IsEmpty() {
+		value, _ := h.Pop()
+		popped = append(popped, value)
+	}
+	if !sort.IntsAreSorted(popped) || len(popped) != 6 {
+		t.Errorf("Expected sorted output, got %v", popped)
+	}
+	if _, ok := h.Pop(); ok {
+		t.Error("Pop on an empty heap should fail")
+	}
+}
+
+func TestPairingHeapDecreaseKeyAndRemove(t *testing.T) {
+	h := NewPairingHeap[int](lessInt)
+	nodes := make([]*PairingNode[int], 10)
+	for i := range nodes {
+		nodes[i] = h.Insert(10 + i)
+	}
+	h.Pop() // restructure so nodes sit at different depths
+
+	if !h.DecreaseKey(nodes[7], 0) || h.DecreaseKey(nodes[7], 5) {
+		t.Error("DecreaseKey returned an unexpected result")
+	}
+	if value, _ := h.Peek(); value != 0 || nodes[7].Value() != 0 {
+		t.Errorf("Expected min 0, got %d", value)
+	}
+	if h.DecreaseKey(nodes[0], -1) {
+		t.Error("Popped nodes should no longer belong to the heap")
+	}
+
+	if !h.Remove(nodes[4]) || h.Remove(nodes[4]) {
+		t.Error("Expected Remove to succeed once")
+	}
+	if h.Size() != 8 {
+		t.Errorf("Expected size 8, got %d", h.Size())
+	}
+
+	expected := []int{0, 11, 12, 13, 15, 16, 18, 19}
+	for _, want := range expected {
+		if value, _ := h.Pop(); value != want {
+			t.Fatalf("Expected %d, got %d", want, value)
+		}
+	}
+}
+
+func TestPairingHeapMeld(t *testing.T) {
+	a := NewPairingHeapFromSlice([]int{4, 8}, lessInt)
+	b := NewPairingHeap[int](lessInt)
+	handle := b.Insert(6)
+	b.Insert(2)
+
+	a.Meld(b)
+	if a.Size() != 4 || !b.IsEmpty() {
+		t.Errorf("Expected sizes 4 and 0, got %d and %d", a.Size(), b.Size())
+	}
+	if b.DecreaseKey(handle, 1) || !a.DecreaseKey(handle, 1) {
+		t.Error("Handles from the melded heap should move to the receiver")
+	}
+	if value, _ := a.Peek(); value != 1 {
+		t.Errorf("Expected min 1, got %d", value)
+	}
+
+	b.Insert(7)
+	if b.Size() != 1 || a.Size() != 4 {
+		t.Error("Melded heap should be reusable and independent")
+	}
+}
+
+func TestPairingHeapRandomized(t *testing.T) {
+	h := NewPairingHeap[int](lessInt)
+	r := rand.New(rand.NewSource(11))
+	live := make(map[*PairingNode[int]]bool)
+
+	for i := 0; i < 3000; i++ {
+		switch r.Intn(4) {
+		case 0, 1:
+			live[h.Insert(r.Intn(10000))] = true
+		case 2:
+			for node := range live {
+				h.DecreaseKey(node, node.Value()-r.Intn(100))
+				break
+			}
+		default:
+			for node := range live {
+				h.Remove(node)
+				delete(live, node)
+				break
+			}
+		}
+	}
+
+	var expected []int
+	for node := range live {
+		expected = append(expected, node.Value())
+	}
+	sort.Ints(expected)
+	for i, want := range expected {
+		if value, _ := h.Pop(); value != want {
+			t.Fatalf("Pop %d: expected %d, got %d", i, want, value)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package stl
+
+import (
+	"fmt"
+)
+
+const (
+	pvBits  = 5
+	pvWidth = 1 << pvBits
+	pvMask  = pvWidth - 1
+)
+
+// pvNode is an immutable node of a PersistentVector trie. Leaves hold values; internal
+// nodes hold up to 32 children.
+type pvNode[T any] struct {
+	children []*pvNode[T]
+	values   []T
+}
+
+// PersistentVector represents an immutable indexed sequence. Set, Append, and Pop return new
+// versions that share all untouched structure with the original through a 32-way trie, and
+// the last partial block is kept in a tail for fast appends. Get, Set, Append, and Pop take
+// O(log32 n), which is effectively constant.
+type PersistentVector[T any] struct {
+	size  int
+	shift uint
+	root  *pvNode[T]
+	tail  []T
+}
+
+// NewPersistentVector creates a new empty persistent vector.
+func NewPersistentVector[T any]() *PersistentVector[T] {
+	return &PersistentVector[T]{shift: pvBits, root: &pvNode[T]{}}
+}
+
+// NewPersistentVectorFromSlice creates a persistent vector holding the slice elements in order.
+func NewPersistentVectorFromSlice[T any](slice []T) *PersistentVector[T] {
+	return NewPersistentVector[T]().Append(slice...)
+}
+
+// tailOffset returns the index of the first element stored in the tail.
+func (pv *PersistentVector[T]) tailOffset() int {
+	if pv.size < pvWidth {
+		return 0
+	}
+	return ((pv.size - 1) >> pvBits) << pvBits
+}
+
+// leafFor returns the block of values holding index, which must be in range.
+func (pv *PersistentVector[T]) leafFor(index int) []T {
+	if index >= pv.tailOffset() {
+		return pv.tail
+	}
+	node := pv.root
+	for level := pv.shift; level > 0; level -= pvBits {
+		node = node.children[(index>>level)&pvMask]
+	}
+	return node.values
+}
+
+// Get returns the element at the given index.
+func (pv *PersistentVector[T]) Get(index int) (T, bool) {
+	if index < 0 || index >= pv.size {
+		var zero T
+		return zero, false
+	}
+	return pv.leafFor(index)[index&pvMask], true
+}
+
+// Set returns a new version with the element at index replaced. It returns pv and false if
+// the index is out of range.
+func (pv *PersistentVector[T]) Set(index int, value T) (*PersistentVector[T], bool) {
+	if index < 0 || index >= pv.size {
+		return pv, false
+	}
+
+	result := *pv
+	if index >= pv.tailOffset() {
+		result.tail = append([]T(nil), pv.tail...)
+		result.tail[index&pvMask] = value
+	} else {
+		result.root = pvAssoc(pv.root, pv.shift, index, value)
+	}
+	return &result, true
+}
+
+// pvAssoc copies the path to index below node and stores value in the copied leaf.
+func pvAssoc[T any](node *pvNode[T], level uint, index int, value T) *pvNode[T] {
+	if level == 0 {
+		values := append([]T(nil), node.values...)
+		values[index&pvMask] = value
+		return &pvNode[T]{values: values}
+	}
+	children := append([]*pvNode[T](nil), node.children...)
+	sub := (index >> level) & pvMask
+	children[sub] = pvAssoc(node.children[sub], level-pvBits, index, value)
+	return &pvNode[T]{children: children}
+}
+
+// Append returns a new version with the values added at the end.
+func (pv *PersistentVector[T]) Append(values ...T) *PersistentVector[T] {
+	result := pv
+	for _, value := range values {
+		result = result.append(value)
+	}
+	return result
+}
+
+// append returns a new version with a single value added at the end.
+func (pv *PersistentVector[T]) append(value T) *PersistentVector[T] {
+	result := *pv
+	result.size++
+
+	// Room left in the tail
+	if pv.size-pv.tailOffset() < pvWidth {
+		tail := make([]T, len(pv.tail), len(pv.tail)+1)
+		copy(tail, pv.tail)
+		result.tail = append(tail, value)
+		return &result
+	}
+
+	// Push the full tail into the trie, growing a level if the root is full
+	leaf := &pvNode[T]{values: pv.tail}
+	if (pv.size >> pvBits) > (1 << pv.shift) {
+		result.root = &pvNode[T]{children: []*pvNode[T]{pv.root, pvNewPath(pv.shift, leaf)}}
+		result.shift += pvBits
+	} else {
+		result.root = pv.pushTail(pv.shift, pv.root, leaf)
+	}
+	result.tail = []T{value}
+	return &result
+}
+
+// pvNewPath wraps node in single-child internal nodes down from level.
+func pvNewPath[T any](level uint, node *pvNode[T]) *pvNode[T] {
+	if level == 0 {
+		return node
+	}
+	return &pvNode[T]{children: []*pvNode[T]{pvNewPath(level-pvBits, node)}}
+}
+
+// pushTail copies the rightmost path of parent and attaches leaf at its end.
+func (pv *PersistentVector[T]) pushTail(level uint, parent, leaf *pvNode[T]) *pvNode[T] {
+	sub := ((pv.size - 1) >> level) & pvMask
+	children := make([]*pvNode[T], len(parent.children), max(len(parent.children), sub+1))
+	copy(children, parent.children)
+
+	var child *pvNode[T]
+	switch {
+	case level == pvBits:
+		child = leaf
+	case sub < len(parent.children):
+		child = pv.pushTail(level-pvBits, parent.children[sub], leaf)
+	default:
+		child = pvNewPath(level-pvBits, leaf)
+	}
+
+	if sub < len(children) {
+		children[sub] = child
+	} else {
+		children = append(children, child)
+	}
+	return &pvNode[T]{children: children}
+}
+
+// Pop returns a new version without the last element, along with that element.
+func (pv *PersistentVector[T]) Pop() (*PersistentVector[T], T, bool) {
+	if pv.size == 0 {
+		var zero T
+		return pv, zero, false
+	}
+	last, _ := pv.Get(pv.size - 1)
+	if pv.size == 1 {
+		return NewPersistentVector[T](), last, true
+	}
+
+	result := *pv
+	result.size--
+
+	if pv.size-pv.tailOffset() > 1 {
+		result.tail = pv.tail[: len(pv.tail)-1 : len(pv.tail)-1]
+		return &result, last, true
+	}
+
+	// The tail empties, so the rightmost leaf of the trie becomes the new tail
+	result.tail = pv.leafFor(pv.size - 2)
+	root := pv.popTail(pv.shift, pv.root)
+	if root == nil {
+		root = &pvNode[T]{}
+	}
+	if pv.shift > pvBits && len(root.children) == 1 {
+		root = root.children[0]
+		result.shift -= pvBits
+	}
+	result.root = root
+	return &result, last, true
+}
+
+// popTail copies the rightmost path of node without its last leaf, returning nil if
+// nothing remains.
+func (pv *PersistentVector[T]) popTail(level uint, node *pvNode[T]) *pvNode[T] {
+	sub := ((pv.size - 2) >> level) & pvMask
+	if level > pvBits {
+		child := pv.popTail(level-pvBits, node.children[sub])
+		if child == nil && sub == 0 {
+			return nil
+		}
+		children := append([]*pvNode[T](nil), node.children[:sub]...)
+		if child != nil {
+			children = append(children, child)
+		}
+		return &pvNode[T]{children: children}
+	}
+	if sub == 0 {
+		return nil
+	}
+	return &pvNode[T]{children: append([]*pvNode[T](nil), node.children[:sub]...)}
+}
+
+// Last returns the last element.
+func (pv *PersistentVector[T]) Last() (T, bool) {
+	return pv.Get(pv.size - 1)
+}
+
+// Size returns the number of elements in the vector.
+func (pv *PersistentVector[T]) Size() int {
+	return pv.size
+}
+
+// IsEmpty checks if the vector is empty.
+func (pv *PersistentVector[T]) IsEmpty() bool {
+	return pv.size == 0
+}
+
+// ForEach applies a function to each element in order.
+func (pv *PersistentVector[T]) ForEach(fn func(T)) {
+	for i := 0; i < pv.size; i += pvWidth {
+		for _, value := range pv.leafFor(i) {
+			fn(value)
+		}
+	}
+}
+
+// ToSlice returns the elements in order.
+func (pv *PersistentVector[T]) ToSlice() []T {
+	result := make([]T, 0, pv.size)
+	pv.ForEach(func(value T) {
+		result = append(result, value)
+	})
+	return result
+}
+
+// String returns a string representation of the vector.
+func (pv *PersistentVector[T]) String() string {
+	return fmt.Sprintf("PersistentVector%v", pv.ToSlice())
+}
@@ -0,0 +1,96 @@
+package stl
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestPersistentVectorBasicOperations(t *testing.T) {
+	v0 := NewPersistentVector[string]()
+	v1 := v0.Append("a", "b", "c")
+	v2, ok := v1.Set(1, "B")
+	if !ok {
+		t.Fatal("Expected Set to succeed")
+	}
+	v3, last, _ := v2.Pop()
+
+	if !v0.IsEmpty() || v1.String() != "PersistentVector[a b c]" {
+		t.Errorf("Unexpected version %s", v1.String())
+	}
+	if v2.String() != "PersistentVector[a B c]" || last != "c" || v3.Size() != 2 {
+		t.Errorf("Unexpected versions %s and %s", v2.String(), v3.String())
+	}
+	if value, _ := v1.Get(1); value != "b" {
+		t.Errorf("Older version should keep b, got %s", value)
+	}
+	if value, _ := v3.Last(); value != "B" {
+		t.Errorf("Expected last B, got %s", value)
+	}
+
+	if _, ok := v1.Get(3); ok {
+		t.Error("Get past the end should fail")
+	}
+	if same, ok := v1.Set(-1, "x"); ok || same != v1 {
+		t.Error("Set out of range should return the same version")
+	}
+	if _, _, ok := v0.Pop(); ok {
+		t.Error("Pop on an empty vector should fail")
+	}
+}
+
+func TestPersistentVectorMatchesSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	v := NewPersistentVector[int]()
+	var expected []int
+	var versions []*PersistentVector[int]
+	var snapshots [][]int
+
+	// Large enough for a three-level trie, with pops crossing block boundaries
+	for i := 0; i < 40000; i++ {
+		switch op := r.Intn(10); {
+		case op < 6 || len(expected) == 0:
+			v = v.Append(i)
+			expected = append(expected, i)
+		case op < 8:
+			index := r.Intn(len(expected))
+			v, _ = v.Set(index, -i)
+			expected[index] = -i
+		default:
+			var value int
+			v, value, _ = v.Pop()
+			if value != expected[len(expected)-1] {
+				t.Fatalf("Expected to pop %d, got %d", expected[len(expected)-1], value)
+			}
+			expected = expected[:len(expected)-1]
+		}
+		if i%5000 == 0 {
+			versions = append(versions, v)
+			snapshots = append(snapshots, append([]int(nil), expected...))
+		}
+	}
+
+	check := func(v *PersistentVector[int], expected []int) {
+		t.Helper()
+		if v.Size() != len(expected) {
+			t.Fatalf("Expected size %d, got %d", len(expected), v.Size())
+		}
+		actual := v.ToSlice()
+		for i := range expected {
+			if value, _ := v.Get(i); value != expected[i] || actual[i] != expected[i] {
+				t.Fatalf("Expected %d at index %d, got %d", expected[i], i, value)
+			}
+		}
+	}
+	check(v, expected)
+	for i := range versions {
+		check(versions[i], snapshots[i])
+	}
+
+	// Popping everything must shrink the trie back to empty
+	for !v.IsEmpty() {
+		v, _, _ = v.Pop()
+	}
+	if v.Append(1).String() != "PersistentVector[1]" {
+		t.Error("Expected a usable empty vector")
+	}
+}
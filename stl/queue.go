@@ -1,19 +1,26 @@
 package stl
 
 import (
+	"cmp"
 	"fmt"
+	"iter"
+	"slices"
 	"sort"
 )
 
 // Queue represents a FIFO (First In, First Out) data structure.
 type Queue[T any] struct {
-	data []T
+	data   []T
+	equals func(T, T) bool
 }
 
-// NewQueue creates a new empty queue.
-func NewQueue[T any]() *Queue[T] {
+// NewQueue creates a new empty queue. It honors WithCapacity, and WithEquals to replace the
+// default comparison of elements with reflect.DeepEqual in Contains, IndexOf, and Equals.
+func NewQueue[T any](opts ...Option) *Queue[T] {
+	o := applyOptions(opts)
 	return &Queue[T]{
-		data: make([]T, 0),
+		data:   make([]T, 0, o.capacity),
+		equals: equalsOf[T](o, nil),
 	}
 }
 
@@ -55,6 +62,26 @@ func (q *Queue[T]) Peek() (T, bool) {
 	return q.data[0], true
 }
 
+// DequeueE is like Dequeue but returns ErrEmpty if the queue is empty.
+func (q *Queue[T]) DequeueE() (T, error) {
+	return orErrEmpty(q.Dequeue())
+}
+
+// MustDequeue is like Dequeue but panics if the queue is empty.
+func (q *Queue[T]) MustDequeue() T {
+	return must(q.DequeueE())
+}
+
+// PeekE is like Peek but returns ErrEmpty if the queue is empty.
+func (q *Queue[T]) PeekE() (T, error) {
+	return orErrEmpty(q.Peek())
+}
+
+// MustPeek is like Peek but panics if the queue is empty.
+func (q *Queue[T]) MustPeek() T {
+	return must(q.PeekE())
+}
+
 // PeekBack returns the back element without removing it.
 func (q *Queue[T]) PeekBack() (T, bool) {
 	if q.IsEmpty() {
@@ -81,9 +108,13 @@ func (q *Queue[T]) Clear() {
 
 // ToSlice returns a copy of the queue as a slice.
 func (q *Queue[T]) ToSlice() []T {
-	result := make([]T, len(q.data))
-	copy(result, q.data)
-	return result
+	return q.AppendTo(make([]T, 0, len(q.data)))
+}
+
+// AppendTo appends the elements to dst in the order of ToSlice and returns the extended
+// slice.
+func (q *Queue[T]) AppendTo(dst []T) []T {
+	return append(dst, q.data...)
 }
 
 // String returns a string representation of the queue.
@@ -98,6 +129,26 @@ func (q *Queue[T]) ForEach(fn func(T)) {
 	}
 }
 
+// All returns an iterator over the elements from front to back.
+func (q *Queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range q.data {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Iterator returns a bidirectional iterator over the elements from front to back.
+func (q *Queue[T]) Iterator() BidirectionalIterator[T] {
+	return newIndexIterator(
+		func(i int) T { return q.data[i] },
+		q.Size,
+		func(i int) { q.data = slices.Delete(q.data, i, i+1) },
+	)
+}
+
 // ForEachReversed applies a function to each element in the queue (from back to front).
 func (q *Queue[T]) ForEachReversed(fn func(T)) {
 	for i := len(q.data) - 1; i >= 0; i-- {
@@ -105,9 +156,55 @@ func (q *Queue[T]) ForEachReversed(fn func(T)) {
 	}
 }
 
+// Reduce folds the elements into a single value, starting from initial and visiting the
+// elements from front to back.
+func (q *Queue[T]) Reduce(initial T, fn func(T, T) T) T {
+	result := initial
+	q.ForEach(func(element T) {
+		result = fn(result, element)
+	})
+	return result
+}
+
+// CountIf returns the number of elements that satisfy the predicate.
+func (q *Queue[T]) CountIf(predicate func(T) bool) int {
+	count := 0
+	q.ForEach(func(element T) {
+		if predicate(element) {
+			count++
+		}
+	})
+	return count
+}
+
+// Min returns the smallest element according to less, or false if the queue is empty.
+func (q *Queue[T]) Min(less func(T, T) bool) (T, bool) {
+	var result T
+	found := false
+	q.ForEach(func(element T) {
+		if !found || less(element, result) {
+			result, found = element, true
+		}
+	})
+	return result, found
+}
+
+// Max returns the largest element according to less, or false if the queue is empty.
+func (q *Queue[T]) Max(less func(T, T) bool) (T, bool) {
+	var result T
+	found := false
+	q.ForEach(func(element T) {
+		if !found || less(result, element) {
+			result, found = element, true
+		}
+	})
+	return result, found
+}
+
 // Filter returns a new queue containing elements that satisfy the predicate.
 func (q *Queue[T]) Filter(predicate func(T) bool) *Queue[T] {
 	result := NewQueue[T]()
+	result.equals = q.equals
 	for _, item := range q.data {
 		if predicate(item) {
 			result.Enqueue(item)
@@ -119,6 +216,7 @@ func (q *Queue[T]) Filter(predicate func(T) bool) *Queue[T] {
 // Map applies a transformation function to each element and returns a new queue.
 func (q *Queue[T]) Map(transform func(T) T) *Queue[T] {
 	result := NewQueue[T]()
+	result.equals = q.equals
 	for _, item := range q.data {
 		result.Enqueue(transform(item))
 	}
@@ -128,10 +226,22 @@ func (q *Queue[T]) Map(transform func(T) T) *Queue[T] {
 // Clone creates a deep copy of the queue.
 func (q *Queue[T]) Clone() *Queue[T] {
 	result := NewQueueWithCapacity[T](len(q.data))
+	result.equals = q.equals
 	result.EnqueueAll(q.data)
 	return result
 }
 
+// CloneWith creates a copy of the queue whose elements are copied by cloneElem, for a deep
+// copy of queues of pointers or slices.
+func (q *Queue[T]) CloneWith(cloneElem func(T) T) *Queue[T] {
+	result := NewQueueWithCapacity[T](len(q.data))
+	result.equals = q.equals
+	for _, item := range q.data {
+		result.data = append(result.data, cloneElem(item))
+	}
+	return result
+}
+
 // Equals checks if two queues contain the same elements in the same order.
 func (q *Queue[T]) Equals(other *Queue[T]) bool {
 	if q.Size() != other.Size() {
@@ -139,7 +249,7 @@ func (q *Queue[T]) Equals(other *Queue[T]) bool {
 	}
 
 	for i, item := range q.data {
-		if fmt.Sprintf("%v", item) != fmt.Sprintf("%v", other.data[i]) {
+		if !elementsEqual(q.equals, item, other.data[i]) {
 			return false
 		}
 	}
@@ -192,7 +302,7 @@ func (q *Queue[T]) InsertAt(index int, item T) bool {
 // Contains checks if the queue contains an element.
 func (q *Queue[T]) Contains(item T) bool {
 	for _, element := range q.data {
-		if fmt.Sprintf("%v", element) == fmt.Sprintf("%v", item) {
+		if elementsEqual(q.equals, element, item) {
 			return true
 		}
 	}
@@ -202,7 +312,7 @@ func (q *Queue[T]) Contains(item T) bool {
 // IndexOf returns the index of the first occurrence of an element.
 func (q *Queue[T]) IndexOf(item T) int {
 	for i, element := range q.data {
-		if fmt.Sprintf("%v", element) == fmt.Sprintf("%v", item) {
+		if elementsEqual(q.equals, element, item) {
 			return i
 		}
 	}
@@ -212,7 +322,7 @@ func (q *Queue[T]) IndexOf(item T) int {
 // LastIndexOf returns the index of the last occurrence of an element.
 func (q *Queue[T]) LastIndexOf(item T) int {
 	for i := len(q.data) - 1; i >= 0; i-- {
-		if fmt.Sprintf("%v", q.data[i]) == fmt.Sprintf("%v", item) {
+		if elementsEqual(q.equals, q.data[i], item) {
 			return i
 		}
 	}
@@ -232,7 +342,7 @@ func (q *Queue[T]) Remove(item T) bool {
 func (q *Queue[T]) RemoveAll(item T) int {
 	count := 0
 	for i := len(q.data) - 1; i >= 0; i-- {
-		if fmt.Sprintf("%v", q.data[i]) == fmt.Sprintf("%v", item) {
+		if elementsEqual(q.equals, q.data[i], item) {
 			q.RemoveAt(i)
 			count++
 		}
@@ -318,16 +428,31 @@ func (q *Queue[T]) TrimToSize() {
 type PriorityQueue[T any] struct {
 	less func(T, T) bool
 	data []T
+	// seqs holds insertion sequence numbers parallel to data in stable mode and is nil
+	// otherwise
+	seqs    []uint64
+	nextSeq uint64
 }
 
-// NewPriorityQueue creates a new priority queue with a custom comparator.
-func NewPriorityQueue[T any](less func(T, T) bool) *PriorityQueue[T] {
+// NewPriorityQueue creates a new priority queue with a custom comparator. It honors
+// WithCapacity.
+func NewPriorityQueue[T any](less func(T, T) bool, opts ...Option) *PriorityQueue[T] {
 	return &PriorityQueue[T]{
-		data: make([]T, 0),
+		data: make([]T, 0, applyOptions(opts).capacity),
 		less: less,
 	}
 }
 
+// NewMinPriorityQueue creates a new priority queue that dequeues the smallest element first.
+func NewMinPriorityQueue[T cmp.Ordered]() *PriorityQueue[T] {
+	return NewPriorityQueue(cmp.Less[T])
+}
+
+// NewMaxPriorityQueue creates a new priority queue that dequeues the largest element first.
+func NewMaxPriorityQueue[T cmp.Ordered]() *PriorityQueue[T] {
+	return NewPriorityQueue(func(a, b T) bool { return cmp.Less(b, a) })
+}
+
 // NewPriorityQueueWithCapacity creates a new priority queue with initial capacity.
 func NewPriorityQueueWithCapacity[T any](capacity int, less func(T, T) bool) *PriorityQueue[T] {
 	return &PriorityQueue[T]{
@@ -336,9 +461,29 @@ func NewPriorityQueueWithCapacity[T any](capacity int, less func(T, T) bool) *Pr
 	}
 }
 
+// NewStablePriorityQueue creates a priority queue in which elements of equal priority are
+// dequeued in insertion order, as schedulers usually expect. Each element carries a sequence
+// number to break ties.
+func NewStablePriorityQueue[T any](less func(T, T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{
+		data: make([]T, 0),
+		less: less,
+		seqs: make([]uint64, 0),
+	}
+}
+
+// IsStable returns true if equal-priority elements dequeue in insertion order.
+func (pq *PriorityQueue[T]) IsStable() bool {
+	return pq.seqs != nil
+}
+
 // Enqueue adds an element to the priority queue.
 func (pq *PriorityQueue[T]) Enqueue(item T) {
 	pq.data = append(pq.data, item)
+	if pq.seqs != nil {
+		pq.seqs = append(pq.seqs, pq.nextSeq)
+		pq.nextSeq++
+	}
 	pq.up(len(pq.data) - 1)
 }
 
@@ -350,8 +495,7 @@ func (pq *PriorityQueue[T]) Dequeue() (T, bool) {
 	}
 
 	item := pq.data[0]
-	pq.data[0] = pq.data[len(pq.data)-1]
-	pq.data = pq.data[:len(pq.data)-1]
+	pq.removeLast(0)
 
 	if len(pq.data) > 0 {
 		pq.down(0)
@@ -369,6 +513,26 @@ func (pq *PriorityQueue[T]) Peek() (T, bool) {
 	return pq.data[0], true
 }
 
+// DequeueE is like Dequeue but returns ErrEmpty if the priority queue is empty.
+func (pq *PriorityQueue[T]) DequeueE() (T, error) {
+	return orErrEmpty(pq.Dequeue())
+}
+
+// MustDequeue is like Dequeue but panics if the priority queue is empty.
+func (pq *PriorityQueue[T]) MustDequeue() T {
+	return must(pq.DequeueE())
+}
+
+// PeekE is like Peek but returns ErrEmpty if the priority queue is empty.
+func (pq *PriorityQueue[T]) PeekE() (T, error) {
+	return orErrEmpty(pq.Peek())
+}
+
+// MustPeek is like Peek but panics if the priority queue is empty.
+func (pq *PriorityQueue[T]) MustPeek() T {
+	return must(pq.PeekE())
+}
+
 // Size returns the number of elements in the priority queue.
 func (pq *PriorityQueue[T]) Size() int {
 	return len(pq.data)
@@ -382,15 +546,58 @@ func (pq *PriorityQueue[T]) IsEmpty() bool {
 // Clear removes all elements from the priority queue.
 func (pq *PriorityQueue[T]) Clear() {
 	pq.data = pq.data[:0]
+	if pq.seqs != nil {
+		pq.seqs = pq.seqs[:0]
+	}
 }
 
-// ToSlice returns a copy of the priority queue as a slice.
+// ToSlice returns a copy of the priority queue as a slice in internal heap order; use
+// SortedSlice for priority order.
 func (pq *PriorityQueue[T]) ToSlice() []T {
-	result := make([]T, len(pq.data))
-	copy(result, pq.data)
+	return pq.AppendTo(make([]T, 0, len(pq.data)))
+}
+
+// AppendTo appends the elements in internal heap order to dst and returns the extended slice.
+func (pq *PriorityQueue[T]) AppendTo(dst []T) []T {
+	return append(dst, pq.data...)
+}
+
+// SortedSlice returns the elements in the order Dequeue would return them, leaving the
+// queue unchanged. ToSlice returns the raw heap order instead.
+func (pq *PriorityQueue[T]) SortedSlice() []T {
+	result := make([]T, 0, len(pq.data))
+	for item := range pq.Sorted() {
+		result = append(result, item)
+	}
 	return result
 }
 
+// Sorted returns an iterator over the elements in priority order that does not modify the
+// queue. It walks the heap with a frontier of candidate positions, so stopping after the
+// first k elements costs O(k log k). The queue must not be modified during iteration.
+func (pq *PriorityQueue[T]) Sorted() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if len(pq.data) == 0 {
+			return
+		}
+
+		frontier := NewPriorityQueue[int](pq.before)
+		frontier.Enqueue(0)
+		for !frontier.IsEmpty() {
+			i, _ := frontier.Dequeue()
+			if !yield(pq.data[i]) {
+				return
+			}
+			if left := 2*i + 1; left < len(pq.data) {
+				frontier.Enqueue(left)
+			}
+			if right := 2*i + 2; right < len(pq.data) {
+				frontier.Enqueue(right)
+			}
+		}
+	}
+}
+
 // String returns a string representation of the priority queue.
 func (pq *PriorityQueue[T]) String() string {
 	return fmt.Sprintf("PriorityQueue%v", pq.data)
@@ -400,10 +607,10 @@ func (pq *PriorityQueue[T]) String() string {
 func (pq *PriorityQueue[T]) up(index int) {
 	for index > 0 {
 		parent := (index - 1) / 2
-		if !pq.less(pq.data[index], pq.data[parent]) {
+		if !pq.before(index, parent) {
 			break
 		}
-		pq.data[index], pq.data[parent] = pq.data[parent], pq.data[index]
+		pq.swap(index, parent)
 		index = parent
 	}
 }
@@ -415,11 +622,11 @@ func (pq *PriorityQueue[T]) down(index int) {
 		right := 2*index + 2
 		smallest := index
 
-		if left < len(pq.data) && pq.less(pq.data[left], pq.data[smallest]) {
+		if left < len(pq.data) && pq.before(left, smallest) {
 			smallest = left
 		}
 
-		if right < len(pq.data) && pq.less(pq.data[right], pq.data[smallest]) {
+		if right < len(pq.data) && pq.before(right, smallest) {
 			smallest = right
 		}
 
@@ -427,15 +634,100 @@ func (pq *PriorityQueue[T]) down(index int) {
 			break
 		}
 
-		pq.data[index], pq.data[smallest] = pq.data[smallest], pq.data[index]
+		pq.swap(index, smallest)
 		index = smallest
 	}
 }
 
+// before reports whether the element at i has priority over the element at j, breaking ties
+// by insertion order in stable mode.
+func (pq *PriorityQueue[T]) before(i, j int) bool {
+	if pq.less(pq.data[i], pq.data[j]) {
+		return true
+	}
+	if pq.seqs == nil || pq.less(pq.data[j], pq.data[i]) {
+		return false
+	}
+	return pq.seqs[i] < pq.seqs[j]
+}
+
+// swap exchanges two heap positions.
+func (pq *PriorityQueue[T]) swap(i, j int) {
+	pq.data[i], pq.data[j] = pq.data[j], pq.data[i]
+	if pq.seqs != nil {
+		pq.seqs[i], pq.seqs[j] = pq.seqs[j], pq.seqs[i]
+	}
+}
+
+// removeLast overwrites position index with the last element and shrinks the heap by one.
+func (pq *PriorityQueue[T]) removeLast(index int) {
+	last := len(pq.data) - 1
+	pq.data[index] = pq.data[last]
+	var zero T
+	pq.data[last] = zero
+	pq.data = pq.data[:last]
+	if pq.seqs != nil {
+		pq.seqs[index] = pq.seqs[last]
+		pq.seqs = pq.seqs[:last]
+	}
+}
+
 // Clone creates a deep copy of the priority queue.
 func (pq *PriorityQueue[T]) Clone() *PriorityQueue[T] {
+	return pq.CloneWith(identity[T])
+}
+
+// CloneWith creates a copy of the priority queue whose elements are copied by cloneElem,
+// which must preserve their priorities.
+func (pq *PriorityQueue[T]) CloneWith(cloneElem func(T) T) *PriorityQueue[T] {
 	result := NewPriorityQueueWithCapacity[T](len(pq.data), pq.less)
-	result.data = make([]T, len(pq.data))
-	copy(result.data, pq.data)
+	for _, item := range pq.data {
+		result.data = append(result.data, cloneElem(item))
+	}
+	if pq.seqs != nil {
+		result.seqs = append(make([]uint64, 0, len(pq.seqs)), pq.seqs...)
+		result.nextSeq = pq.nextSeq
+	}
 	return result
 }
+
+// Contains checks if the priority queue holds an element equal to item according to equals.
+func (pq *PriorityQueue[T]) Contains(item T, equals func(T, T) bool) bool {
+	for _, element := range pq.data {
+		if equals(element, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remove removes and returns the first element, in heap order, that satisfies the predicate.
+// It returns false if no element matches.
+func (pq *PriorityQueue[T]) Remove(predicate func(T) bool) (T, bool) {
+	for i, element := range pq.data {
+		if predicate(element) {
+			return pq.RemoveAt(i)
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// RemoveAt removes and returns the element at the given position of the heap array, as seen
+// in ToSlice, and restores the heap property.
+func (pq *PriorityQueue[T]) RemoveAt(index int) (T, bool) {
+	if index < 0 || index >= len(pq.data) {
+		var zero T
+		return zero, false
+	}
+
+	item := pq.data[index]
+	pq.removeLast(index)
+
+	// The moved element may belong above or below its new position
+	if index < len(pq.data) {
+		pq.down(index)
+		pq.up(index)
+	}
+	return item, true
+}
@@ -113,3 +113,148 @@ func TestQueueContains(t *testing.T) {
 		t.Error("Queue should not contain element 4")
 	}
 }
+
+func TestPriorityQueueRemove(t *testing.T) {
+	pq := NewPriorityQueue[int](func(a, b int) bool { return a < b })
+	for _, value := range []int{9, 4, 7, 1, 8, 2, 6, 3, 5} {
+		pq.Enqueue(value)
+	}
+	equals := func(a, b int) bool { return a == b }
+
+	if !pq.Contains(7, equals) || pq.Contains(10, equals) {
+		t.Error("Contains returned an unexpected result")
+	}
+	if value, ok := pq.Remove(func(x int) bool { return x == 7 }); !ok || value != 7 {
+		t.Errorf("Expected to remove 7, got %d", value)
+	}
+	if _, ok := pq.Remove(func(x int) bool { return x > 100 }); ok {
+		t.Error("Remove without a match should fail")
+	}
+	if value, ok := pq.RemoveAt(0); !ok || value != 1 {
+		t.Errorf("Expected to remove the root 1, got %d", value)
+	}
+	if _, ok := pq.RemoveAt(pq.Size()); ok {
+		t.Error("RemoveAt out of range should fail")
+	}
+
+	expected := []int{2, 3, 4, 5, 6, 8, 9}
+	for _, want := range expected {
+		if value, _ := pq.Dequeue(); value != want {
+			t.Fatalf("Expected %d, got %d", want, value)
+		}
+	}
+}
+
+func TestStablePriorityQueue(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	pq := NewStablePriorityQueue(func(a, b task) bool { return a.priority < b.priority })
+	if !pq.IsStable() || NewPriorityQueue(lessInt).IsStable() {
+		t.Error("IsStable returned an unexpected result")
+	}
+
+	for i, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		pq.Enqueue(task{name, i % 2})
+	}
+	if removed, _ := pq.Remove(func(x task) bool { return x.name == "c" }); removed.name != "c" {
+		t.Errorf("Expected to remove c, got %s", removed.name)
+	}
+
+	// The clone keeps the sequence numbers, so later additions still queue behind ties
+	clone := pq.Clone()
+	clone.Enqueue(task{"z", 0})
+
+	check := func(q *PriorityQueue[task], expected []string) {
+		t.Helper()
+		for _, want := range expected {
+			if item, _ := q.Dequeue(); item.name != want {
+				t.Fatalf("Expected %s, got %s", want, item.name)
+			}
+		}
+	}
+	check(pq, []string{"a", "e", "g", "b", "d", "f", "h"})
+	check(clone, []string{"a", "e", "g", "z", "b", "d", "f", "h"})
+}
+
+func TestPriorityQueueSortedIteration(t *testing.T) {
+	pq := NewPriorityQueue[int](func(a, b int) bool { return a < b })
+	for _, value := range []int{5, 2, 8, 1, 9, 3, 3} {
+		pq.Enqueue(value)
+	}
+	before := pq.ToSlice()
+
+	sorted := pq.SortedSlice()
+	expected := []int{1, 2, 3, 3, 5, 8, 9}
+	for i := range expected {
+		if sorted[i] != expected[i] {
+			t.Fatalf("Expected %v, got %v", expected, sorted)
+		}
+	}
+
+	var firstThree []int
+	for value := range pq.Sorted() {
+		if len(firstThree) == 3 {
+			break
+		}
+		firstThree = append(firstThree, value)
+	}
+	if len(firstThree) != 3 || firstThree[2] != 3 {
+		t.Errorf("Expected [1 2 3], got %v", firstThree)
+	}
+
+	after := pq.ToSlice()
+	for i := range before {
+		if before[i] != after[i] {
+			t.Fatal("Sorted iteration should not modify the queue")
+		}
+	}
+}
+
+func TestQueueAll(t *testing.T) {
+	queue := NewQueue[int]()
+	queue.EnqueueAll([]int{1, 2, 3})
+
+	var got []int
+	for x := range queue.All() {
+		got = append(got, x)
+		if x == 2 {
+			break
+		}
+	}
+	if len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("Expected iteration to stop at 2, got %v", got)
+	}
+}
+
+func TestQueueAggregations(t *testing.T) {
+	queue := NewQueue[string]()
+	queue.EnqueueAll([]string{"b", "c", "a"})
+	if joined := queue.Reduce("", func(a, b string) string { return a + b }); joined != "bca" {
+		t.Errorf("Expected front-to-back fold bca, got %s", joined)
+	}
+	if smallest, ok := queue.Min(func(a, b string) bool { return a < b }); !ok || smallest != "a" {
+		t.Errorf("Expected min a, got %s", smallest)
+	}
+	if count := queue.CountIf(func(s string) bool { return s != "c" }); count != 2 {
+		t.Errorf("Expected 2, got %d", count)
+	}
+}
+
+func TestOrderedPriorityQueues(t *testing.T) {
+	minPQ := NewMinPriorityQueue[string]()
+	maxPQ := NewMaxPriorityQueue[float64]()
+	for _, word := range []string{"pear", "apple", "fig"} {
+		minPQ.Enqueue(word)
+	}
+	for _, value := range []float64{2.5, -1, 7} {
+		maxPQ.Enqueue(value)
+	}
+	if first, _ := minPQ.Dequeue(); first != "apple" {
+		t.Errorf("Expected apple first from the min queue, got %s", first)
+	}
+	if first, _ := maxPQ.Dequeue(); first != 7 {
+		t.Errorf("Expected 7 first from the max queue, got %v", first)
+	}
+}
@@ -0,0 +1,275 @@
+package stl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// radixNode represents a node in a RadixTree. Each node owns the edge label leading to it.
+type radixNode[V any] struct {
+	prefix   string
+	children []*radixNode[V]
+	value    V
+	hasValue bool
+}
+
+// childIndex returns the position of the child whose label starts with b, or where it would be inserted.
+func (n *radixNode[V]) childIndex(b byte) (int, bool) {
+	i := sort.Search(len(n.children), func(k int) bool {
+		return n.children[k].prefix[0] >= b
+	})
+	return i, i < len(n.children) && n.children[i].prefix[0] == b
+}
+
+// mergeChild absorbs the only child of a valueless node into it.
+func (n *radixNode[V]) mergeChild() {
+	child := n.children[0]
+	n.prefix += child.prefix
+	n.children = child.children
+	n.value, n.hasValue = child.value, child.hasValue
+}
+
+// RadixTree represents a path-compressed prefix tree mapping string keys to values.
+// Chains of single-child nodes are collapsed into one edge, so it uses far fewer nodes
+// than Trie. Keys are compared byte by byte; convert []byte keys with string(key).
+type RadixTree[V any] struct {
+	root *radixNode[V]
+	size int
+}
+
+// NewRadixTree creates a new empty radix tree.
+func NewRadixTree[V any]() *RadixTree[V] {
+	return &RadixTree[V]{root: &radixNode[V]{}}
+}
+
+// commonPrefixLength returns the length of the longest common prefix of a and b.
+func commonPrefixLength(a, b string) int {
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	return n
+}
+
+// Insert associates a value with a key and reports whether the key was new.
+func (rt *RadixTree[V]) Insert(key string, value V) bool {
+	node := rt.root
+	for key != "" {
+		i, found := node.childIndex(key[0])
+		if !found {
+			leaf := &radixNode[V]{prefix: key, value: value, hasValue: true}
+			node.children = append(node.children, nil)
+			copy(node.children[i+1:], node.children[i:])
+			node.children[i] = leaf
+			rt.size++
+			return true
+		}
+
+		child := node.children[i]
+		l := commonPrefixLength(child.prefix, key)
+		if l < len(child.prefix) {
+			// Split the edge at the point where key diverges
+			mid := &radixNode[V]{prefix: child.prefix[:l], children: []*radixNode[V]{child}}
+			child.prefix = child.prefix[l:]
+			node.children[i] = mid
+			child = mid
+		}
+		node = child
+		key = key[l:]
+	}
+
+	isNew := !node.hasValue
+	node.value, node.hasValue = value, true
+	if isNew {
+		rt.size++
+	}
+	return isNew
+}
+
+// find returns the node for key, if the key ends exactly on a node.
+func (rt *RadixTree[V]) find(key string) *radixNode[V] {
+	node := rt.root
+	for key != "" {
+		i, found := node.childIndex(key[0])
+		if !found || !strings.HasPrefix(key, node.children[i].prefix) {
+			return nil
+		}
+		node = node.children[i]
+		key = key[len(node.prefix):]
+	}
+	return node
+}
+
+// Get returns the value associated with a key.
+func (rt *RadixTree[V]) Get(key string) (V, bool) {
+	if node := rt.find(key); node != nil && node.hasValue {
+		return node.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains checks if a key exists in the tree.
+func (rt *RadixTree[V]) Contains(key string) bool {
+	node := rt.find(key)
+	return node != nil && node.hasValue
+}
+
+// Delete removes a key from the tree and reports whether it was present.
+func (rt *RadixTree[V]) Delete(key string) bool {
+	var parent *radixNode[V]
+	node := rt.root
+	index := -1
+	for key != "" {
+		i, found := node.childIndex(key[0])
+		if !found || !strings.HasPrefix(key, node.children[i].prefix) {
+			return false
+		}
+		parent, index = node, i
+		node = node.children[i]
+		key = key[len(node.prefix):]
+	}
+	if !node.hasValue {
+		return false
+	}
+
+	var zero V
+	node.value, node.hasValue = zero, false
+	rt.size--
+
+	// Restore path compression around the removed key
+	switch {
+	case parent == nil:
+	case len(node.children) == 0:
+		parent.children = append(parent.children[:index], parent.children[index+1:]...)
+		if parent != rt.root && !parent.hasValue && len(parent.children) == 1 {
+			parent.mergeChild()
+		}
+	case len(node.children) == 1:
+		node.mergeChild()
+	}
+	return true
+}
+
+// LongestPrefixMatch returns the longest key in the tree that is a prefix of s, with its value.
+func (rt *RadixTree[V]) LongestPrefixMatch(s string) (string, V, bool) {
+	var value V
+	matched, found := 0, false
+	if rt.root.hasValue {
+		value, found = rt.root.value, true
+	}
+
+	node := rt.root
+	consumed := 0
+	for consumed < len(s) {
+		i, ok := node.childIndex(s[consumed])
+		if !ok || !strings.HasPrefix(s[consumed:], node.children[i].prefix) {
+			break
+		}
+		node = node.children[i]
+		consumed += len(node.prefix)
+		if node.hasValue {
+			value, matched, found = node.value, consumed, true
+		}
+	}
+
+	if !found {
+		return "", value, false
+	}
+	return s[:matched], value, true
+}
+
+// WalkPrefix calls fn for every key starting with prefix, in lexicographic byte order,
+// until fn returns false.
+func (rt *RadixTree[V]) WalkPrefix(prefix string, fn func(key string, value V) bool) {
+	node := rt.root
+	path := ""
+	for prefix != "" {
+		i, found := node.childIndex(prefix[0])
+		if !found {
+			return
+		}
+		child := node.children[i]
+		l := commonPrefixLength(child.prefix, prefix)
+		if l < len(prefix) && l < len(child.prefix) {
+			return
+		}
+		node = child
+		path += child.prefix
+		prefix = prefix[l:]
+	}
+	rt.walk(node, path, fn)
+}
+
+// walk visits the keys below node in order and reports whether to continue.
+func (rt *RadixTree[V]) walk(node *radixNode[V], key string, fn func(string, V) bool) bool {
+	if node.hasValue && !fn(key, node.value) {
+		return false
+	}
+	for _, child := range node.children {
+		if !rt.walk(child, key+child.prefix, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// KeysWithPrefix returns all keys starting with prefix in lexicographic byte order.
+func (rt *RadixTree[V]) KeysWithPrefix(prefix string) []string {
+	keys := []string{}
+	rt.WalkPrefix(prefix, func(key string, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Keys returns all keys in lexicographic byte order.
+func (rt *RadixTree[V]) Keys() []string {
+	return rt.KeysWithPrefix("")
+}
+
+// ForEach applies a function to each key-value pair in lexicographic byte order.
+func (rt *RadixTree[V]) ForEach(fn func(string, V)) {
+	rt.walk(rt.root, "", func(key string, value V) bool {
+		fn(key, value)
+		return true
+	})
+}
+
+// Size returns the number of keys in the tree.
+func (rt *RadixTree[V]) Size() int {
+	return rt.size
+}
+
+// IsEmpty checks if the tree is empty.
+func (rt *RadixTree[V]) IsEmpty() bool {
+	return rt.size == 0
+}
+
+// Clear removes all keys from the tree.
+func (rt *RadixTree[V]) Clear() {
+	rt.root = &radixNode[V]{}
+	rt.size = 0
+}
+
+// NodeCount returns the number of nodes in the tree, including the root.
+func (rt *RadixTree[V]) NodeCount() int {
+	count := 0
+	stack := []*radixNode[V]{rt.root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		count++
+		stack = append(stack, node.children...)
+	}
+	return count
+}
+
+// String returns a string representation of the tree.
+func (rt *RadixTree[V]) String() string {
+	return fmt.Sprintf("RadixTree%v", rt.Keys())
+}
@@ -0,0 +1,127 @@
+package stl
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestRadixTreeBasicOperations(t *testing.T) {
+	rt := NewRadixTree[int]()
+	words := []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus"}
+	for i, word := range words {
+		if !rt.Insert(word, i) {
+			t.Errorf("Expected %q to be new", word)
+		}
+	}
+	if rt.Insert("ruber", 42) {
+		t.Error("Expected re-insert to report an existing key")
+	}
+
+	if rt.Size() != len(words) {
+		t.Errorf("Expected size %d, got %d", len(words), rt.Size())
+	}
+	if value, ok := rt.Get("ruber"); !ok || value != 42 {
+		t.Errorf("Expected 42, got %d", value)
+	}
+	if rt.Contains("rom") || rt.Contains("romanes") {
+		t.Error("Contains returned an unexpected result")
+	}
+
+	expected := []string{"rubens", "ruber", "rubicon", "rubicundus"}
+	if keys := rt.KeysWithPrefix("rub"); len(keys) != 4 || keys[0] != expected[0] || keys[3] != expected[3] {
+		t.Errorf("Expected %v, got %v", expected, keys)
+	}
+	if keys := rt.KeysWithPrefix("rubic"); len(keys) != 2 {
+		t.Errorf("Expected 2 keys, got %v", keys)
+	}
+	if keys := rt.KeysWithPrefix("x"); len(keys) != 0 {
+		t.Errorf("Expected no keys, got %v", keys)
+	}
+
+	// Seven keys sharing prefixes need far fewer nodes than one per byte
+	if nodes := rt.NodeCount(); nodes > 2*len(words) {
+		t.Errorf("Expected a compressed tree, got %d nodes", nodes)
+	}
+
+	if !rt.Delete("romane") || rt.Delete("romane") || rt.Delete("rom") {
+		t.Error("Expected Delete to succeed once")
+	}
+	if !rt.Contains("romanus") || rt.Size() != len(words)-1 {
+		t.Error("Delete removed the wrong key")
+	}
+
+	rt.Clear()
+	if !rt.IsEmpty() || rt.NodeCount() != 1 {
+		t.Error("Tree should be empty after Clear")
+	}
+}
+
+func TestRadixTreeLongestPrefixMatch(t *testing.T) {
+	routes := NewRadixTree[string]()
+	routes.Insert("/", "root")
+	routes.Insert("/api", "api")
+	routes.Insert("/api/users", "users")
+
+	cases := map[string]string{
+		"/api/users/42": "users",
+		"/api/items":    "api",
+		"/static/x.css": "root",
+	}
+	for path, expected := range cases {
+		if _, value, ok := routes.LongestPrefixMatch(path); !ok || value != expected {
+			t.Errorf("Expected %q for %q, got %q", expected, path, value)
+		}
+	}
+	if key, _, _ := routes.LongestPrefixMatch("/api/use"); key != "/api" {
+		t.Errorf("Expected key /api, got %q", key)
+	}
+	if _, _, ok := routes.LongestPrefixMatch("api"); ok {
+		t.Error("Expected no match without a leading slash")
+	}
+}
+
+func TestRadixTreeMatchesMap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	rt := NewRadixTree[int]()
+	expected := map[string]int{}
+	randomKey := func() string {
+		b := make([]byte, r.Intn(6))
+		for i := range b {
+			b[i] = byte('a' + r.Intn(3))
+		}
+		return string(b)
+	}
+
+	for i := 0; i < 2000; i++ {
+		key := randomKey()
+		if r.Intn(3) == 0 {
+			_, present := expected[key]
+			if rt.Delete(key) != present {
+				t.Fatalf("Delete(%q) disagreed with map", key)
+			}
+			delete(expected, key)
+		} else {
+			rt.Insert(key, i)
+			expected[key] = i
+		}
+	}
+
+	keys := make([]string, 0, len(expected))
+	for key, value := range expected {
+		keys = append(keys, key)
+		if got, ok := rt.Get(key); !ok || got != value {
+			t.Errorf("Expected %d for %q, got %d", value, key, got)
+		}
+	}
+	sort.Strings(keys)
+	actual := rt.Keys()
+	if len(actual) != len(keys) || rt.Size() != len(keys) {
+		t.Fatalf("Expected %d keys, got %d", len(keys), len(actual))
+	}
+	for i := range keys {
+		if actual[i] != keys[i] {
+			t.Fatalf("Expected %v, got %v", keys, actual)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package stl
+
+import (
+	"cmp"
+	"fmt"
+	"sort"
+)
+
+// Interval represents the half-open range [Start, End).
+type Interval[T cmp.Ordered] struct {
+	Start T
+	End   T
+}
+
+// IsEmpty checks if the interval contains no values.
+func (iv Interval[T]) IsEmpty() bool {
+	return !(iv.Start < iv.End)
+}
+
+// Contains checks if a value lies within the interval.
+func (iv Interval[T]) Contains(value T) bool {
+	return iv.Start <= value && value < iv.End
+}
+
+// String returns a string representation of the interval.
+func (iv Interval[T]) String() string {
+	return fmt.Sprintf("[%v, %v)", iv.Start, iv.End)
+}
+
+// RangeSet represents a set of values stored as sorted, disjoint, half-open intervals.
+// Overlapping and adjacent intervals are coalesced on Add and split on Remove.
+type RangeSet[T cmp.Ordered] struct {
+	ranges []Interval[T]
+}
+
+// NewRangeSet creates a new empty range set.
+func NewRangeSet[T cmp.Ordered]() *RangeSet[T] {
+	return &RangeSet[T]{}
+}
+
+// NewRangeSetFromIntervals creates a range set covering the union of the given intervals.
+func NewRangeSetFromIntervals[T cmp.Ordered](intervals []Interval[T]) *RangeSet[T] {
+	rs := NewRangeSet[T]()
+	for _, iv := range intervals {
+		rs.Add(iv.Start, iv.End)
+	}
+	return rs
+}
+
+// firstEndAfter returns the index of the first interval whose end is greater than value.
+func (rs *RangeSet[T]) firstEndAfter(value T) int {
+	return sort.Search(len(rs.ranges), func(i int) bool {
+		return rs.ranges[i].End > value
+	})
+}
+
+// splice replaces the intervals in [i, j) with replacement.
+func (rs *RangeSet[T]) splice(i, j int, replacement ...Interval[T]) {
+	tail := append(replacement, rs.ranges[j:]...)
+	rs.ranges = append(rs.ranges[:i], tail...)
+}
+
+// Add adds the values in [start, end), merging with any overlapping or adjacent intervals.
+// Empty intervals are ignored.
+func (rs *RangeSet[T]) Add(start, end T) {
+	if !(start < end) {
+		return
+	}
+
+	// Intervals ending exactly at start are adjacent and merge as well
+	i := sort.Search(len(rs.ranges), func(k int) bool {
+		return rs.ranges[k].End >= start
+	})
+	j := sort.Search(len(rs.ranges), func(k int) bool {
+		return rs.ranges[k].Start > end
+	})
+
+	merged := Interval[T]{Start: start, End: end}
+	if i < j {
+		merged.Start = min(merged.Start, rs.ranges[i].Start)
+		merged.End = max(merged.End, rs.ranges[j-1].End)
+	}
+	rs.splice(i, j, merged)
+}
+
+// Remove removes the values in [start, end), splitting intervals that straddle either bound.
+func (rs *RangeSet[T]) Remove(start, end T) {
+	if !(start < end) {
+		return
+	}
+
+	i := rs.firstEndAfter(start)
+	j := sort.Search(len(rs.ranges), func(k int) bool {
+		return rs.ranges[k].Start >= end
+	})
+	if i >= j {
+		return
+	}
+
+	var remainder []Interval[T]
+	if first := rs.ranges[i]; first.Start < start {
+		remainder = append(remainder, Interval[T]{Start: first.Start, End: start})
+	}
+	if last := rs.ranges[j-1]; last.End > end {
+		remainder = append(remainder, Interval[T]{Start: end, End: last.End})
+	}
+	rs.splice(i, j, remainder...)
+}
+
+// Contains checks if a value is covered by the set.
+func (rs *RangeSet[T]) Contains(value T) bool {
+	i := rs.firstEndAfter(value)
+	return i < len(rs.ranges) && rs.ranges[i].Start <= value
+}
+
+// ContainsRange checks if every value in [start, end) is covered by the set.
+func (rs *RangeSet[T]) ContainsRange(start, end T) bool {
+	if !(start < end) {
+		return true
+	}
+	i := rs.firstEndAfter(start)
+	return i < len(rs.ranges) && rs.ranges[i].Start <= start && rs.ranges[i].End >= end
+}
+
+// Overlaps checks if any value in [start, end) is covered by the set.
+func (rs *RangeSet[T]) Overlaps(start, end T) bool {
+	if !(start < end) {
+		return false
+	}
+	i := rs.firstEndAfter(start)
+	return i < len(rs.ranges) && rs.ranges[i].Start < end
+}
+
+// Intervals returns the disjoint intervals of the set in ascending order.
+func (rs *RangeSet[T]) Intervals() []Interval[T] {
+	result := make([]Interval[T], len(rs.ranges))
+	copy(result, rs.ranges)
+	return result
+}
+
+// Bounds returns the smallest interval covering the whole set.
+func (rs *RangeSet[T]) Bounds() (Interval[T], bool) {
+	if len(rs.ranges) == 0 {
+		return Interval[T]{}, false
+	}
+	return Interval[T]{Start: rs.ranges[0].Start, End: rs.ranges[len(rs.ranges)-1].End}, true
+}
+
+// Size returns the number of disjoint intervals in the set.
+func (rs *RangeSet[T]) Size() int {
+	return len(rs.ranges)
+}
+
+// IsEmpty checks if the set covers no values.
+func (rs *RangeSet[T]) IsEmpty() bool {
+	return len(rs.ranges) == 0
+}
+
+// Clear removes all intervals from the set.
+func (rs *RangeSet[T]) Clear() {
+	rs.ranges = nil
+}
+
+// Complement returns the values in [start, end) that are not covered by the set.
+func (rs *RangeSet[T]) Complement(start, end T) *RangeSet[T] {
+	result := NewRangeSet[T]()
+	if !(start < end) {
+		return result
+	}
+
+	cursor := start
+	for i := rs.firstEndAfter(start); i < len(rs.ranges) && rs.ranges[i].Start < end; i++ {
+		if cursor < rs.ranges[i].Start {
+			result.ranges = append(result.ranges, Interval[T]{Start: cursor, End: rs.ranges[i].Start})
+		}
+		cursor = rs.ranges[i].End
+	}
+	if cursor < end {
+		result.ranges = append(result.ranges, Interval[T]{Start: cursor, End: end})
+	}
+	return result
+}
+
+// Union returns a new set covering the values of both sets.
+func (rs *RangeSet[T]) Union(other *RangeSet[T]) *RangeSet[T] {
+	result := NewRangeSet[T]()
+	i, j := 0, 0
+	for i < len(rs.ranges) || j < len(other.ranges) {
+		var next Interval[T]
+		if j >= len(other.ranges) || (i < len(rs.ranges) && rs.ranges[i].Start <= other.ranges[j].Start) {
+			next = rs.ranges[i]
+			i++
+		} else {
+			next = other.ranges[j]
+			j++
+		}
+
+		// Inputs arrive ordered by start, so only the last result interval can absorb next
+		if n := len(result.ranges); n > 0 && next.Start <= result.ranges[n-1].End {
+			result.ranges[n-1].End = max(result.ranges[n-1].End, next.End)
+		} else {
+			result.ranges = append(result.ranges, next)
+		}
+	}
+	return result
+}
+
+// Intersection returns a new set covering the values present in both sets.
+func (rs *RangeSet[T]) Intersection(other *RangeSet[T]) *RangeSet[T] {
+	result := NewRangeSet[T]()
+	i, j := 0, 0
+	for i < len(rs.ranges) && j < len(other.ranges) {
+		a, b := rs.ranges[i], other.ranges[j]
+		start, end := max(a.Start, b.Start), min(a.End, b.End)
+		if start < end {
+			result.ranges = append(result.ranges, Interval[T]{Start: start, End: end})
+		}
+		if a.End < b.End {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
+
+// Difference returns a new set covering the values of this set that are not in other.
+func (rs *RangeSet[T]) Difference(other *RangeSet[T]) *RangeSet[T] {
+	result := rs.Clone()
+	for _, iv := range other.ranges {
+		result.Remove(iv.Start, iv.End)
+	}
+	return result
+}
+
+// Clone creates a copy of the set.
+func (rs *RangeSet[T]) Clone() *RangeSet[T] {
+	return &RangeSet[T]{ranges: rs.Intervals()}
+}
+
+// Equals checks if two sets cover exactly the same values.
+func (rs *RangeSet[T]) Equals(other *RangeSet[T]) bool {
+	if len(rs.ranges) != len(other.ranges) {
+		return false
+	}
+	for i := range rs.ranges {
+		if rs.ranges[i] != other.ranges[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ForEach applies a function to each interval in ascending order.
+func (rs *RangeSet[T]) ForEach(fn func(Interval[T])) {
+	for _, iv := range rs.ranges {
+		fn(iv)
+	}
+}
+
+// String returns a string representation of the set.
+func (rs *RangeSet[T]) String() string {
+	return fmt.Sprintf("RangeSet%v", rs.ranges)
+}
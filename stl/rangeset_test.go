@@ -0,0 +1,111 @@
+package stl
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRangeSetAddCoalesces(t *testing.T) {
+	rs := NewRangeSet[int]()
+	rs.Add(10, 20)
+	rs.Add(30, 40)
+	rs.Add(20, 25) // adjacent to [10, 20)
+	rs.Add(5, 5)   // empty
+
+	if rs.String() != "RangeSet[[10, 25) [30, 40)]" {
+		t.Errorf("Unexpected set %s", rs.String())
+	}
+
+	rs.Add(24, 31)
+	if rs.Size() != 1 || rs.String() != "RangeSet[[10, 40)]" {
+		t.Errorf("Expected a single merged interval, got %s", rs.String())
+	}
+
+	if !rs.Contains(10) || rs.Contains(40) || rs.Contains(9) {
+		t.Error("Contains returned an unexpected result")
+	}
+	if !rs.ContainsRange(15, 40) || rs.ContainsRange(5, 15) {
+		t.Error("ContainsRange returned an unexpected result")
+	}
+	if !rs.Overlaps(39, 50) || rs.Overlaps(40, 50) {
+		t.Error("Overlaps returned an unexpected result")
+	}
+}
+
+func TestRangeSetRemoveSplits(t *testing.T) {
+	rs := NewRangeSetFromIntervals([]Interval[int]{{0, 100}, {200, 300}})
+	rs.Remove(40, 60)
+	rs.Remove(90, 210)
+
+	expected := []Interval[int]{{0, 40}, {60, 90}, {210, 300}}
+	intervals := rs.Intervals()
+	if len(intervals) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, intervals)
+	}
+	for i := range expected {
+		if intervals[i] != expected[i] {
+			t.Errorf("Expected %v at %d, got %v", expected[i], i, intervals[i])
+		}
+	}
+
+	if bounds, _ := rs.Bounds(); bounds != (Interval[int]{0, 300}) {
+		t.Errorf("Expected bounds [0, 300), got %v", bounds)
+	}
+
+	rs.Remove(-10, 1000)
+	if !rs.IsEmpty() {
+		t.Errorf("Expected empty set, got %s", rs.String())
+	}
+}
+
+func TestRangeSetAlgebra(t *testing.T) {
+	a := NewRangeSetFromIntervals([]Interval[int]{{0, 10}, {20, 30}})
+	b := NewRangeSetFromIntervals([]Interval[int]{{5, 25}, {40, 50}})
+
+	if union := a.Union(b); union.String() != "RangeSet[[0, 30) [40, 50)]" {
+		t.Errorf("Unexpected union %s", union.String())
+	}
+	if inter := a.Intersection(b); inter.String() != "RangeSet[[5, 10) [20, 25)]" {
+		t.Errorf("Unexpected intersection %s", inter.String())
+	}
+	if diff := a.Difference(b); diff.String() != "RangeSet[[0, 5) [25, 30)]" {
+		t.Errorf("Unexpected difference %s", diff.String())
+	}
+	if comp := a.Complement(-5, 35); comp.String() != "RangeSet[[-5, 0) [10, 20) [30, 35)]" {
+		t.Errorf("Unexpected complement %s", comp.String())
+	}
+	if !a.Equals(a.Clone()) || a.Equals(b) {
+		t.Error("Equals returned an unexpected result")
+	}
+}
+
+func TestRangeSetMatchesBitmap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	rs := NewRangeSet[int]()
+	var covered [200]bool
+
+	for i := 0; i < 500; i++ {
+		start, end := r.Intn(200), r.Intn(200)
+		add := r.Intn(2) == 0
+		if add {
+			rs.Add(start, end)
+		} else {
+			rs.Remove(start, end)
+		}
+		for v := start; v < end; v++ {
+			covered[v] = add
+		}
+	}
+
+	for v, expected := range covered {
+		if rs.Contains(v) != expected {
+			t.Fatalf("Expected Contains(%d) to be %v", v, expected)
+		}
+	}
+	intervals := rs.Intervals()
+	for i := 1; i < len(intervals); i++ {
+		if intervals[i-1].End >= intervals[i].Start {
+			t.Fatalf("Intervals not disjoint and coalesced: %v", intervals)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package stl
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// OverflowPolicy decides what RingBuffer.Push does when the buffer is full.
+type OverflowPolicy int
+
+const (
+	// OverflowFail rejects the new element and Push returns false.
+	OverflowFail OverflowPolicy = iota
+	// OverflowBlock waits until a Pop or Clear frees a slot.
+	OverflowBlock
+	// OverflowOverwrite discards the oldest element to make room.
+	OverflowOverwrite
+)
+
+// String returns the name of the policy.
+func (p OverflowPolicy) String() string {
+	switch p {
+	case OverflowFail:
+		return "Fail"
+	case OverflowBlock:
+		return "Block"
+	case OverflowOverwrite:
+		return "Overwrite"
+	default:
+		return fmt.Sprintf("OverflowPolicy(%d)", int(p))
+	}
+}
+
+// RingBuffer represents a fixed-capacity FIFO buffer that never grows. What happens when a
+// full buffer receives another element is set by its OverflowPolicy. A RingBuffer is safe
+// for concurrent use, which OverflowBlock requires.
+type RingBuffer[T any] struct {
+	mu      sync.Mutex
+	notFull *sync.Cond
+	data    []T
+	front   int
+	size    int
+	policy  OverflowPolicy
+	dropped int
+	equals  func(T, T) bool
+}
+
+// NewRingBuffer creates a new empty ring buffer. A capacity below 1 is raised to 1. It honors
+// WithEquals to replace the default comparison of elements with reflect.DeepEqual in Contains.
+func NewRingBuffer[T any](capacity int, policy OverflowPolicy, opts ...Option) *RingBuffer[T] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	rb := &RingBuffer[T]{
+		data:   make([]T, capacity),
+		policy: policy,
+		equals: equalsOf[T](applyOptions(opts), nil),
+	}
+	rb.notFull = sync.NewCond(&rb.mu)
+	return rb
+}
+
+// Push adds an element at the back of the buffer and reports whether it was stored.
+// On a full buffer it fails, blocks, or overwrites the oldest element according to the policy.
+func (rb *RingBuffer[T]) Push(element T) bool {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	if rb.size == len(rb.data) {
+		switch rb.policy {
+		case OverflowBlock:
+			for rb.size == len(rb.data) {
+				rb.notFull.Wait()
+			}
+		case OverflowOverwrite:
+			rb.popLocked()
+			rb.dropped++
+		default:
+			return false
+		}
+	}
+
+	rb.data[(rb.front+rb.size)%len(rb.data)] = element
+	rb.size++
+	return true
+}
+
+// Pop removes and returns the oldest element.
+func (rb *RingBuffer[T]) Pop() (T, bool) {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	if rb.size == 0 {
+		var zero T
+		return zero, false
+	}
+	element := rb.popLocked()
+	rb.notFull.Signal()
+	return element, true
+}
+
+// popLocked removes the oldest element of a non-empty buffer. The caller must hold mu.
+func (rb *RingBuffer[T]) popLocked() T {
+	var zero T
+	element := rb.data[rb.front]
+	rb.data[rb.front] = zero // Allow garbage collection
+	rb.front = (rb.front + 1) % len(rb.data)
+	rb.size--
+	return element
+}
+
+// Peek returns the oldest element without removing it.
+func (rb *RingBuffer[T]) Peek() (T, bool) {
+	return rb.At(0)
+}
+
+// PeekBack returns the newest element without removing it.
+func (rb *RingBuffer[T]) PeekBack() (T, bool) {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	return rb.atLocked(rb.size - 1)
+}
+
+// At returns the element at the given position, counting from the oldest.
+func (rb *RingBuffer[T]) At(index int) (T, bool) {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	return rb.atLocked(index)
+}
+
+// atLocked returns the element at the given position. The caller must hold mu.
+func (rb *RingBuffer[T]) atLocked(index int) (T, bool) {
+	if index < 0 || index >= rb.size {
+		var zero T
+		return zero, false
+	}
+	return rb.data[(rb.front+index)%len(rb.data)], true
+}
+
+// Size returns the number of elements in the buffer.
+func (rb *RingBuffer[T]) Size() int {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	return rb.size
+}
+
+// Capacity returns the maximum number of elements the buffer holds.
+func (rb *RingBuffer[T]) Capacity() int {
+	return len(rb.data)
+}
+
+// Policy returns the overflow policy of the buffer.
+func (rb *RingBuffer[T]) Policy() OverflowPolicy {
+	return rb.policy
+}
+
+// Dropped returns how many elements have been discarded by OverflowOverwrite.
+func (rb *RingBuffer[T]) Dropped() int {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	return rb.dropped
+}
+
+// IsEmpty checks if the buffer is empty.
+func (rb *RingBuffer[T]) IsEmpty() bool {
+	return rb.Size() == 0
+}
+
+// IsFull checks if the buffer is at capacity.
+func (rb *RingBuffer[T]) IsFull() bool {
+	return rb.Size() == len(rb.data)
+}
+
+// Clear removes all elements and wakes any blocked Push calls.
+func (rb *RingBuffer[T]) Clear() {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	clear(rb.data)
+	rb.front = 0
+	rb.size = 0
+	rb.notFull.Broadcast()
+}
+
+// Contains checks if the buffer contains an element.
+func (rb *RingBuffer[T]) Contains(item T) bool {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	for i := 0; i < rb.size; i++ {
+		if elementsEqual(rb.equals, rb.data[(rb.front+i)%len(rb.data)], item) {
+			return true
+		}
+	}
+	return false
+}
+
+// ToSlice returns the elements from oldest to newest.
+func (rb *RingBuffer[T]) ToSlice() []T {
+	return rb.AppendTo(nil)
+}
+
+// AppendTo appends the elements from oldest to newest to dst and returns the extended slice.
+func (rb *RingBuffer[T]) AppendTo(dst []T) []T {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	dst = slices.Grow(dst, rb.size)
+	for i := 0; i < rb.size; i++ {
+		dst = append(dst, rb.data[(rb.front+i)%len(rb.data)])
+	}
+	return dst
+}
+
+// ForEach applies a function to each element from oldest to newest.
+// It iterates over a snapshot, so fn may use the buffer.
+func (rb *RingBuffer[T]) ForEach(fn func(T)) {
+	for _, element := range rb.ToSlice() {
+		fn(element)
+	}
+}
+
+// String returns a string representation of the buffer.
+func (rb *RingBuffer[T]) String() string {
+	return fmt.Sprintf("RingBuffer%v", rb.ToSlice())
+}
@@ -0,0 +1,107 @@
+package stl
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRingBufferOverflowFail(t *testing.T) {
+	rb := NewRingBuffer[int](3, OverflowFail)
+	for i := 1; i <= 3; i++ {
+		if !rb.Push(i) {
+			t.Errorf("Expected Push(%d) to succeed", i)
+		}
+	}
+	if rb.Push(4) {
+		t.Error("Expected Push on a full buffer to fail")
+	}
+	if !rb.IsFull() || rb.String() != "RingBuffer[1 2 3]" {
+		t.Errorf("Unexpected buffer %s", rb.String())
+	}
+
+	if value, _ := rb.Pop(); value != 1 {
+		t.Errorf("Expected 1, got %d", value)
+	}
+	rb.Push(4)
+	if value, _ := rb.Peek(); value != 2 {
+		t.Errorf("Expected oldest 2, got %d", value)
+	}
+	if value, _ := rb.PeekBack(); value != 4 {
+		t.Errorf("Expected newest 4, got %d", value)
+	}
+	if value, _ := rb.At(1); value != 3 {
+		t.Errorf("Expected 3 at index 1, got %d", value)
+	}
+
+	rb.Clear()
+	if !rb.IsEmpty() {
+		t.Error("Buffer should be empty after Clear")
+	}
+	if _, ok := rb.Pop(); ok {
+		t.Error("Pop on an empty buffer should fail")
+	}
+}
+
+func TestRingBufferOverflowOverwrite(t *testing.T) {
+	rb := NewRingBuffer[int](3, OverflowOverwrite)
+	for i := 1; i <= 7; i++ {
+		if !rb.Push(i) {
+			t.Errorf("Expected Push(%d) to succeed", i)
+		}
+	}
+
+	if rb.String() != "RingBuffer[5 6 7]" {
+		t.Errorf("Expected the newest three elements, got %s", rb.String())
+	}
+	if rb.Dropped() != 4 {
+		t.Errorf("Expected 4 dropped elements, got %d", rb.Dropped())
+	}
+	if rb.Size() != 3 || rb.Capacity() != 3 {
+		t.Errorf("Expected size and capacity 3, got %d and %d", rb.Size(), rb.Capacity())
+	}
+}
+
+func TestRingBufferOverflowBlock(t *testing.T) {
+	rb := NewRingBuffer[int](1, OverflowBlock)
+	rb.Push(1)
+
+	done := make(chan struct{})
+	go func() {
+		rb.Push(2)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Push on a full blocking buffer should wait")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	if value, _ := rb.Pop(); value != 1 {
+		t.Errorf("Expected 1, got %d", value)
+	}
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Pop should unblock the waiting Push")
+	}
+	if value, _ := rb.Pop(); value != 2 {
+		t.Errorf("Expected 2, got %d", value)
+	}
+}
+
+func TestRingBufferContainsEquality(t *testing.T) {
+	rb := NewRingBuffer[any](4, OverflowOverwrite)
+	rb.Push(1)
+	rb.Push([]int{2})
+	if rb.Contains("1") || !rb.Contains(1) || !rb.Contains([]int{2}) {
+		t.Errorf("Expected deep equality by default")
+	}
+
+	folded := NewRingBuffer[string](4, OverflowOverwrite, WithEquals(strings.EqualFold))
+	folded.Push("Go")
+	if !folded.Contains("GO") {
+		t.Errorf("Expected WithEquals to be honored by Contains")
+	}
+}
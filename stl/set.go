@@ -2,39 +2,127 @@ package stl
 
 import (
 	"fmt"
+	"iter"
+	"maps"
+	"math/rand"
+	"sort"
 )
 
 // Set represents an unordered collection of unique elements.
 type Set[T comparable] struct {
 	data map[T]struct{}
+	// shared is set while data is also referenced by a snapshot; the next write copies it.
+	shared bool
 }
 
-// NewSet creates a new empty set.
-func NewSet[T comparable]() *Set[T] {
+// NewSet creates a new empty set. It honors WithCapacity.
+func NewSet[T comparable](opts ...Option) *Set[T] {
 	return &Set[T]{
-		data: make(map[T]struct{}),
+		data: make(map[T]struct{}, applyOptions(opts).capacity),
 	}
 }
 
+// NewSetWithCapacity creates a new empty set with room for capacity elements, so adding them
+// does not rehash.
+func NewSetWithCapacity[T comparable](capacity int) *Set[T] {
+	return NewSet[T](WithCapacity(capacity))
+}
+
 // NewSetFromSlice creates a set from a slice, removing duplicates.
 func NewSetFromSlice[T comparable](slice []T) *Set[T] {
-	s := NewSet[T]()
+	s := NewSetWithCapacity[T](len(slice))
 	for _, item := range slice {
 		s.Add(item)
 	}
 	return s
 }
 
+// NewSetFromSeq creates a set from the elements of a sequence, removing duplicates. It honors
+// WithCapacity as a hint of the sequence length, since a sequence cannot report it.
+func NewSetFromSeq[T comparable](seq iter.Seq[T], opts ...Option) *Set[T] {
+	s := NewSet[T](opts...)
+	for item := range seq {
+		s.data[item] = struct{}{}
+	}
+	return s
+}
+
 // Add adds an element to the set.
 func (s *Set[T]) Add(element T) {
+	s.detach()
 	s.data[element] = struct{}{}
 }
 
+// AddAll adds the given elements to the set.
+func (s *Set[T]) AddAll(items ...T) {
+	s.detach()
+	for _, item := range items {
+		s.data[item] = struct{}{}
+	}
+}
+
 // Remove removes an element from the set.
 func (s *Set[T]) Remove(element T) {
+	if !s.Contains(element) {
+		return
+	}
+	s.detach()
 	delete(s.data, element)
 }
 
+// Pop removes and returns an arbitrary element, or false if the set is empty.
+func (s *Set[T]) Pop() (T, bool) {
+	for element := range s.data {
+		s.detach()
+		delete(s.data, element)
+		return element, true
+	}
+	var zero T
+	return zero, false
+}
+
+// RandomElement returns an element chosen uniformly at random in O(n), or false if the set
+// is empty. A nil r uses a time-seeded generator. Map iteration order varies between runs,
+// so the choice is not reproducible from the seed of r alone.
+func (s *Set[T]) RandomElement(r *rand.Rand) (T, bool) {
+	var zero T
+	if len(s.data) == 0 {
+		return zero, false
+	}
+	if r == nil {
+		r = newRand(nil)
+	}
+	skip := r.Intn(len(s.data))
+	for element := range s.data {
+		if skip == 0 {
+			return element, true
+		}
+		skip--
+	}
+	return zero, false
+}
+
+// Sample returns min(n, Size()) distinct elements chosen uniformly at random, using
+// reservoir sampling in a single pass. A nil r uses a time-seeded generator. Like
+// RandomElement, the result is not reproducible from the seed of r alone.
+func (s *Set[T]) Sample(n int, r *rand.Rand) []T {
+	n = max(min(n, len(s.data)), 0)
+	if r == nil {
+		r = newRand(nil)
+	}
+	result := make([]T, 0, n)
+	i := 0
+	for element := range s.data {
+		if i < n {
+			result = append(result, element)
+		} else if j := r.Intn(i + 1); j < n {
+			result[j] = element
+		}
+		i++
+	}
+	return result
+}
+
 // Contains checks if an element exists in the set.
 func (s *Set[T]) Contains(element T) bool {
 	_, exists := s.data[element]
@@ -54,14 +142,60 @@ func (s *Set[T]) IsEmpty() bool {
 // Clear removes all elements from the set.
 func (s *Set[T]) Clear() {
 	s.data = make(map[T]struct{})
+	s.shared = false
+}
+
+// Grow ensures room for n more elements without rehashing. Go maps cannot grow in place, so
+// if there are already elements this copies them once into a larger map.
+func (s *Set[T]) Grow(n int) {
+	if n <= 0 {
+		return
+	}
+	data := make(map[T]struct{}, len(s.data)+n)
+	for element := range s.data {
+		data[element] = struct{}{}
+	}
+	s.data = data
+	s.shared = false
+}
+
+// detach gives the set its own copy of data if a snapshot still shares it. Unlike TreeMap's
+// path copying, this clones every element, so the first write after a snapshot takes O(n).
+func (s *Set[T]) detach() {
+	if s.shared {
+		s.data = maps.Clone(s.data)
+		s.shared = false
+	}
+}
+
+// Snapshot returns an immutable view of the set in O(1). The view shares storage with the
+// set until the set is next modified, which copies all elements once in O(n), so the view
+// can be read from other goroutines while the set keeps changing.
+func (s *Set[T]) Snapshot() *SetSnapshot[T] {
+	s.shared = true
+	return &SetSnapshot[T]{set: &Set[T]{data: s.data, shared: true}}
 }
 
 // ToSlice converts the set to a slice.
 func (s *Set[T]) ToSlice() []T {
-	result := make([]T, 0, len(s.data))
+	return s.AppendTo(make([]T, 0, len(s.data)))
+}
+
+// AppendTo appends the elements to dst and returns the extended slice, so callers can reuse a
+// buffer.
+func (s *Set[T]) AppendTo(dst []T) []T {
 	for element := range s.data {
-		result = append(result, element)
+		dst = append(dst, element)
 	}
+	return dst
+}
+
+// ToSortedSlice returns the elements sorted by less, for reproducible output.
+func (s *Set[T]) ToSortedSlice(less func(T, T) bool) []T {
+	result := s.ToSlice()
+	sort.Slice(result, func(i, j int) bool {
+		return less(result[i], result[j])
+	})
 	return result
 }
 
@@ -115,6 +249,51 @@ func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
 	return union.Difference(intersection)
 }
 
+// UnionWith adds the elements of other to s in place, without allocating a new set.
+func (s *Set[T]) UnionWith(other *Set[T]) {
+	s.detach()
+	for element := range other.data {
+		s.data[element] = struct{}{}
+	}
+}
+
+// IntersectWith removes from s, in place, the elements that are not in other.
+func (s *Set[T]) IntersectWith(other *Set[T]) {
+	s.detach()
+	for element := range s.data {
+		if !other.Contains(element) {
+			delete(s.data, element)
+		}
+	}
+}
+
+// DifferenceWith removes from s, in place, the elements that are in other. It walks the
+// smaller of the two sets.
+func (s *Set[T]) DifferenceWith(other *Set[T]) {
+	s.detach()
+	if len(other.data) < len(s.data) {
+		for element := range other.data {
+			delete(s.data, element)
+		}
+		return
+	}
+	for element := range s.data {
+		if other.Contains(element) {
+			delete(s.data, element)
+		}
+	}
+}
+
+// RemoveAll removes the elements of other from s (alias for DifferenceWith).
+func (s *Set[T]) RemoveAll(other *Set[T]) {
+	s.DifferenceWith(other)
+}
+
+// RetainAll keeps only the elements of s that are also in other (alias for IntersectWith).
+func (s *Set[T]) RetainAll(other *Set[T]) {
+	s.IntersectWith(other)
+}
+
 // IsSubset checks if s is a subset of other.
 func (s *Set[T]) IsSubset(other *Set[T]) bool {
 	for element := range s.data {
@@ -157,11 +336,27 @@ func (s *Set[T]) Clone() *Set[T] {
 	return result
 }
 
+// CloneWith creates a copy of the set whose elements are copied by cloneElem, for a deep copy
+// of sets of pointers.
+func (s *Set[T]) CloneWith(cloneElem func(T) T) *Set[T] {
+	result := NewSet[T](WithCapacity(len(s.data)))
+	for element := range s.data {
+		result.Add(cloneElem(element))
+	}
+	return result
+}
+
 // String returns a string representation of the set.
 func (s *Set[T]) String() string {
 	return fmt.Sprintf("Set%v", s.ToSlice())
 }
 
+// StringSorted returns a string representation of the set with the elements sorted by less,
+// so the output is stable, for example in logs.
+func (s *Set[T]) StringSorted(less func(T, T) bool) string {
+	return fmt.Sprintf("Set%v", s.ToSortedSlice(less))
+}
+
 // ForEach applies a function to each element in the set.
 func (s *Set[T]) ForEach(fn func(T)) {
 	for element := range s.data {
@@ -169,6 +364,68 @@ func (s *Set[T]) ForEach(fn func(T)) {
 	}
 }
 
+// All returns an iterator over the elements in unspecified order.
+func (s *Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for element := range s.data {
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// Iterator returns an iterator over the elements present when it is created, in unspecified
+// order. Remove deletes the current element from the set.
+func (s *Set[T]) Iterator() Iterator[T] {
+	return snapshotIterator(s.ToSlice(), s.Remove)
+}
+
+// Reduce folds the elements into a single value, starting from initial and visiting the
+// elements in no particular order.
+func (s *Set[T]) Reduce(initial T, fn func(T, T) T) T {
+	result := initial
+	s.ForEach(func(element T) {
+		result = fn(result, element)
+	})
+	return result
+}
+
+// CountIf returns the number of elements that satisfy the predicate.
+func (s *Set[T]) CountIf(predicate func(T) bool) int {
+	count := 0
+	s.ForEach(func(element T) {
+		if predicate(element) {
+			count++
+		}
+	})
+	return count
+}
+
+// Min returns the smallest element according to less, or false if the set is empty.
+func (s *Set[T]) Min(less func(T, T) bool) (T, bool) {
+	var result T
+	found := false
+	s.ForEach(func(element T) {
+		if !found || less(element, result) {
+			result, found = element, true
+		}
+	})
+	return result, found
+}
+
+// Max returns the largest element according to less, or false if the set is empty.
+func (s *Set[T]) Max(less func(T, T) bool) (T, bool) {
+	var result T
+	found := false
+	s.ForEach(func(element T) {
+		if !found || less(result, element) {
+			result, found = element, true
+		}
+	})
+	return result, found
+}
+
 // Filter returns a new set containing elements that satisfy the predicate.
 func (s *Set[T]) Filter(predicate func(T) bool) *Set[T] {
 	result := NewSet[T]()
@@ -180,6 +437,22 @@ func (s *Set[T]) Filter(predicate func(T) bool) *Set[T] {
 	return result
 }
 
+// Map applies a transformation function to each element and returns a new set. Elements that
+// transform to the same value are merged, so the result may be smaller.
+func (s *Set[T]) Map(transform func(T) T) *Set[T] {
+	return MapSet(s, transform)
+}
+
+// MapSet returns a new set of the results of applying fn to each element of s, which may
+// change the element type. Results that are equal are merged.
+func MapSet[T, U comparable](s *Set[T], fn func(T) U) *Set[U] {
+	result := NewSet[U](WithCapacity(len(s.data)))
+	for element := range s.data {
+		result.data[fn(element)] = struct{}{}
+	}
+	return result
+}
+
 // Any returns true if any element satisfies the predicate.
 func (s *Set[T]) Any(predicate func(T) bool) bool {
 	for element := range s.data {
@@ -190,8 +463,8 @@ func (s *Set[T]) Any(predicate func(T) bool) bool {
 	return false
 }
 
-// All returns true if all elements satisfy the predicate.
-func (s *Set[T]) All(predicate func(T) bool) bool {
+// Every returns true if all elements satisfy the predicate.
+func (s *Set[T]) Every(predicate func(T) bool) bool {
 	for element := range s.data {
 		if !predicate(element) {
 			return false
@@ -199,3 +472,49 @@ func (s *Set[T]) All(predicate func(T) bool) bool {
 	}
 	return true
 }
+
+// SetSnapshot is a read-only view of a Set at the moment Snapshot was called.
+type SetSnapshot[T comparable] struct {
+	set *Set[T]
+}
+
+// Contains checks if an element exists in the snapshot.
+func (ss *SetSnapshot[T]) Contains(element T) bool {
+	return ss.set.Contains(element)
+}
+
+// Size returns the number of elements in the snapshot.
+func (ss *SetSnapshot[T]) Size() int {
+	return ss.set.Size()
+}
+
+// IsEmpty checks if the snapshot is empty.
+func (ss *SetSnapshot[T]) IsEmpty() bool {
+	return ss.set.IsEmpty()
+}
+
+// ToSlice converts the snapshot to a slice.
+func (ss *SetSnapshot[T]) ToSlice() []T {
+	return ss.set.ToSlice()
+}
+
+// ForEach applies a function to each element in the snapshot.
+func (ss *SetSnapshot[T]) ForEach(fn func(T)) {
+	ss.set.ForEach(fn)
+}
+
+// All returns an iterator over the elements in unspecified order.
+func (ss *SetSnapshot[T]) All() iter.Seq[T] {
+	return ss.set.All()
+}
+
+// ToSet returns a mutable set with the snapshot's elements. It is O(1); the elements are
+// copied when the returned set is first modified.
+func (ss *SetSnapshot[T]) ToSet() *Set[T] {
+	return &Set[T]{data: ss.set.data, shared: true}
+}
+
+// String returns a string representation of the snapshot.
+func (ss *SetSnapshot[T]) String() string {
+	return fmt.Sprintf("SetSnapshot%v", ss.set.ToSlice())
+}
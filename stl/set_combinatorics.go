@@ -0,0 +1,131 @@
+package stl
+
+import (
+	"iter"
+	"slices"
+)
+
+// PowerSet returns an iterator over all 2^n subsets of the set, starting with the empty set.
+// Subsets are built lazily from the elements present when iteration starts, so stopping early
+// avoids enumerating the rest.
+func (s *Set[T]) PowerSet() iter.Seq[*Set[T]] {
+	return func(yield func(*Set[T]) bool) {
+		elements := s.ToSlice()
+		include := make([]bool, len(elements))
+		for {
+			subset := NewSet[T]()
+			for i, element := range elements {
+				if include[i] {
+					subset.data[element] = struct{}{}
+				}
+			}
+			if !yield(subset) {
+				return
+			}
+
+			// Advance include like a binary counter; wrapping around to all false ends it
+			i := 0
+			for i < len(include) && include[i] {
+				include[i] = false
+				i++
+			}
+			if i == len(include) {
+				return
+			}
+			include[i] = true
+		}
+	}
+}
+
+// Combinations returns an iterator over all k-element subsets of the set, each as a new
+// slice. It yields nothing if k is negative or larger than the set, and one empty slice if k
+// is 0.
+func (s *Set[T]) Combinations(k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		elements := s.ToSlice()
+		n := len(elements)
+		if k < 0 || k > n {
+			return
+		}
+
+		indices := make([]int, k)
+		for i := range indices {
+			indices[i] = i
+		}
+		for {
+			combination := make([]T, k)
+			for i, index := range indices {
+				combination[i] = elements[index]
+			}
+			if !yield(combination) {
+				return
+			}
+
+			// Find the rightmost index that can still move right
+			i := k - 1
+			for i >= 0 && indices[i] == n-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indices[i]++
+			for j := i + 1; j < k; j++ {
+				indices[j] = indices[j-1] + 1
+			}
+		}
+	}
+}
+
+// Permutations returns an iterator over all ordered arrangements of k distinct elements of
+// the set, each as a new slice. It yields nothing if k is negative or larger than the set.
+func (s *Set[T]) Permutations(k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		elements := s.ToSlice()
+		if k < 0 || k > len(elements) {
+			return
+		}
+
+		used := make([]bool, len(elements))
+		current := make([]T, 0, k)
+		var permute func() bool
+		permute = func() bool {
+			if len(current) == k {
+				return yield(slices.Clone(current))
+			}
+			for i, element := range elements {
+				if used[i] {
+					continue
+				}
+				used[i] = true
+				current = append(current, element)
+				if !permute() {
+					return false
+				}
+				current = current[:len(current)-1]
+				used[i] = false
+			}
+			return true
+		}
+		permute()
+	}
+}
+
+// CartesianProduct returns an iterator over all pairs (a, b) with a in s and b in other.
+func (s *Set[T]) CartesianProduct(other *Set[T]) iter.Seq2[T, T] {
+	return CartesianProduct(s, other)
+}
+
+// CartesianProduct returns an iterator over all pairs (x, y) with x in a and y in b, whose
+// element types may differ.
+func CartesianProduct[T, U comparable](a *Set[T], b *Set[U]) iter.Seq2[T, U] {
+	return func(yield func(T, U) bool) {
+		for x := range a.data {
+			for y := range b.data {
+				if !yield(x, y) {
+					return
+				}
+			}
+		}
+	}
+}
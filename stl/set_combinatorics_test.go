@@ -0,0 +1,106 @@
+package stl
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSetPowerSet(t *testing.T) {
+	set := NewSetFromSlice([]int{1, 2, 3})
+	subsets := 0
+	withOne := 0
+	for subset := range set.PowerSet() {
+		if !subset.IsSubset(set) {
+			t.Errorf("Expected a subset of %v, got %v", set, subset)
+		}
+		if subset.Contains(1) {
+			withOne++
+		}
+		subsets++
+	}
+	if subsets != 8 || withOne != 4 {
+		t.Errorf("Expected 8 subsets, 4 containing 1, got %d and %d", subsets, withOne)
+	}
+
+	count := 0
+	for subset := range NewSet[int]().PowerSet() {
+		if !subset.IsEmpty() {
+			t.Errorf("Expected only the empty set, got %v", subset)
+		}
+		count++
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 subset of the empty set, got %d", count)
+	}
+}
+
+func TestSetCombinationsAndPermutations(t *testing.T) {
+	set := NewSetFromSlice([]string{"a", "b", "c", "d"})
+
+	seen := NewSet[string]()
+	for combination := range set.Combinations(2) {
+		slices.Sort(combination)
+		seen.Add(combination[0] + combination[1])
+	}
+	if !seen.Equals(NewSetFromSlice([]string{"ab", "ac", "ad", "bc", "bd", "cd"})) {
+		t.Errorf("Expected the 6 pairs, got %v", seen)
+	}
+
+	seen.Clear()
+	for permutation := range set.Permutations(2) {
+		seen.Add(permutation[0] + permutation[1])
+	}
+	if seen.Size() != 12 || seen.Contains("aa") {
+		t.Errorf("Expected 12 ordered pairs of distinct elements, got %v", seen)
+	}
+
+	countOf := func(seq func(func([]string) bool)) int {
+		n := 0
+		for range seq {
+			n++
+		}
+		return n
+	}
+	if countOf(set.Combinations(0)) != 1 || countOf(set.Combinations(5)) != 0 || countOf(set.Permutations(-1)) != 0 {
+		t.Error("Expected one empty combination for k=0 and none for k out of range")
+	}
+	if countOf(set.Permutations(4)) != 24 {
+		t.Errorf("Expected 24 permutations, got %d", countOf(set.Permutations(4)))
+	}
+
+	// Stopping early is honored
+	taken := 0
+	for range set.Permutations(3) {
+		taken++
+		if taken == 5 {
+			break
+		}
+	}
+	if taken != 5 {
+		t.Errorf("Expected iteration to stop after 5 permutations, got %d", taken)
+	}
+}
+
+func TestSetCartesianProduct(t *testing.T) {
+	numbers := NewSetFromSlice([]int{1, 2})
+	letters := NewSetFromSlice([]string{"x", "y", "z"})
+
+	pairs := NewSet[Pair[int, string]]()
+	for n, l := range CartesianProduct(numbers, letters) {
+		pairs.Add(NewPair(n, l))
+	}
+	if pairs.Size() != 6 || !pairs.Contains(NewPair(2, "z")) {
+		t.Errorf("Expected 6 pairs including (2, z), got %v", pairs)
+	}
+
+	count := 0
+	for a, b := range numbers.CartesianProduct(numbers) {
+		if !numbers.Contains(a) || !numbers.Contains(b) {
+			t.Errorf("Expected elements of %v, got (%d, %d)", numbers, a, b)
+		}
+		count++
+	}
+	if count != 4 {
+		t.Errorf("Expected 4 pairs, got %d", count)
+	}
+}
@@ -0,0 +1,112 @@
+package stl
+
+import (
+	"maps"
+	"runtime"
+	"sync"
+)
+
+// parallelSetThreshold is the number of elements to test below which the parallel set
+// operations run sequentially, because starting goroutines costs more than it saves. It is a
+// variable so the benchmarks can disable it; see BenchmarkSetIntersection.
+var parallelSetThreshold = 1 << 15
+
+// UnionParallel returns a new set containing all elements from both sets, like Union, but
+// finds the elements of the smaller set missing from the larger one with workers goroutines.
+// workers <= 0 uses GOMAXPROCS. Small sets are combined sequentially. Neither set may be
+// modified during the call.
+func (s *Set[T]) UnionParallel(other *Set[T], workers int) *Set[T] {
+	larger, smaller := s, other
+	if len(smaller.data) > len(larger.data) {
+		larger, smaller = smaller, larger
+	}
+	result := &Set[T]{data: maps.Clone(larger.data)}
+	if result.data == nil {
+		result.data = make(map[T]struct{})
+	}
+	missing := parallelFilter(smaller, workers, func(element T) bool {
+		return !larger.Contains(element)
+	})
+	for _, element := range missing {
+		result.data[element] = struct{}{}
+	}
+	return result
+}
+
+// IntersectionParallel returns a new set containing elements present in both sets, like
+// Intersection, but tests the elements of the smaller set with workers goroutines. workers
+// <= 0 uses GOMAXPROCS. Small sets are intersected sequentially. Neither set may be modified
+// during the call.
+func (s *Set[T]) IntersectionParallel(other *Set[T], workers int) *Set[T] {
+	larger, smaller := s, other
+	if len(smaller.data) > len(larger.data) {
+		larger, smaller = smaller, larger
+	}
+	common := parallelFilter(smaller, workers, larger.Contains)
+	result := NewSetWithCapacity[T](len(common))
+	for _, element := range common {
+		result.data[element] = struct{}{}
+	}
+	return result
+}
+
+// DifferenceParallel returns a new set containing elements in s but not in other, like
+// Difference, but tests the elements of s with workers goroutines. workers <= 0 uses
+// GOMAXPROCS. Small sets are handled sequentially. Neither set may be modified during the
+// call.
+func (s *Set[T]) DifferenceParallel(other *Set[T], workers int) *Set[T] {
+	rest := parallelFilter(s, workers, func(element T) bool {
+		return !other.Contains(element)
+	})
+	result := NewSetWithCapacity[T](len(rest))
+	for _, element := range rest {
+		result.data[element] = struct{}{}
+	}
+	return result
+}
+
+// parallelFilter returns the elements of s that satisfy keep, splitting the work across
+// workers goroutines once s reaches parallelSetThreshold. keep must be safe for concurrent
+// use; reading a map that nobody writes is.
+func parallelFilter[T comparable](s *Set[T], workers int, keep func(T) bool) []T {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers == 1 || len(s.data) < parallelSetThreshold {
+		var result []T
+		for element := range s.data {
+			if keep(element) {
+				result = append(result, element)
+			}
+		}
+		return result
+	}
+
+	elements := s.ToSlice()
+	chunk := (len(elements) + workers - 1) / workers
+	parts := make([][]T, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		from, to := min(w*chunk, len(elements)), min((w+1)*chunk, len(elements))
+		wg.Add(1)
+		go func(w int, elements []T) {
+			defer wg.Done()
+			for _, element := range elements {
+				if keep(element) {
+					parts[w] = append(parts[w], element)
+				}
+			}
+		}(w, elements[from:to])
+	}
+	wg.Wait()
+
+	total := 0
+	for _, part := range parts {
+		total += len(part)
+	}
+	result := make([]T, 0, total)
+	for _, part := range parts {
+		result = append(result, part...)
+	}
+	return result
+}
@@ -0,0 +1,90 @@
+package stl
+
+import (
+	"fmt"
+	"testing"
+)
+
+// rangeSet returns the set of integers in [from, to).
+func rangeSet(from, to int) *Set[int] {
+	s := NewSetWithCapacity[int](to - from)
+	for i := from; i < to; i++ {
+		s.Add(i)
+	}
+	return s
+}
+
+// forceParallel disables the sequential fallback and returns a function that restores it.
+func forceParallel() func() {
+	saved := parallelSetThreshold
+	parallelSetThreshold = 0
+	return func() {
+		parallelSetThreshold = saved
+	}
+}
+
+func TestSetParallelOperations(t *testing.T) {
+	// Sizes on both sides of parallelSetThreshold take both paths
+	for _, n := range []int{100, 2 * parallelSetThreshold} {
+		a := rangeSet(0, n)
+		b := rangeSet(n/2, n+n/2)
+		for _, workers := range []int{0, 1, 3} {
+			if got, want := a.UnionParallel(b, workers), a.Union(b); !got.Equals(want) {
+				t.Errorf("n=%d workers=%d: Expected union of size %d, got %d", n, workers, want.Size(), got.Size())
+			}
+			if got, want := b.IntersectionParallel(a, workers), a.Intersection(b); !got.Equals(want) {
+				t.Errorf("n=%d workers=%d: Expected intersection of size %d, got %d", n, workers, want.Size(), got.Size())
+			}
+			if got, want := a.DifferenceParallel(b, workers), a.Difference(b); !got.Equals(want) {
+				t.Errorf("n=%d workers=%d: Expected difference of size %d, got %d", n, workers, want.Size(), got.Size())
+			}
+		}
+	}
+
+	empty := NewSet[int]()
+	if got := empty.UnionParallel(empty, 0); !got.IsEmpty() {
+		t.Errorf("Expected an empty union, got %v", got)
+	}
+	if got := empty.UnionParallel(rangeSet(0, 3), 0); got.Size() != 3 {
+		t.Errorf("Expected 3 elements, got %v", got)
+	}
+}
+
+// The benchmarks compare the sequential and parallel operations across sizes with the
+// sequential fallback disabled. Run them with -cpu=1,4,8 to find the crossover point on a
+// given machine and to check parallelSetThreshold against it.
+func BenchmarkSetIntersection(b *testing.B) {
+	for _, n := range []int{1_000, 10_000, 100_000, 1_000_000} {
+		x := rangeSet(0, n)
+		y := rangeSet(n/2, n+n/2)
+		b.Run(fmt.Sprintf("Sequential/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				x.Intersection(y)
+			}
+		})
+		b.Run(fmt.Sprintf("Parallel/%d", n), func(b *testing.B) {
+			defer forceParallel()()
+			for i := 0; i < b.N; i++ {
+				x.IntersectionParallel(y, 0)
+			}
+		})
+	}
+}
+
+func BenchmarkSetUnion(b *testing.B) {
+	for _, n := range []int{10_000, 1_000_000} {
+		x := rangeSet(0, n)
+		y := rangeSet(n/2, n+n/2)
+		b.Run(fmt.Sprintf("Sequential/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				x.Union(y)
+			}
+		})
+		b.Run(fmt.Sprintf("Parallel/%d", n), func(b *testing.B) {
+			defer forceParallel()()
+			for i := 0; i < b.N; i++ {
+				x.UnionParallel(y, 0)
+			}
+		})
+	}
+}
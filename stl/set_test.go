@@ -1,6 +1,7 @@
 package stl
 
 import (
+	"math/rand"
 	"testing"
 )
 
@@ -148,12 +149,12 @@ func TestSetFunctionalOperations(t *testing.T) {
 	}
 
 	// Test All
-	allPositive := set.All(func(x int) bool { return x > 0 })
+	allPositive := set.Every(func(x int) bool { return x > 0 })
 	if !allPositive {
 		t.Error("All numbers should be positive")
 	}
 
-	allEven := set.All(func(x int) bool { return x%2 == 0 })
+	allEven := set.Every(func(x int) bool { return x%2 == 0 })
 	if allEven {
 		t.Error("Not all numbers should be even")
 	}
@@ -226,3 +227,201 @@ func BenchmarkSetContains(b *testing.B) {
 		set.Contains(i % 1000)
 	}
 }
+
+func TestSetSnapshot(t *testing.T) {
+	set := NewSetFromSlice([]int{1, 2, 3})
+	snapshot := set.Snapshot()
+
+	set.Add(4)
+	set.Remove(1)
+	if snapshot.Size() != 3 || !snapshot.Contains(1) || snapshot.Contains(4) {
+		t.Errorf("Expected snapshot to keep [1 2 3], got %v", snapshot)
+	}
+	if set.Size() != 3 || set.Contains(1) || !set.Contains(4) {
+		t.Errorf("Expected set to be [2 3 4], got %v", set)
+	}
+
+	copied := snapshot.ToSet()
+	copied.Add(5)
+	if snapshot.Contains(5) || copied.Size() != 4 {
+		t.Error("Modifying ToSet result should not affect the snapshot")
+	}
+
+	set.Clear()
+	if snapshot.Size() != 3 {
+		t.Error("Clear should not affect the snapshot")
+	}
+}
+
+func TestSetAll(t *testing.T) {
+	set := NewSetFromSlice([]int{1, 2, 3, 4})
+
+	sum := 0
+	for x := range set.All() {
+		sum += x
+	}
+	if sum != 10 {
+		t.Errorf("Expected sum 10, got %d", sum)
+	}
+
+	count := 0
+	for range set.All() {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Errorf("Expected iteration to stop after 2 elements, got %d", count)
+	}
+}
+
+func TestSetAggregations(t *testing.T) {
+	set := NewSetFromSlice([]int{4, 1, 3})
+	if sum := set.Reduce(0, func(a, b int) int { return a + b }); sum != 8 {
+		t.Errorf("Expected sum 8, got %d", sum)
+	}
+	if count := set.CountIf(func(x int) bool { return x > 1 }); count != 2 {
+		t.Errorf("Expected 2 elements greater than 1, got %d", count)
+	}
+	if smallest, ok := set.Min(lessInt); !ok || smallest != 1 {
+		t.Errorf("Expected min 1, got %d", smallest)
+	}
+	if largest, ok := set.Max(lessInt); !ok || largest != 4 {
+		t.Errorf("Expected max 4, got %d", largest)
+	}
+	if _, ok := NewSet[int]().Min(lessInt); ok {
+		t.Error("Expected Min of an empty set to fail")
+	}
+}
+
+func TestSetBulkMutations(t *testing.T) {
+	set := NewSet[int]()
+	set.AddAll(1, 2, 3, 4)
+	set.UnionWith(NewSetFromSlice([]int{4, 5}))
+	if !set.Equals(NewSetFromSlice([]int{1, 2, 3, 4, 5})) {
+		t.Errorf("Expected {1 2 3 4 5}, got %v", set)
+	}
+
+	set.DifferenceWith(NewSetFromSlice([]int{1, 9}))
+	set.IntersectWith(NewSetFromSlice([]int{2, 3, 4, 8}))
+	if !set.Equals(NewSetFromSlice([]int{2, 3, 4})) {
+		t.Errorf("Expected {2 3 4}, got %v", set)
+	}
+
+	// The aliases behave the same, and a snapshot is unaffected
+	snapshot := set.Snapshot()
+	set.RemoveAll(NewSetFromSlice([]int{2}))
+	set.RetainAll(NewSetFromSlice([]int{3, 4, 5}))
+	if !set.Equals(NewSetFromSlice([]int{3, 4})) || snapshot.Size() != 3 {
+		t.Errorf("Expected {3 4} and a snapshot of 3 elements, got %v and %d", set, snapshot.Size())
+	}
+
+	set.DifferenceWith(set)
+	if !set.IsEmpty() {
+		t.Errorf("Expected the difference with itself to be empty, got %v", set)
+	}
+}
+
+func TestSetPopAndSampling(t *testing.T) {
+	set := NewSetFromSlice([]int{1, 2, 3})
+	seen := NewSet[int]()
+	for !set.IsEmpty() {
+		element, _ := set.Pop()
+		seen.Add(element)
+	}
+	if seen.Size() != 3 {
+		t.Errorf("Expected Pop to return each element once, got %v", seen)
+	}
+	if _, ok := set.Pop(); ok {
+		t.Error("Expected Pop on an empty set to fail")
+	}
+	if _, ok := set.RandomElement(nil); ok {
+		t.Error("Expected RandomElement on an empty set to fail")
+	}
+
+	r := rand.New(rand.NewSource(1))
+	set = NewSetFromSlice([]int{1, 2, 3, 4, 5, 6, 7, 8})
+	sample := NewSetFromSlice(set.Sample(3, r))
+	if sample.Size() != 3 || !sample.IsSubset(set) {
+		t.Errorf("Expected 3 distinct elements of the set, got %v", sample)
+	}
+	if len(set.Sample(20, r)) != 8 || len(set.Sample(-1, r)) != 0 {
+		t.Error("Expected Sample to clamp n to the size of the set")
+	}
+
+	// Every element is eventually chosen
+	chosen := NewSet[int]()
+	for i := 0; i < 500; i++ {
+		element, ok := set.RandomElement(r)
+		if !ok || !set.Contains(element) {
+			t.Fatalf("Expected an element of the set, got %d", element)
+		}
+		chosen.Add(element)
+	}
+	if !chosen.Equals(set) {
+		t.Errorf("Expected every element to be chosen, got %v", chosen)
+	}
+}
+
+func TestSetMap(t *testing.T) {
+	set := NewSetFromSlice([]int{-2, -1, 1, 3})
+	squares := set.Map(func(x int) int { return x * x })
+	if !squares.Equals(NewSetFromSlice([]int{4, 1, 9})) {
+		t.Errorf("Expected {1 4 9}, got %v", squares)
+	}
+
+	labels := MapSet(set, func(x int) string {
+		if x < 0 {
+			return "negative"
+		}
+		return "positive"
+	})
+	if !labels.Equals(NewSetFromSlice([]string{"negative", "positive"})) {
+		t.Errorf("Expected {negative positive}, got %v", labels)
+	}
+	if set.Size() != 4 {
+		t.Errorf("Expected the source set to be unchanged, got %v", set)
+	}
+}
+
+func TestSetSortedOutput(t *testing.T) {
+	set := NewSetFromSlice([]int{3, 1, 2})
+	if got := set.ToSortedSlice(lessInt); got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Errorf("Expected [1 2 3], got %v", got)
+	}
+	if got := set.StringSorted(lessInt); got != "Set[1 2 3]" {
+		t.Errorf("Expected Set[1 2 3], got %s", got)
+	}
+}
+
+func TestSetCapacityAndGrow(t *testing.T) {
+	set := NewSetWithCapacity[int](100)
+	set.AddAll(1, 2)
+	snapshot := set.Snapshot()
+	set.Grow(1000)
+	set.Add(3)
+	if set.Size() != 3 || snapshot.Size() != 2 {
+		t.Errorf("Expected 3 elements and a snapshot of 2, got %v and %v", set, snapshot)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		s := NewSetWithCapacity[int](64)
+		for i := 0; i < 64; i++ {
+			s.Add(i)
+		}
+	})
+	if growing := testing.AllocsPerRun(10, func() {
+		s := NewSet[int]()
+		for i := 0; i < 64; i++ {
+			s.Add(i)
+		}
+	}); allocs >= growing {
+		t.Errorf("Expected preallocation to save allocations, got %v vs %v", allocs, growing)
+	}
+
+	fromSeq := NewSetFromSeq(NewDequeFromSlice([]int{1, 2, 2, 3}).All(), WithCapacity(4))
+	if !fromSeq.Equals(NewSetFromSlice([]int{1, 2, 3})) {
+		t.Errorf("Expected {1 2 3}, got %v", fromSeq)
+	}
+}
@@ -0,0 +1,211 @@
+package stl
+
+import (
+	"fmt"
+	"iter"
+)
+
+// SetMultiMap represents a multimap that stores each value at most once per key, so
+// duplicate key-value pairs are collapsed. Each key's values are kept in a hash set, which
+// makes Put, Remove, and ContainsEntry O(1) on average; values have no particular order.
+type SetMultiMap[K, V comparable] struct {
+	data map[K]map[V]struct{}
+	size int
+}
+
+// NewSetMultiMap creates a new empty SetMultiMap.
+func NewSetMultiMap[K, V comparable]() *SetMultiMap[K, V] {
+	return &SetMultiMap[K, V]{
+		data: make(map[K]map[V]struct{}),
+	}
+}
+
+// Put adds a value for the given key and reports whether the pair was new.
+func (mm *SetMultiMap[K, V]) Put(key K, value V) bool {
+	values, exists := mm.data[key]
+	if !exists {
+		values = make(map[V]struct{})
+		mm.data[key] = values
+	}
+	if _, exists := values[value]; exists {
+		return false
+	}
+	values[value] = struct{}{}
+	mm.size++
+	return true
+}
+
+// PutAll adds multiple values for the given key.
+func (mm *SetMultiMap[K, V]) PutAll(key K, values []V) {
+	for _, value := range values {
+		mm.Put(key, value)
+	}
+}
+
+// Get returns the values associated with the given key, in no particular order.
+func (mm *SetMultiMap[K, V]) Get(key K) []V {
+	result := make([]V, 0, len(mm.data[key]))
+	for value := range mm.data[key] {
+		result = append(result, value)
+	}
+	return result
+}
+
+// GetSet returns the values associated with the given key as a new set.
+func (mm *SetMultiMap[K, V]) GetSet(key K) *Set[V] {
+	result := NewSetWithCapacity[V](len(mm.data[key]))
+	for value := range mm.data[key] {
+		result.data[value] = struct{}{}
+	}
+	return result
+}
+
+// Remove removes a key-value pair and reports whether it was present. The key is removed
+// with its last value.
+func (mm *SetMultiMap[K, V]) Remove(key K, value V) bool {
+	values, exists := mm.data[key]
+	if !exists {
+		return false
+	}
+	if _, exists := values[value]; !exists {
+		return false
+	}
+	delete(values, value)
+	if len(values) == 0 {
+		delete(mm.data, key)
+	}
+	mm.size--
+	return true
+}
+
+// RemoveAll removes all values for the given key.
+func (mm *SetMultiMap[K, V]) RemoveAll(key K) bool {
+	values, exists := mm.data[key]
+	if !exists {
+		return false
+	}
+	mm.size -= len(values)
+	delete(mm.data, key)
+	return true
+}
+
+// ContainsKey checks if the multimap contains the given key.
+func (mm *SetMultiMap[K, V]) ContainsKey(key K) bool {
+	_, exists := mm.data[key]
+	return exists
+}
+
+// ContainsValue checks if any key has the given value. It takes time linear in the number of
+// keys.
+func (mm *SetMultiMap[K, V]) ContainsValue(value V) bool {
+	for _, values := range mm.data {
+		if _, exists := values[value]; exists {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsEntry checks if the multimap contains the given key-value pair in O(1).
+func (mm *SetMultiMap[K, V]) ContainsEntry(key K, value V) bool {
+	_, exists := mm.data[key][value]
+	return exists
+}
+
+// Size returns the total number of key-value pairs.
+func (mm *SetMultiMap[K, V]) Size() int {
+	return mm.size
+}
+
+// KeySize returns the number of unique keys.
+func (mm *SetMultiMap[K, V]) KeySize() int {
+	return len(mm.data)
+}
+
+// ValueCount returns the number of values for a given key.
+func (mm *SetMultiMap[K, V]) ValueCount(key K) int {
+	return len(mm.data[key])
+}
+
+// IsEmpty checks if the multimap is empty.
+func (mm *SetMultiMap[K, V]) IsEmpty() bool {
+	return mm.size == 0
+}
+
+// Clear removes all key-value pairs.
+func (mm *SetMultiMap[K, V]) Clear() {
+	mm.data = make(map[K]map[V]struct{})
+	mm.size = 0
+}
+
+// Keys returns all keys in no particular order.
+func (mm *SetMultiMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(mm.data))
+	for key := range mm.data {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Values returns all values, once per key they belong to, in no particular order.
+func (mm *SetMultiMap[K, V]) Values() []V {
+	result := make([]V, 0, mm.size)
+	for _, values := range mm.data {
+		for value := range values {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// ToMapOfSlices returns a Go map from each key to a slice of its values.
+func (mm *SetMultiMap[K, V]) ToMapOfSlices() map[K][]V {
+	result := make(map[K][]V, len(mm.data))
+	for key := range mm.data {
+		result[key] = mm.Get(key)
+	}
+	return result
+}
+
+// ForEach applies a function to each key-value pair.
+func (mm *SetMultiMap[K, V]) ForEach(fn func(K, V)) {
+	for key, values := range mm.data {
+		for value := range values {
+			fn(key, value)
+		}
+	}
+}
+
+// All returns an iterator over every key-value pair.
+func (mm *SetMultiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, values := range mm.data {
+			for value := range values {
+				if !yield(key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Clone creates a deep copy of the multimap.
+func (mm *SetMultiMap[K, V]) Clone() *SetMultiMap[K, V] {
+	result := &SetMultiMap[K, V]{
+		data: make(map[K]map[V]struct{}, len(mm.data)),
+		size: mm.size,
+	}
+	for key, values := range mm.data {
+		copied := make(map[V]struct{}, len(values))
+		for value := range values {
+			copied[value] = struct{}{}
+		}
+		result.data[key] = copied
+	}
+	return result
+}
+
+// String returns a string representation of the multimap.
+func (mm *SetMultiMap[K, V]) String() string {
+	return fmt.Sprintf("SetMultiMap%v", mm.ToMapOfSlices())
+}
@@ -0,0 +1,52 @@
+package stl
+
+import (
+	"testing"
+)
+
+func TestSetMultiMapBasicOperations(t *testing.T) {
+	tags := NewSetMultiMap[string, string]()
+	if !tags.Put("go", "fast") || !tags.Put("go", "typed") || tags.Put("go", "fast") {
+		t.Error("Expected Put to report only new pairs")
+	}
+	tags.PutAll("rust", []string{"fast", "safe", "safe"})
+
+	if tags.Size() != 4 || tags.KeySize() != 2 || tags.ValueCount("rust") != 2 {
+		t.Errorf("Expected 4 pairs under 2 keys with duplicates collapsed, got %v", tags)
+	}
+	if !tags.ContainsEntry("go", "typed") || tags.ContainsEntry("go", "safe") || tags.ContainsEntry("c", "fast") {
+		t.Error("Expected ContainsEntry to match only stored pairs")
+	}
+	if !tags.ContainsValue("safe") || tags.ContainsValue("slow") || !tags.ContainsKey("rust") {
+		t.Error("Expected ContainsValue and ContainsKey to match stored values and keys")
+	}
+	if got := tags.GetSet("go"); !got.Equals(NewSetFromSlice([]string{"fast", "typed"})) {
+		t.Errorf("Expected {fast typed}, got %v", got)
+	}
+	if len(tags.Get("missing")) != 0 || len(tags.Values()) != 4 || len(tags.Keys()) != 2 {
+		t.Error("Expected no values for a missing key and 4 values overall")
+	}
+
+	clone := tags.Clone()
+	if !tags.Remove("go", "fast") || tags.Remove("go", "fast") || !tags.Remove("go", "typed") || tags.ContainsKey("go") {
+		t.Errorf("Expected each pair to be removed once and go to go with its last value, got %v", tags)
+	}
+	if !tags.RemoveAll("rust") || !tags.IsEmpty() || tags.Size() != 0 {
+		t.Errorf("Expected an empty multimap, got %v", tags)
+	}
+	if clone.Size() != 4 || !clone.ContainsEntry("go", "fast") {
+		t.Errorf("Expected the clone to be unaffected, got %v", clone)
+	}
+
+	pairs := 0
+	for key, value := range clone.All() {
+		if !clone.ContainsEntry(key, value) {
+			t.Errorf("Expected a stored pair, got %s:%s", key, value)
+		}
+		pairs++
+	}
+	clone.Clear()
+	if pairs != 4 || !clone.IsEmpty() {
+		t.Errorf("Expected 4 pairs and then an empty clone, got %d and %v", pairs, clone)
+	}
+}
@@ -0,0 +1,299 @@
+package stl
+
+import (
+	"fmt"
+)
+
+// SortedList represents a sequence kept in sorted order that allows duplicate values and
+// indexed access. It is backed by a size-augmented AVL tree, so Add, Remove, At, and the
+// rank queries take O(log n). Values that compare equal keep their insertion order.
+type SortedList[T comparable] struct {
+	tree *AVLTree[T]
+}
+
+// NewSortedList creates a new empty sorted list with a comparator function.
+func NewSortedList[T comparable](less func(T, T) bool) *SortedList[T] {
+	return &SortedList[T]{tree: NewAVLTree[T](less)}
+}
+
+// NewSortedListFromSlice creates a sorted list holding every element of the slice.
+func NewSortedListFromSlice[T comparable](slice []T, less func(T, T) bool) *SortedList[T] {
+	sl := NewSortedList[T](less)
+	for _, item := range slice {
+		sl.Add(item)
+	}
+	return sl
+}
+
+// Add inserts a value after any values that compare equal to it.
+func (sl *SortedList[T]) Add(value T) {
+	sl.tree.root = sl.insert(sl.tree.root, value)
+}
+
+// insert is the recursive helper for Add.
+func (sl *SortedList[T]) insert(node *avlNode[T], value T) *avlNode[T] {
+	if node == nil {
+		return &avlNode[T]{value: value, height: 1, size: 1}
+	}
+	if sl.tree.less(value, node.value) {
+		node.left = sl.insert(node.left, value)
+	} else {
+		node.right = sl.insert(node.right, value)
+	}
+	return sl.tree.rebalance(node)
+}
+
+// removeAt deletes the node at index within a subtree.
+func (sl *SortedList[T]) removeAt(node *avlNode[T], index int) *avlNode[T] {
+	leftSize := avlSize(node.left)
+	switch {
+	case index < leftSize:
+		node.left = sl.removeAt(node.left, index)
+	case index > leftSize:
+		node.right = sl.removeAt(node.right, index-leftSize-1)
+	default:
+		if node.left == nil {
+			return node.right
+		} else if node.right == nil {
+			return node.left
+		}
+
+		// Replace with the first node of the right subtree
+		successor := node.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		node.value = successor.value
+		node.right = sl.removeAt(node.right, 0)
+	}
+	return sl.tree.rebalance(node)
+}
+
+// insertRun inserts count copies of value after any values that compare equal to it, in
+// O(log n + count), by splitting the tree and joining a balanced run of copies in between.
+func (sl *SortedList[T]) insertRun(value T, count int) {
+	left, right := sl.split(sl.tree.root, sl.RankRight(value))
+	sl.tree.root = sl.concat(sl.concat(left, sl.run(value, count)), right)
+}
+
+// removeRange removes the values with indices in [from, to) in O(log n).
+func (sl *SortedList[T]) removeRange(from, to int) {
+	left, rest := sl.split(sl.tree.root, from)
+	_, right := sl.split(rest, to-from)
+	sl.tree.root = sl.concat(left, right)
+}
+
+// run builds a balanced subtree holding count copies of value.
+func (sl *SortedList[T]) run(value T, count int) *avlNode[T] {
+	if count <= 0 {
+		return nil
+	}
+	node := &avlNode[T]{value: value}
+	node.left = sl.run(value, count/2)
+	node.right = sl.run(value, count-count/2-1)
+	sl.tree.update(node)
+	return node
+}
+
+// split divides a subtree into the nodes with indices below index and the rest.
+func (sl *SortedList[T]) split(node *avlNode[T], index int) (*avlNode[T], *avlNode[T]) {
+	if node == nil {
+		return nil, nil
+	}
+	leftSize := avlSize(node.left)
+	if index <= leftSize {
+		left, right := sl.split(node.left, index)
+		return left, sl.join(right, node, node.right)
+	}
+	left, right := sl.split(node.right, index-leftSize-1)
+	return sl.join(node.left, node, left), right
+}
+
+// join returns the subtree holding left, then mid, then right, where mid is a detached
+// node. It descends the taller side to a subtree of matching height, in
+// O(|height(left) - height(right)|).
+func (sl *SortedList[T]) join(left, mid, right *avlNode[T]) *avlNode[T] {
+	switch leftHeight, rightHeight := avlHeight(left), avlHeight(right); {
+	case leftHeight > rightHeight+1:
+		left.right = sl.join(left.right, mid, right)
+		return sl.tree.rebalance(left)
+	case rightHeight > leftHeight+1:
+		right.left = sl.join(left, mid, right.left)
+		return sl.tree.rebalance(right)
+	}
+	mid.left, mid.right = left, right
+	sl.tree.update(mid)
+	return mid
+}
+
+// concat returns the subtree holding left followed by right.
+func (sl *SortedList[T]) concat(left, right *avlNode[T]) *avlNode[T] {
+	if right == nil {
+		return left
+	}
+	first := right
+	for first.left != nil {
+		first = first.left
+	}
+	return sl.join(left, &avlNode[T]{value: first.value}, sl.removeAt(right, 0))
+}
+
+// RemoveAt removes and returns the value at the given index.
+func (sl *SortedList[T]) RemoveAt(index int) (T, bool) {
+	value, ok := sl.At(index)
+	if ok {
+		sl.tree.root = sl.removeAt(sl.tree.root, index)
+	}
+	return value, ok
+}
+
+// Remove removes one occurrence of a value and reports whether it was present.
+func (sl *SortedList[T]) Remove(value T) bool {
+	index := sl.IndexOf(value)
+	if index < 0 {
+		return false
+	}
+	sl.tree.root = sl.removeAt(sl.tree.root, index)
+	return true
+}
+
+// At returns the value at the given index.
+func (sl *SortedList[T]) At(index int) (T, bool) {
+	return sl.tree.Select(index)
+}
+
+// IndexOf returns the index of the first occurrence of a value, or -1 if it is absent.
+func (sl *SortedList[T]) IndexOf(value T) int {
+	for i, end := sl.Rank(value), sl.RankRight(value); i < end; i++ {
+		if candidate, _ := sl.At(i); candidate == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains checks if a value is in the list.
+func (sl *SortedList[T]) Contains(value T) bool {
+	return sl.IndexOf(value) >= 0
+}
+
+// Rank returns the number of values less than the given value, which is the first index
+// the value could be inserted at.
+func (sl *SortedList[T]) Rank(value T) int {
+	rank := 0
+	current := sl.tree.root
+	for current != nil {
+		if sl.tree.less(current.value, value) {
+			rank += 1 + avlSize(current.left)
+			current = current.right
+		} else {
+			current = current.left
+		}
+	}
+	return rank
+}
+
+// RankRight returns the number of values less than or equal to the given value, which is
+// the last index the value could be inserted at.
+func (sl *SortedList[T]) RankRight(value T) int {
+	rank := 0
+	current := sl.tree.root
+	for current != nil {
+		if sl.tree.less(value, current.value) {
+			current = current.left
+		} else {
+			rank += 1 + avlSize(current.left)
+			current = current.right
+		}
+	}
+	return rank
+}
+
+// Count returns the number of values that compare equal to the given value.
+func (sl *SortedList[T]) Count(value T) int {
+	return sl.RankRight(value) - sl.Rank(value)
+}
+
+// Slice returns the values with indices in [from, to), clamped to the list bounds.
+func (sl *SortedList[T]) Slice(from, to int) []T {
+	from, to = max(from, 0), min(to, sl.Size())
+	result := make([]T, 0, max(to-from, 0))
+	sl.collect(sl.tree.root, 0, from, to, &result)
+	return result
+}
+
+// collect appends the values of a subtree whose indices fall in [from, to). offset is the
+// index of the leftmost node of the subtree.
+func (sl *SortedList[T]) collect(node *avlNode[T], offset, from, to int, result *[]T) {
+	if node == nil || offset >= to || offset+node.size <= from {
+		return
+	}
+	index := offset + avlSize(node.left)
+	sl.collect(node.left, offset, from, to, result)
+	if index >= from && index < to {
+		*result = append(*result, node.value)
+	}
+	sl.collect(node.right, index+1, from, to, result)
+}
+
+// Range returns all values between min and max (inclusive).
+func (sl *SortedList[T]) Range(min, max T) []T {
+	return sl.Slice(sl.Rank(min), sl.RankRight(max))
+}
+
+// Min returns the smallest value.
+func (sl *SortedList[T]) Min() (T, bool) {
+	return sl.At(0)
+}
+
+// Max returns the largest value.
+func (sl *SortedList[T]) Max() (T, bool) {
+	return sl.At(sl.Size() - 1)
+}
+
+// Size returns the number of values in the list.
+func (sl *SortedList[T]) Size() int {
+	return sl.tree.Size()
+}
+
+// IsEmpty checks if the list is empty.
+func (sl *SortedList[T]) IsEmpty() bool {
+	return sl.tree.IsEmpty()
+}
+
+// Clear removes all values from the list.
+func (sl *SortedList[T]) Clear() {
+	sl.tree.Clear()
+}
+
+// ToSlice returns the values in sorted order.
+func (sl *SortedList[T]) ToSlice() []T {
+	return sl.Slice(0, sl.Size())
+}
+
+// AppendTo appends the values in sorted order to dst and returns the extended slice.
+func (sl *SortedList[T]) AppendTo(dst []T) []T {
+	sl.collect(sl.tree.root, 0, 0, sl.Size(), &dst)
+	return dst
+}
+
+// ForEach applies a function to each value in sorted order.
+func (sl *SortedList[T]) ForEach(fn func(T)) {
+	sl.tree.ForEach(fn)
+}
+
+// Clone creates a copy of the list.
+func (sl *SortedList[T]) Clone() *SortedList[T] {
+	return &SortedList[T]{tree: sl.tree.Clone()}
+}
+
+// CloneWith creates a copy of the list whose values are copied by cloneElem, which must
+// preserve their order.
+func (sl *SortedList[T]) CloneWith(cloneElem func(T) T) *SortedList[T] {
+	return &SortedList[T]{tree: sl.tree.CloneWith(cloneElem)}
+}
+
+// String returns a string representation of the list.
+func (sl *SortedList[T]) String() string {
+	return fmt.Sprintf("SortedList%v", sl.ToSlice())
+}
@@ -0,0 +1,110 @@
+package stl
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestSortedListBasicOperations(t *testing.T) {
+	sl := NewSortedListFromSlice([]int{5, 1, 3, 3, 9, 7, 3}, lessInt)
+
+	if sl.String() != "SortedList[1 3 3 3 5 7 9]" || sl.Size() != 7 {
+		t.Errorf("Unexpected list %s", sl.String())
+	}
+	if value, _ := sl.At(4); value != 5 {
+		t.Errorf("Expected 5 at index 4, got %d", value)
+	}
+	if index := sl.IndexOf(3); index != 1 {
+		t.Errorf("Expected index 1, got %d", index)
+	}
+	if sl.IndexOf(4) != -1 || sl.Contains(4) {
+		t.Error("Expected 4 to be absent")
+	}
+	if sl.Rank(3) != 1 || sl.RankRight(3) != 4 || sl.Count(3) != 3 {
+		t.Errorf("Unexpected ranks %d %d", sl.Rank(3), sl.RankRight(3))
+	}
+	if values := sl.Slice(2, 5); len(values) != 3 || values[0] != 3 || values[2] != 5 {
+		t.Errorf("Expected [3 3 5], got %v", values)
+	}
+	if values := sl.Range(3, 7); len(values) != 5 {
+		t.Errorf("Expected 5 values in [3, 7], got %v", values)
+	}
+
+	if !sl.Remove(3) || sl.Count(3) != 2 {
+		t.Error("Remove should delete a single occurrence")
+	}
+	if value, _ := sl.RemoveAt(0); value != 1 {
+		t.Errorf("Expected to remove 1, got %d", value)
+	}
+	if value, _ := sl.Min(); value != 3 {
+		t.Errorf("Expected min 3, got %d", value)
+	}
+	if value, _ := sl.Max(); value != 9 {
+		t.Errorf("Expected max 9, got %d", value)
+	}
+	if _, ok := sl.At(sl.Size()); ok {
+		t.Error("At past the end should fail")
+	}
+
+	sl.Clear()
+	if !sl.IsEmpty() {
+		t.Error("List should be empty after Clear")
+	}
+}
+
+func TestSortedListStableForEqualKeys(t *testing.T) {
+	type item struct {
+		key  int
+		name string
+	}
+	sl := NewSortedList[item](func(a, b item) bool { return a.key < b.key })
+	sl.Add(item{2, "a"})
+	sl.Add(item{1, "b"})
+	sl.Add(item{2, "c"})
+	sl.Add(item{2, "d"})
+
+	names := ""
+	sl.ForEach(func(it item) { names += it.name })
+	if names != "bacd" {
+		t.Errorf("Expected bacd, got %s", names)
+	}
+	if !sl.Remove(item{2, "c"}) || sl.Contains(item{2, "c"}) || !sl.Contains(item{2, "d"}) {
+		t.Error("Remove should delete the exact value among equal keys")
+	}
+}
+
+func TestSortedListMatchesSortedSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	sl := NewSortedList[int](lessInt)
+	var expected []int
+
+	for i := 0; i < 3000; i++ {
+		if len(expected) > 0 && r.Intn(3) == 0 {
+			index := r.Intn(len(expected))
+			value, _ := sl.RemoveAt(index)
+			if value != expected[index] {
+				t.Fatalf("Expected %d at index %d, got %d", expected[index], index, value)
+			}
+			expected = append(expected[:index], expected[index+1:]...)
+		} else {
+			value := r.Intn(100)
+			sl.Add(value)
+			expected = append(expected, value)
+			sort.Ints(expected)
+		}
+	}
+
+	actual := sl.ToSlice()
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %d values, got %d", len(expected), len(actual))
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("Expected %d at index %d, got %d", expected[i], i, actual[i])
+		}
+	}
+	if !sl.tree.IsBalanced() {
+		t.Error("Backing tree should stay balanced")
+	}
+}
@@ -0,0 +1,115 @@
+package stl
+
+import (
+	"fmt"
+)
+
+// SparseSet represents a set of non-negative integers stored as a sparse index array paired
+// with a dense array of members. Add, Remove, Contains, and Clear are O(1), and iteration
+// walks only the live members in a contiguous slice. Memory grows with the largest member.
+type SparseSet struct {
+	sparse []int // sparse[x] is the position of x in dense, valid only if dense agrees
+	dense  []int
+}
+
+// NewSparseSet creates a new empty sparse set with room for members 0..capacity-1 before growing.
+func NewSparseSet(capacity int) *SparseSet {
+	return &SparseSet{sparse: make([]int, max(capacity, 0))}
+}
+
+// NewSparseSetFromSlice creates a sparse set from a slice. Negative values are ignored.
+func NewSparseSetFromSlice(slice []int) *SparseSet {
+	s := NewSparseSet(0)
+	for _, item := range slice {
+		s.Add(item)
+	}
+	return s
+}
+
+// Add adds a value to the set. Negative values are ignored.
+func (s *SparseSet) Add(value int) {
+	if value < 0 || s.Contains(value) {
+		return
+	}
+	if value >= len(s.sparse) {
+		grown := make([]int, max(value+1, 2*len(s.sparse)))
+		copy(grown, s.sparse)
+		s.sparse = grown
+	}
+	s.sparse[value] = len(s.dense)
+	s.dense = append(s.dense, value)
+}
+
+// Remove removes a value from the set. The last member takes its place in iteration order.
+func (s *SparseSet) Remove(value int) {
+	if !s.Contains(value) {
+		return
+	}
+	position := s.sparse[value]
+	last := s.dense[len(s.dense)-1]
+	s.dense[position] = last
+	s.sparse[last] = position
+	s.dense = s.dense[:len(s.dense)-1]
+}
+
+// Contains checks if a value is in the set.
+func (s *SparseSet) Contains(value int) bool {
+	if value < 0 || value >= len(s.sparse) {
+		return false
+	}
+	position := s.sparse[value]
+	return position < len(s.dense) && s.dense[position] == value
+}
+
+// Size returns the number of values in the set.
+func (s *SparseSet) Size() int {
+	return len(s.dense)
+}
+
+// IsEmpty checks if the set is empty.
+func (s *SparseSet) IsEmpty() bool {
+	return len(s.dense) == 0
+}
+
+// Clear removes all values from the set in O(1), keeping the allocated storage.
+func (s *SparseSet) Clear() {
+	s.dense = s.dense[:0]
+}
+
+// Capacity returns one more than the largest value that can be added without growing.
+func (s *SparseSet) Capacity() int {
+	return len(s.sparse)
+}
+
+// At returns the member at the given position of the dense array.
+func (s *SparseSet) At(index int) (int, bool) {
+	if index < 0 || index >= len(s.dense) {
+		return 0, false
+	}
+	return s.dense[index], true
+}
+
+// ToSlice returns the members in dense order.
+func (s *SparseSet) ToSlice() []int {
+	return append([]int(nil), s.dense...)
+}
+
+// ForEach applies a function to each member in dense order.
+func (s *SparseSet) ForEach(fn func(int)) {
+	for _, value := range s.dense {
+		fn(value)
+	}
+}
+
+// Clone creates a copy of the set.
+func (s *SparseSet) Clone() *SparseSet {
+	return &SparseSet{
+		sparse: append([]int(nil), s.sparse...),
+		dense:  append([]int(nil), s.dense...),
+	}
+}
+
+// String returns a string representation of the set.
+func (s *SparseSet) String() string {
+	return fmt.Sprintf("SparseSet%v", s.dense)
+}
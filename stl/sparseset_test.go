@@ -0,0 +1,75 @@
+package stl
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSparseSetBasicOperations(t *testing.T) {
+	s := NewSparseSet(8)
+	s.Add(5)
+	s.Add(2)
+	s.Add(7)
+	s.Add(5)
+	s.Add(-1)
+	s.Add(100)
+
+	if s.String() != "SparseSet[5 2 7 100]" || s.Size() != 4 {
+		t.Errorf("Unexpected set %s", s.String())
+	}
+	if !s.Contains(100) || s.Contains(3) || s.Contains(-1) || s.Contains(1000) {
+		t.Error("Contains returned an unexpected result")
+	}
+	if s.Capacity() < 101 {
+		t.Errorf("Expected capacity to grow past 100, got %d", s.Capacity())
+	}
+
+	s.Remove(5)
+	if s.String() != "SparseSet[100 2 7]" {
+		t.Errorf("Expected the last member to fill the gap, got %s", s.String())
+	}
+	if value, _ := s.At(0); value != 100 {
+		t.Errorf("Expected 100 at index 0, got %d", value)
+	}
+
+	clone := s.Clone()
+	s.Clear()
+	if !s.IsEmpty() || s.Contains(2) {
+		t.Error("Set should be empty after Clear")
+	}
+	if clone.Size() != 3 || !clone.Contains(2) {
+		t.Error("Clone should be unaffected by Clear")
+	}
+
+	// Stale sparse entries must not resurrect members after Clear
+	s.Add(7)
+	if s.Contains(2) || !s.Contains(7) || s.Size() != 1 {
+		t.Errorf("Unexpected set after re-adding %s", s.String())
+	}
+}
+
+func TestSparseSetMatchesSet(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s := NewSparseSet(0)
+	expected := NewSet[int]()
+
+	for i := 0; i < 5000; i++ {
+		value := r.Intn(500)
+		if r.Intn(2) == 0 {
+			s.Add(value)
+			expected.Add(value)
+		} else {
+			s.Remove(value)
+			expected.Remove(value)
+		}
+	}
+
+	if s.Size() != expected.Size() {
+		t.Fatalf("Expected %d members, got %d", expected.Size(), s.Size())
+	}
+	s.ForEach(func(value int) {
+		if !expected.Contains(value) {
+			t.Errorf("Unexpected member %d", value)
+		}
+	})
+}
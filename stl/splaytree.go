@@ -0,0 +1,301 @@
+package stl
+
+import "fmt"
+
+// splayNode represents a node in a SplayTree.
+type splayNode[T comparable] struct {
+	value T
+	left  *splayNode[T]
+	right *splayNode[T]
+}
+
+// SplayTree represents a self-adjusting binary search tree of unique values. Every access
+// moves the accessed value to the root, so recently and frequently used values are cheap to
+// reach again. Operations run in O(log n) amortized time.
+type SplayTree[T comparable] struct {
+	root *splayNode[T]
+	less func(T, T) bool
+	size int
+}
+
+// NewSplayTree creates a new empty SplayTree with a comparator function.
+func NewSplayTree[T comparable](less func(T, T) bool) *SplayTree[T] {
+	return &SplayTree[T]{less: less}
+}
+
+// NewSplayTreeFromSlice creates a SplayTree from a slice, ignoring duplicates.
+func NewSplayTreeFromSlice[T comparable](slice []T, less func(T, T) bool) *SplayTree[T] {
+	tree := NewSplayTree[T](less)
+	for _, item := range slice {
+		tree.Insert(item)
+	}
+	return tree
+}
+
+// splay performs a top-down splay of node around value. The returned root holds value if it
+// is present, otherwise the last node visited on its search path.
+func (st *SplayTree[T]) splay(node *splayNode[T], value T) *splayNode[T] {
+	if node == nil {
+		return nil
+	}
+
+	var header splayNode[T]
+	leftMax, rightMin := &header, &header
+
+	for {
+		if st.less(value, node.value) {
+			if node.left == nil {
+				break
+			}
+			if st.less(value, node.left.value) {
+				// Rotate right
+				child := node.left
+				node.left = child.right
+				child.right = node
+				node = child
+				if node.left == nil {
+					break
+				}
+			}
+			// Link right
+			rightMin.left = node
+			rightMin = node
+			node = node.left
+		} else if st.less(node.value, value) {
+			if node.right == nil {
+				break
+			}
+			if st.less(node.right.value, value) {
+				// Rotate left
+				child := node.right
+				node.right = child.left
+				child.left = node
+				node = child
+				if node.right == nil {
+					break
+				}
+			}
+			// Link left
+			leftMax.right = node
+			leftMax = node
+			node = node.right
+		} else {
+			break
+		}
+	}
+
+	// Reassemble
+	leftMax.right = node.left
+	rightMin.left = node.right
+	node.left = header.right
+	node.right = header.left
+	return node
+}
+
+// Insert adds a value to the tree. Inserting a value that is already present has no effect.
+func (st *SplayTree[T]) Insert(value T) {
+	if st.root == nil {
+		st.root = &splayNode[T]{value: value}
+		st.size++
+		return
+	}
+
+	st.root = st.splay(st.root, value)
+	if st.root.value == value {
+		return
+	}
+
+	node := &splayNode[T]{value: value}
+	if st.less(value, st.root.value) {
+		node.left = st.root.left
+		node.right = st.root
+		st.root.left = nil
+	} else {
+		node.right = st.root.right
+		node.left = st.root
+		st.root.right = nil
+	}
+	st.root = node
+	st.size++
+}
+
+// Delete removes a value from the tree and reports whether it was present.
+func (st *SplayTree[T]) Delete(value T) bool {
+	if st.root == nil {
+		return false
+	}
+
+	st.root = st.splay(st.root, value)
+	if st.root.value != value {
+		return false
+	}
+
+	if st.root.left == nil {
+		st.root = st.root.right
+	} else {
+		// The largest value of the left subtree has no right child once splayed
+		right := st.root.right
+		st.root = st.splay(st.root.left, value)
+		st.root.right = right
+	}
+	st.size--
+	return true
+}
+
+// Search checks if a value exists in the tree. The search splays the tree.
+func (st *SplayTree[T]) Search(value T) bool {
+	if st.root == nil {
+		return false
+	}
+	st.root = st.splay(st.root, value)
+	return st.root.value == value
+}
+
+// Size returns the number of values in the tree.
+func (st *SplayTree[T]) Size() int {
+	return st.size
+}
+
+// IsEmpty checks if the tree is empty.
+func (st *SplayTree[T]) IsEmpty() bool {
+	return st.size == 0
+}
+
+// Clear removes all values from the tree.
+func (st *SplayTree[T]) Clear() {
+	st.root = nil
+	st.size = 0
+}
+
+// Min returns the minimum value in the tree and splays it to the root.
+func (st *SplayTree[T]) Min() (T, bool) {
+	if st.root == nil {
+		var zero T
+		return zero, false
+	}
+	current := st.root
+	for current.left != nil {
+		current = current.left
+	}
+	st.root = st.splay(st.root, current.value)
+	return current.value, true
+}
+
+// Max returns the maximum value in the tree and splays it to the root.
+func (st *SplayTree[T]) Max() (T, bool) {
+	if st.root == nil {
+		var zero T
+		return zero, false
+	}
+	current := st.root
+	for current.right != nil {
+		current = current.right
+	}
+	st.root = st.splay(st.root, current.value)
+	return current.value, true
+}
+
+// Root returns the value at the root of the tree, which is the most recently accessed value.
+func (st *SplayTree[T]) Root() (T, bool) {
+	if st.root == nil {
+		var zero T
+		return zero, false
+	}
+	return st.root.value, true
+}
+
+// Height returns the height of the tree, or -1 if it is empty.
+func (st *SplayTree[T]) Height() int {
+	height := -1
+	level := []*splayNode[T]{}
+	if st.root != nil {
+		level = append(level, st.root)
+	}
+	for len(level) > 0 {
+		height++
+		var next []*splayNode[T]
+		for _, node := range level {
+			if node.left != nil {
+				next = append(next, node.left)
+			}
+			if node.right != nil {
+				next = append(next, node.right)
+			}
+		}
+		level = next
+	}
+	return height
+}
+
+// Contains checks if a value exists in the tree. Like Search, it splays the tree.
+func (st *SplayTree[T]) Contains(value T) bool {
+	return st.Search(value)
+}
+
+// ToSlice returns the values in sorted order.
+func (st *SplayTree[T]) ToSlice() []T {
+	return st.InOrder()
+}
+
+// InOrder returns the values in sorted order.
+func (st *SplayTree[T]) InOrder() []T {
+	return st.AppendTo(nil)
+}
+
+// AppendTo appends the values in sorted order to dst and returns the extended slice.
+func (st *SplayTree[T]) AppendTo(dst []T) []T {
+	st.ForEach(func(value T) {
+		dst = append(dst, value)
+	})
+	return dst
+}
+
+// ForEach applies a function to each value in sorted order without splaying.
+func (st *SplayTree[T]) ForEach(fn func(T)) {
+	// Iterative so that degenerate chains cannot overflow the stack
+	var stack []*splayNode[T]
+	current := st.root
+	for current != nil || len(stack) > 0 {
+		for current != nil {
+			stack = append(stack, current)
+			current = current.left
+		}
+		current = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		fn(current.value)
+		current = current.right
+	}
+}
+
+// Range returns all values between min and max (inclusive) without splaying.
+func (st *SplayTree[T]) Range(min, max T) []T {
+	var result []T
+	var stack []*splayNode[T]
+	current := st.root
+	for current != nil || len(stack) > 0 {
+		for current != nil {
+			stack = append(stack, current)
+			if st.less(current.value, min) {
+				// Everything to the left is below the range
+				current = nil
+			} else {
+				current = current.left
+			}
+		}
+		current = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if st.less(max, current.value) {
+			break
+		}
+		if !st.less(current.value, min) {
+			result = append(result, current.value)
+		}
+		current = current.right
+	}
+	return result
+}
+
+// String returns a string representation of the tree.
+func (st *SplayTree[T]) String() string {
+	return fmt.Sprintf("SplayTree%v", st.InOrder())
+}
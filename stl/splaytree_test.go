@@ -0,0 +1,63 @@
+package stl
+
+import "testing"
+
+func TestSplayTreeBasicOperations(t *testing.T) {
+	tree := NewSplayTreeFromSlice([]int{50, 30, 70, 20, 40, 60, 80, 30}, lessInt)
+
+	if tree.Size() != 7 {
+		t.Errorf("Expected size 7, got %d", tree.Size())
+	}
+	if !tree.Search(40) || tree.Search(45) {
+		t.Error("Search returned an unexpected result")
+	}
+	if !tree.Delete(30) || tree.Delete(30) {
+		t.Error("Expected Delete to succeed once")
+	}
+	if tree.String() != "SplayTree[20 40 50 60 70 80]" {
+		t.Errorf("Unexpected tree %s", tree.String())
+	}
+
+	if value, _ := tree.Min(); value != 20 {
+		t.Errorf("Expected min 20, got %d", value)
+	}
+	if value, _ := tree.Max(); value != 80 {
+		t.Errorf("Expected max 80, got %d", value)
+	}
+	if values := tree.Range(35, 65); len(values) != 3 || values[0] != 40 || values[2] != 60 {
+		t.Errorf("Expected range [40 50 60], got %v", values)
+	}
+
+	tree.Clear()
+	if !tree.IsEmpty() || tree.Height() != -1 {
+		t.Error("Tree should be empty after Clear")
+	}
+}
+
+func TestSplayTreeMovesAccessedValueToRoot(t *testing.T) {
+	tree := NewSplayTree[int](lessInt)
+	const n = 1000
+	for i := 0; i < n; i++ {
+		tree.Insert(i)
+	}
+
+	for _, value := range []int{0, 500, 999, 250} {
+		tree.Search(value)
+		if root, _ := tree.Root(); root != value {
+			t.Errorf("Expected %d at the root, got %d", value, root)
+		}
+	}
+
+	for i := 0; i < n; i += 2 {
+		tree.Delete(i)
+	}
+	values := tree.InOrder()
+	if len(values) != n/2 || tree.Size() != n/2 {
+		t.Fatalf("Expected %d values, got %d", n/2, len(values))
+	}
+	for i, value := range values {
+		if value != 2*i+1 {
+			t.Fatalf("Expected %d at index %d, got %d", 2*i+1, i, value)
+		}
+	}
+}
@@ -2,18 +2,24 @@ package stl
 
 import (
 	"fmt"
+	"iter"
+	"slices"
 	"sort"
 )
 
 // Stack represents a LIFO (Last In, First Out) data structure.
 type Stack[T any] struct {
-	data []T
+	data   []T
+	equals func(T, T) bool
 }
 
-// NewStack creates a new empty stack.
-func NewStack[T any]() *Stack[T] {
+// NewStack creates a new empty stack. It honors WithCapacity, and WithEquals to replace the
+// default comparison of elements with reflect.DeepEqual in Contains, IndexOf, and Equals.
+func NewStack[T any](opts ...Option) *Stack[T] {
+	o := applyOptions(opts)
 	return &Stack[T]{
-		data: make([]T, 0),
+		data:   make([]T, 0, o.capacity),
+		equals: equalsOf[T](o, nil),
 	}
 }
 
@@ -55,6 +61,26 @@ func (s *Stack[T]) Peek() (T, bool) {
 	return s.data[len(s.data)-1], true
 }
 
+// PopE is like Pop but returns ErrEmpty if the stack is empty.
+func (s *Stack[T]) PopE() (T, error) {
+	return orErrEmpty(s.Pop())
+}
+
+// MustPop is like Pop but panics if the stack is empty.
+func (s *Stack[T]) MustPop() T {
+	return must(s.PopE())
+}
+
+// PeekE is like Peek but returns ErrEmpty if the stack is empty.
+func (s *Stack[T]) PeekE() (T, error) {
+	return orErrEmpty(s.Peek())
+}
+
+// MustPeek is like Peek but panics if the stack is empty.
+func (s *Stack[T]) MustPeek() T {
+	return must(s.PeekE())
+}
+
 // Size returns the number of elements in the stack.
 func (s *Stack[T]) Size() int {
 	return len(s.data)
@@ -72,9 +98,13 @@ func (s *Stack[T]) Clear() {
 
 // ToSlice returns a copy of the stack as a slice.
 func (s *Stack[T]) ToSlice() []T {
-	result := make([]T, len(s.data))
-	copy(result, s.data)
-	return result
+	return s.AppendTo(make([]T, 0, len(s.data)))
+}
+
+// AppendTo appends the elements to dst in the order of ToSlice and returns the extended
+// slice.
+func (s *Stack[T]) AppendTo(dst []T) []T {
+	return append(dst, s.data...)
 }
 
 // String returns a string representation of the stack.
@@ -89,6 +119,26 @@ func (s *Stack[T]) ForEach(fn func(T)) {
 	}
 }
 
+// All returns an iterator over the elements from bottom to top.
+func (s *Stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range s.data {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Iterator returns a bidirectional iterator over the elements from bottom to top.
+func (s *Stack[T]) Iterator() BidirectionalIterator[T] {
+	return newIndexIterator(
+		func(i int) T { return s.data[i] },
+		s.Size,
+		func(i int) { s.data = slices.Delete(s.data, i, i+1) },
+	)
+}
+
 // ForEachReversed applies a function to each element in the stack (from top to bottom).
 func (s *Stack[T]) ForEachReversed(fn func(T)) {
 	for i := len(s.data) - 1; i >= 0; i-- {
@@ -96,9 +146,55 @@ func (s *Stack[T]) ForEachReversed(fn func(T)) {
 	}
 }
 
+// Reduce folds the elements into a single value, starting from initial and visiting the
+// elements from bottom to top.
+func (s *Stack[T]) Reduce(initial T, fn func(T, T) T) T {
+	result := initial
+	s.ForEach(func(element T) {
+		result = fn(result, element)
+	})
+	return result
+}
+
+// CountIf returns the number of elements that satisfy the predicate.
+func (s *Stack[T]) CountIf(predicate func(T) bool) int {
+	count := 0
+	s.ForEach(func(element T) {
+		if predicate(element) {
+			count++
+		}
+	})
+	return count
+}
+
+// Min returns the smallest element according to less, or false if the stack is empty.
+func (s *Stack[T]) Min(less func(T, T) bool) (T, bool) {
+	var result T
+	found := false
+	s.ForEach(func(element T) {
+		if !found || less(element, result) {
+			result, found = element, true
+		}
+	})
+	return result, found
+}
+
+// Max returns the largest element according to less, or false if the stack is empty.
+func (s *Stack[T]) Max(less func(T, T) bool) (T, bool) {
+	var result T
+	found := false
+	s.ForEach(func(element T) {
+		if !found || less(result, element) {
+			result, found = element, true
+		}
+	})
+	return result, found
+}
+
 // Filter returns a new stack containing elements that satisfy the predicate.
 func (s *Stack[T]) Filter(predicate func(T) bool) *Stack[T] {
 	result := NewStack[T]()
+	result.equals = s.equals
 	for _, item := range s.data {
 		if predicate(item) {
 			result.Push(item)
@@ -110,6 +206,7 @@ func (s *Stack[T]) Filter(predicate func(T) bool) *Stack[T] {
 // Map applies a transformation function to each element and returns a new stack.
 func (s *Stack[T]) Map(transform func(T) T) *Stack[T] {
 	result := NewStack[T]()
+	result.equals = s.equals
 	for _, item := range s.data {
 		result.Push(transform(item))
 	}
@@ -119,10 +216,22 @@ func (s *Stack[T]) Map(transform func(T) T) *Stack[T] {
 // Clone creates a deep copy of the stack.
 func (s *Stack[T]) Clone() *Stack[T] {
 	result := NewStackWithCapacity[T](len(s.data))
+	result.equals = s.equals
 	result.PushAll(s.data)
 	return result
 }
 
+// CloneWith creates a copy of the stack whose elements are copied by cloneElem, for a deep
+// copy of stacks of pointers or slices.
+func (s *Stack[T]) CloneWith(cloneElem func(T) T) *Stack[T] {
+	result := NewStackWithCapacity[T](len(s.data))
+	result.equals = s.equals
+	for _, item := range s.data {
+		result.data = append(result.data, cloneElem(item))
+	}
+	return result
+}
+
 // Equals checks if two stacks contain the same elements in the same order.
 func (s *Stack[T]) Equals(other *Stack[T]) bool {
 	if s.Size() != other.Size() {
@@ -130,7 +239,7 @@ func (s *Stack[T]) Equals(other *Stack[T]) bool {
 	}
 
 	for i, item := range s.data {
-		if fmt.Sprintf("%v", item) != fmt.Sprintf("%v", other.data[i]) {
+		if !elementsEqual(s.equals, item, other.data[i]) {
 			return false
 		}
 	}
@@ -183,7 +292,7 @@ func (s *Stack[T]) InsertAt(index int, item T) bool {
 // Contains checks if the stack contains an element.
 func (s *Stack[T]) Contains(item T) bool {
 	for _, element := range s.data {
-		if fmt.Sprintf("%v", element) == fmt.Sprintf("%v", item) {
+		if elementsEqual(s.equals, element, item) {
 			return true
 		}
 	}
@@ -193,7 +302,7 @@ func (s *Stack[T]) Contains(item T) bool {
 // IndexOf returns the index of the first occurrence of an element.
 func (s *Stack[T]) IndexOf(item T) int {
 	for i, element := range s.data {
-		if fmt.Sprintf("%v", element) == fmt.Sprintf("%v", item) {
+		if elementsEqual(s.equals, element, item) {
 			return i
 		}
 	}
@@ -203,7 +312,7 @@ func (s *Stack[T]) IndexOf(item T) int {
 // LastIndexOf returns the index of the last occurrence of an element.
 func (s *Stack[T]) LastIndexOf(item T) int {
 	for i := len(s.data) - 1; i >= 0; i-- {
-		if fmt.Sprintf("%v", s.data[i]) == fmt.Sprintf("%v", item) {
+		if elementsEqual(s.equals, s.data[i], item) {
 			return i
 		}
 	}
@@ -223,7 +332,7 @@ func (s *Stack[T]) Remove(item T) bool {
 func (s *Stack[T]) RemoveAll(item T) int {
 	count := 0
 	for i := len(s.data) - 1; i >= 0; i-- {
-		if fmt.Sprintf("%v", s.data[i]) == fmt.Sprintf("%v", item) {
+		if elementsEqual(s.equals, s.data[i], item) {
 			s.RemoveAt(i)
 			count++
 		}
@@ -113,3 +113,31 @@ func TestStackContains(t *testing.T) {
 		t.Error("Stack should not contain element 4")
 	}
 }
+
+func TestStackAll(t *testing.T) {
+	stack := NewStack[int]()
+	stack.PushAll([]int{1, 2, 3})
+
+	var got []int
+	for x := range stack.All() {
+		got = append(got, x)
+	}
+	if len(got) != 3 || got[0] != 1 || got[2] != 3 {
+		t.Errorf("Expected bottom to top [1 2 3], got %v", got)
+	}
+}
+
+func TestStackAggregations(t *testing.T) {
+	stack := NewStack[int]()
+	stack.PushAll([]int{1, 2, 3})
+	// Subtraction is order-sensitive: ((10 - 1) - 2) - 3 visits bottom to top
+	if result := stack.Reduce(10, func(a, b int) int { return a - b }); result != 4 {
+		t.Errorf("Expected 4, got %d", result)
+	}
+	if largest, ok := stack.Max(lessInt); !ok || largest != 3 {
+		t.Errorf("Expected max 3, got %d", largest)
+	}
+	if count := stack.CountIf(func(x int) bool { return x%2 == 1 }); count != 2 {
+		t.Errorf("Expected 2 odd elements, got %d", count)
+	}
+}
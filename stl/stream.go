@@ -0,0 +1,162 @@
+package stl
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"sort"
+)
+
+// Stream is a lazy pipeline over a sequence. Intermediate operations such as Filter and Map
+// only describe the pipeline; nothing runs until a terminal operation such as ToSlice or
+// ToSet pulls elements through it, one at a time and without intermediate containers.
+// A stream can be consumed again if its source sequence can.
+type Stream[T comparable] struct {
+	seq iter.Seq[T]
+}
+
+// NewStream creates a stream over a sequence, such as the All iterator of a container.
+func NewStream[T comparable](seq iter.Seq[T]) *Stream[T] {
+	return &Stream[T]{seq: seq}
+}
+
+// NewStreamFromSlice creates a stream over the elements of a slice.
+func NewStreamFromSlice[T comparable](slice []T) *Stream[T] {
+	return NewStream(slices.Values(slice))
+}
+
+// MapStream returns a stream that applies fn to each element, which may change the element
+// type.
+func MapStream[T, U comparable](s *Stream[T], fn func(T) U) *Stream[U] {
+	return NewStream(func(yield func(U) bool) {
+		for element := range s.seq {
+			if !yield(fn(element)) {
+				return
+			}
+		}
+	})
+}
+
+// Filter returns a stream of the elements that satisfy the predicate.
+func (s *Stream[T]) Filter(predicate func(T) bool) *Stream[T] {
+	return NewStream(func(yield func(T) bool) {
+		for element := range s.seq {
+			if predicate(element) && !yield(element) {
+				return
+			}
+		}
+	})
+}
+
+// Map returns a stream that applies fn to each element.
+func (s *Stream[T]) Map(fn func(T) T) *Stream[T] {
+	return MapStream(s, fn)
+}
+
+// Take returns a stream of at most the first n elements. The source is not read past them.
+func (s *Stream[T]) Take(n int) *Stream[T] {
+	return NewStream(func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		for element := range s.seq {
+			taken++
+			if !yield(element) || taken == n {
+				return
+			}
+		}
+	})
+}
+
+// Skip returns a stream without the first n elements.
+func (s *Stream[T]) Skip(n int) *Stream[T] {
+	return NewStream(func(yield func(T) bool) {
+		skipped := 0
+		for element := range s.seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(element) {
+				return
+			}
+		}
+	})
+}
+
+// Distinct returns a stream that drops repeated elements, keeping the first occurrence.
+func (s *Stream[T]) Distinct() *Stream[T] {
+	return NewStream(func(yield func(T) bool) {
+		seen := make(map[T]struct{})
+		for element := range s.seq {
+			if _, ok := seen[element]; ok {
+				continue
+			}
+			seen[element] = struct{}{}
+			if !yield(element) {
+				return
+			}
+		}
+	})
+}
+
+// Sorted returns a stream of the elements stably sorted by less. It must read the whole
+// source before producing its first element.
+func (s *Stream[T]) Sorted(less func(T, T) bool) *Stream[T] {
+	return NewStream(func(yield func(T) bool) {
+		elements := slices.Collect(s.seq)
+		sort.SliceStable(elements, func(i, j int) bool {
+			return less(elements[i], elements[j])
+		})
+		for _, element := range elements {
+			if !yield(element) {
+				return
+			}
+		}
+	})
+}
+
+// All returns the stream as a sequence.
+func (s *Stream[T]) All() iter.Seq[T] {
+	return s.seq
+}
+
+// ForEach runs the pipeline and applies fn to each element.
+func (s *Stream[T]) ForEach(fn func(T)) {
+	for element := range s.seq {
+		fn(element)
+	}
+}
+
+// Collect runs the pipeline and passes each element to add, such as a container's Add or
+// Push method.
+func (s *Stream[T]) Collect(add func(T)) {
+	s.ForEach(add)
+}
+
+// ToSlice runs the pipeline and returns the elements.
+func (s *Stream[T]) ToSlice() []T {
+	return slices.Collect(s.seq)
+}
+
+// ToSet runs the pipeline and returns the elements as a set.
+func (s *Stream[T]) ToSet() *Set[T] {
+	set := NewSet[T]()
+	s.Collect(set.Add)
+	return set
+}
+
+// Count runs the pipeline and returns the number of elements.
+func (s *Stream[T]) Count() int {
+	count := 0
+	for range s.seq {
+		count++
+	}
+	return count
+}
+
+// String runs the pipeline and returns a string representation of the elements.
+func (s *Stream[T]) String() string {
+	return fmt.Sprintf("Stream%v", s.ToSlice())
+}
@@ -0,0 +1,62 @@
+package stl
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestStreamPipeline(t *testing.T) {
+	deque := NewDequeFromSlice([]int{5, 3, 8, 3, 1, 8, 9, 2})
+	got := NewStream(deque.All()).
+		Filter(func(x int) bool { return x > 1 }).
+		Distinct().
+		Sorted(lessInt).
+		Skip(1).
+		Take(3).
+		ToSlice()
+	if !slices.Equal(got, []int{3, 5, 8}) {
+		t.Errorf("Expected [3 5 8], got %v", got)
+	}
+
+	labels := MapStream(NewStreamFromSlice([]int{1, 2, 2}), strconv.Itoa).ToSet()
+	if labels.Size() != 2 || !labels.Contains("2") {
+		t.Errorf("Expected {1, 2} as strings, got %v", labels)
+	}
+
+	stack := NewStack[int]()
+	NewStreamFromSlice([]int{1, 2, 3}).Map(func(x int) int { return x * 10 }).Collect(stack.Push)
+	if top, _ := stack.Peek(); top != 30 || stack.Size() != 3 {
+		t.Errorf("Expected stack [10 20 30], got %v", stack)
+	}
+}
+
+func TestStreamIsLazy(t *testing.T) {
+	pulled := 0
+	source := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	// Take stops an infinite source after enough elements pass the filter
+	stream := NewStream(source).Filter(func(x int) bool { return x%2 == 0 }).Take(3)
+	if pulled != 0 {
+		t.Errorf("Expected no elements to be pulled before a terminal operation, got %d", pulled)
+	}
+	if got := stream.ToSlice(); !slices.Equal(got, []int{0, 2, 4}) || pulled != 5 {
+		t.Errorf("Expected [0 2 4] after pulling 5 elements, got %v after %d", got, pulled)
+	}
+
+	// A stream over a container can be run again
+	set := NewStream(NewSetFromSlice([]int{1, 2, 3}).All())
+	if set.Count() != 3 || set.Count() != 3 {
+		t.Error("Expected a stream over a container to be reusable")
+	}
+	if NewStreamFromSlice([]int{1}).Take(0).Count() != 0 {
+		t.Error("Expected Take(0) to produce nothing")
+	}
+}
@@ -0,0 +1,94 @@
+package stl
+
+import (
+	"fmt"
+	"sort"
+)
+
+// TopK keeps the k greatest values of a stream according to a comparator, evicting the
+// smallest kept value when a better one arrives.
+type TopK[T any] struct {
+	k    int
+	less func(T, T) bool
+	heap *PriorityQueue[T] // min-heap, so the first value to evict is at the root
+}
+
+// NewTopK creates a collector for the k greatest values according to less. Pass a reversed
+// comparator to keep the k smallest instead. Non-positive k keeps nothing.
+func NewTopK[T any](k int, less func(T, T) bool) *TopK[T] {
+	k = max(k, 0)
+	return &TopK[T]{
+		k:    k,
+		less: less,
+		heap: NewPriorityQueueWithCapacity[T](k, less),
+	}
+}
+
+// Offer considers a value for the collection and returns true if it was kept.
+func (tk *TopK[T]) Offer(x T) bool {
+	if tk.k == 0 {
+		return false
+	}
+	if tk.heap.Size() < tk.k {
+		tk.heap.Enqueue(x)
+		return true
+	}
+	if !tk.less(tk.heap.data[0], x) {
+		return false
+	}
+
+	// Replace the evicted root in place instead of a separate Dequeue and Enqueue
+	tk.heap.data[0] = x
+	tk.heap.down(0)
+	return true
+}
+
+// OfferAll considers every value of the slice.
+func (tk *TopK[T]) OfferAll(values []T) {
+	for _, value := range values {
+		tk.Offer(value)
+	}
+}
+
+// Items returns the kept values sorted from greatest to smallest.
+func (tk *TopK[T]) Items() []T {
+	items := tk.heap.ToSlice()
+	sort.SliceStable(items, func(i, j int) bool { return tk.less(items[j], items[i]) })
+	return items
+}
+
+// Threshold returns the smallest kept value, which a new value must beat once the
+// collector is full.
+func (tk *TopK[T]) Threshold() (T, bool) {
+	return tk.heap.Peek()
+}
+
+// K returns the maximum number of values kept.
+func (tk *TopK[T]) K() int {
+	return tk.k
+}
+
+// Size returns the number of values currently kept.
+func (tk *TopK[T]) Size() int {
+	return tk.heap.Size()
+}
+
+// IsEmpty returns true if no values are kept.
+func (tk *TopK[T]) IsEmpty() bool {
+	return tk.heap.IsEmpty()
+}
+
+// IsFull returns true if k values are kept.
+func (tk *TopK[T]) IsFull() bool {
+	return tk.heap.Size() == tk.k
+}
+
+// Clear removes all kept values.
+func (tk *TopK[T]) Clear() {
+	tk.heap.Clear()
+}
+
+// String returns a string representation of the kept values, greatest first.
+func (tk *TopK[T]) String() string {
+	return fmt.Sprintf("TopK%v", tk.Items())
+}
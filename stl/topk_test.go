@@ -0,0 +1,60 @@
+package stl
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestTopKBasicOperations(t *testing.T) {
+	tk := NewTopK(3, lessInt)
+
+	for _, value := range []int{5, 1, 9, 3} {
+		tk.Offer(value)
+	}
+	if tk.Offer(2) {
+		t.Error("A value below the threshold should be rejected")
+	}
+	if !tk.Offer(7) {
+		t.Error("A value above the threshold should be kept")
+	}
+	if tk.String() != "TopK[9 7 5]" {
+		t.Errorf("Expected TopK[9 7 5], got %s", tk.String())
+	}
+	if threshold, _ := tk.Threshold(); threshold != 5 || !tk.IsFull() {
+		t.Errorf("Expected threshold 5, got %d", threshold)
+	}
+
+	smallest := NewTopK(2, func(a, b string) bool { return a > b })
+	smallest.OfferAll([]string{"pear", "apple", "fig", "banana"})
+	if items := smallest.Items(); items[0] != "apple" || items[1] != "banana" {
+		t.Errorf("Expected [apple banana], got %v", items)
+	}
+
+	if NewTopK(0, lessInt).Offer(1) {
+		t.Error("A zero-capacity collector should keep nothing")
+	}
+
+	tk.Clear()
+	if !tk.IsEmpty() || tk.K() != 3 {
+		t.Error("Collector should be empty after Clear")
+	}
+}
+
+func TestTopKMatchesSort(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	values := make([]int, 5000)
+	tk := NewTopK(25, lessInt)
+	for i := range values {
+		values[i] = r.Intn(100000)
+		tk.Offer(values[i])
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(values)))
+	items := tk.Items()
+	for i := range items {
+		if items[i] != values[i] {
+			t.Fatalf("Item %d: expected %d, got %d", i, values[i], items[i])
+		}
+	}
+}
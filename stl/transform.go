@@ -0,0 +1,89 @@
+package stl
+
+import "iter"
+
+// MapTo applies fn to each element of a collection, in its iteration order, and returns the
+// results. Unlike the Map methods, the result may have a different element type.
+func MapTo[T, U any](c Collection[T], fn func(T) U) []U {
+	result := make([]U, 0, c.Size())
+	c.ForEach(func(element T) {
+		result = append(result, fn(element))
+	})
+	return result
+}
+
+// FilterTo returns the elements of a collection that satisfy the predicate, in its iteration
+// order.
+func FilterTo[T any](c Collection[T], predicate func(T) bool) []T {
+	var result []T
+	c.ForEach(func(element T) {
+		if predicate(element) {
+			result = append(result, element)
+		}
+	})
+	return result
+}
+
+// Reduce folds the elements of a collection into an accumulator, starting from initial and
+// visiting elements in iteration order.
+func Reduce[T, A any](c Collection[T], initial A, fn func(A, T) A) A {
+	acc := initial
+	c.ForEach(func(element T) {
+		acc = fn(acc, element)
+	})
+	return acc
+}
+
+// FlatMap applies fn to each element of a collection and concatenates the resulting slices.
+func FlatMap[T, U any](c Collection[T], fn func(T) []U) []U {
+	var result []U
+	c.ForEach(func(element T) {
+		result = append(result, fn(element)...)
+	})
+	return result
+}
+
+// Sum returns the sum of the elements of a sequence, such as the All iterator of a container.
+func Sum[T Number](seq iter.Seq[T]) T {
+	var total T
+	for element := range seq {
+		total += element
+	}
+	return total
+}
+
+// GroupBy collects the elements of a sequence into a multimap keyed by keyFn. Elements with
+// the same key keep their sequence order.
+func GroupBy[K comparable, V any](seq iter.Seq[V], keyFn func(V) K) *MultiMap[K, V] {
+	groups := NewMultiMap[K, V]()
+	for element := range seq {
+		groups.Put(keyFn(element), element)
+	}
+	return groups
+}
+
+// Partition splits the elements of a collection into those that satisfy the predicate and
+// the rest, each in iteration order.
+func Partition[T any](c Collection[T], predicate func(T) bool) (matching, rest []T) {
+	c.ForEach(func(element T) {
+		if predicate(element) {
+			matching = append(matching, element)
+		} else {
+			rest = append(rest, element)
+		}
+	})
+	return matching, rest
+}
+
+// identity returns its argument; it is the element function of a plain Clone.
+func identity[T any](value T) T {
+	return value
+}
+
+// orIdentity returns fn, or identity if fn is nil.
+func orIdentity[T any](fn func(T) T) func(T) T {
+	if fn == nil {
+		return identity[T]
+	}
+	return fn
+}
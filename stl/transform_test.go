@@ -0,0 +1,83 @@
+package stl
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMapTo(t *testing.T) {
+	got := MapTo(NewDequeFromSlice([]int{1, 2, 3}), strconv.Itoa)
+	if !slices.Equal(got, []string{"1", "2", "3"}) {
+		t.Errorf("Expected [1 2 3] as strings, got %q", got)
+	}
+	if got := MapTo(NewStack[int](), strconv.Itoa); len(got) != 0 {
+		t.Errorf("Expected an empty result, got %v", got)
+	}
+}
+
+func TestFilterTo(t *testing.T) {
+	got := FilterTo(NewTreeSetFromSlice([]int{5, 2, 4, 1}, lessInt), func(x int) bool { return x%2 == 0 })
+	if !slices.Equal(got, []int{2, 4}) {
+		t.Errorf("Expected [2 4], got %v", got)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	words := NewLinkedListFromSlice([]string{"go", "stl", "lib"})
+	length := Reduce(words, 0, func(acc int, word string) int { return acc + len(word) })
+	if length != 8 {
+		t.Errorf("Expected total length 8, got %d", length)
+	}
+	joined := Reduce(words, "", func(acc, word string) string { return acc + word })
+	if joined != "gostllib" {
+		t.Errorf("Expected gostllib, got %s", joined)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	queue := NewQueue[string]()
+	queue.EnqueueAll([]string{"a b", "c"})
+	got := FlatMap(queue, strings.Fields)
+	if !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("Expected [a b c], got %v", got)
+	}
+}
+
+func TestSum(t *testing.T) {
+	if sum := Sum(NewSetFromSlice([]float64{0.5, 1.5}).All()); sum != 2 {
+		t.Errorf("Expected 2, got %v", sum)
+	}
+	if sum := Sum(NewStack[int]().All()); sum != 0 {
+		t.Errorf("Expected 0 for an empty stack, got %d", sum)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	words := NewLinkedListFromSlice([]string{"go", "stl", "map", "set", "a"})
+	groups := GroupBy(slices.Values(words.ToSlice()), func(word string) int { return len(word) })
+	if groups.KeySize() != 3 || groups.Size() != 5 {
+		t.Errorf("Expected 3 groups of 5 words, got %v", groups)
+	}
+	if got := groups.Get(3); !slices.Equal(got, []string{"stl", "map", "set"}) {
+		t.Errorf("Expected [stl map set] in sequence order, got %v", got)
+	}
+
+	// Any container's All iterator can feed a grouping
+	parity := GroupBy(NewDequeFromSlice([]int{1, 2, 3, 4}).All(), func(x int) bool { return x%2 == 0 })
+	if got := parity.Get(true); !slices.Equal(got, []int{2, 4}) {
+		t.Errorf("Expected evens [2 4], got %v", got)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	matching, rest := Partition(NewDequeFromSlice([]int{1, 2, 3, 4, 5}), func(x int) bool { return x > 3 })
+	if !slices.Equal(matching, []int{4, 5}) || !slices.Equal(rest, []int{1, 2, 3}) {
+		t.Errorf("Expected [4 5] and [1 2 3], got %v and %v", matching, rest)
+	}
+	matching, rest = Partition(NewSet[int](), func(int) bool { return true })
+	if matching != nil || rest != nil {
+		t.Errorf("Expected nil halves for an empty set, got %v and %v", matching, rest)
+	}
+}
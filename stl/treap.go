@@ -0,0 +1,387 @@
+package stl
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// treapNode represents a node in a Treap.
+type treapNode[T comparable] struct {
+	value    T
+	priority int64
+	left     *treapNode[T]
+	right    *treapNode[T]
+	size     int
+}
+
+// Treap represents a randomized balanced binary search tree of unique values. Nodes are ordered
+// by value and heap-ordered by random priority, giving O(log n) expected operations. Split and
+// Merge move whole key ranges between treaps in O(log n) expected time.
+type Treap[T comparable] struct {
+	root *treapNode[T]
+	less func(T, T) bool
+	rng  *rand.Rand
+}
+
+// NewTreap creates a new empty Treap with a comparator function and time-seeded priorities.
+// WithRandSource supplies the priorities instead, as NewTreapWithSource does.
+func NewTreap[T comparable](less func(T, T) bool, opts ...Option) *Treap[T] {
+	return NewTreapWithSource[T](less, applyOptions(opts).source)
+}
+
+// NewTreapWithSource creates a new empty Treap drawing priorities from src, for reproducible
+// tree shapes. A nil src uses a time-seeded source.
+func NewTreapWithSource[T comparable](less func(T, T) bool, src rand.Source) *Treap[T] {
+	return &Treap[T]{
+		less: less,
+		rng:  newRand(src),
+	}
+}
+
+// NewTreapFromSlice creates a Treap from a slice, ignoring duplicates.
+func NewTreapFromSlice[T comparable](slice []T, less func(T, T) bool) *Treap[T] {
+	treap := NewTreap[T](less)
+	for _, item := range slice {
+		treap.Insert(item)
+	}
+	return treap
+}
+
+// treapSize returns the number of nodes in a subtree.
+func treapSize[T comparable](node *treapNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+// update recomputes the cached size of node.
+func (t *Treap[T]) update(node *treapNode[T]) *treapNode[T] {
+	if node != nil {
+		node.size = 1 + treapSize(node.left) + treapSize(node.right)
+	}
+	return node
+}
+
+// split divides a subtree into the nodes with values less than key and the rest.
+func (t *Treap[T]) split(node *treapNode[T], key T) (*treapNode[T], *treapNode[T]) {
+	if node == nil {
+		return nil, nil
+	}
+	if t.less(node.value, key) {
+		left, right := t.split(node.right, key)
+		node.right = left
+		return t.update(node), right
+	}
+	left, right := t.split(node.left, key)
+	node.left = right
+	return left, t.update(node)
+}
+
+// join concatenates two subtrees where every value in left is less than every value in right.
+func (t *Treap[T]) join(left, right *treapNode[T]) *treapNode[T] {
+	switch {
+	case left == nil:
+		return right
+	case right == nil:
+		return left
+	case left.priority > right.priority:
+		left.right = t.join(left.right, right)
+		return t.update(left)
+	default:
+		right.left = t.join(left, right.left)
+		return t.update(right)
+	}
+}
+
+// union combines two subtrees with arbitrary overlapping values, dropping duplicates from b.
+func (t *Treap[T]) union(a, b *treapNode[T]) *treapNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if a.priority < b.priority {
+		a, b = b, a
+	}
+
+	less, rest := t.split(b, a.value)
+	greater, _ := t.removeIfMin(rest, a.value)
+
+	a.left = t.union(a.left, less)
+	a.right = t.union(a.right, greater)
+	return t.update(a)
+}
+
+// removeIfMin removes the leftmost node of a subtree if its value equals key and reports
+// whether it did.
+func (t *Treap[T]) removeIfMin(node *treapNode[T], key T) (*treapNode[T], bool) {
+	if node == nil {
+		return nil, false
+	}
+	if node.left == nil {
+		if node.value == key {
+			return node.right, true
+		}
+		return node, false
+	}
+	left, removed := t.removeIfMin(node.left, key)
+	node.left = left
+	return t.update(node), removed
+}
+
+// Insert adds a value to the treap. Inserting a value that is already present has no effect.
+func (t *Treap[T]) Insert(value T) {
+	if t.Search(value) {
+		return
+	}
+	left, right := t.split(t.root, value)
+	node := &treapNode[T]{value: value, priority: t.rng.Int63(), size: 1}
+	t.root = t.join(t.join(left, node), right)
+}
+
+// Delete removes a value from the treap and reports whether it was present.
+func (t *Treap[T]) Delete(value T) bool {
+	var parent *treapNode[T]
+	current := t.root
+
+	// Match with the comparator, like Search, so equivalent values are found too
+	for current != nil && (t.less(value, current.value) || t.less(current.value, value)) {
+		parent = current
+		if t.less(value, current.value) {
+			current = current.left
+		} else {
+			current = current.right
+		}
+	}
+	if current == nil {
+		return false
+	}
+
+	merged := t.join(current.left, current.right)
+	switch {
+	case parent == nil:
+		t.root = merged
+	case parent.left == current:
+		parent.left = merged
+	default:
+		parent.right = merged
+	}
+
+	// Fix cached sizes along the search path
+	for node := t.root; node != nil && node != merged; {
+		node.size--
+		if t.less(value, node.value) {
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	return true
+}
+
+// Search checks if a value exists in the treap.
+func (t *Treap[T]) Search(value T) bool {
+	current := t.root
+	for current != nil {
+		switch {
+		case t.less(value, current.value):
+			current = current.left
+		case t.less(current.value, value):
+			current = current.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Split removes every value greater than or equal to key from t and returns them as a new
+// treap. Afterwards t holds only the values less than key.
+func (t *Treap[T]) Split(key T) *Treap[T] {
+	left, right := t.split(t.root, key)
+	t.root = left
+	return &Treap[T]{root: right, less: t.less, rng: t.rng}
+}
+
+// ExtractRange removes every value between min and max (inclusive) from t and returns them as
+// a new treap.
+func (t *Treap[T]) ExtractRange(min, max T) *Treap[T] {
+	left, rest := t.split(t.root, min)
+	middle, right := t.splitAfter(rest, max)
+	t.root = t.join(left, right)
+	return &Treap[T]{root: middle, less: t.less, rng: t.rng}
+}
+
+// splitAfter divides a subtree into the nodes with values less than or equal to key and the rest.
+func (t *Treap[T]) splitAfter(node *treapNode[T], key T) (*treapNode[T], *treapNode[T]) {
+	if node == nil {
+		return nil, nil
+	}
+	if !t.less(key, node.value) {
+		left, right := t.splitAfter(node.right, key)
+		node.right = left
+		return t.update(node), right
+	}
+	left, right := t.splitAfter(node.left, key)
+	node.left = right
+	return left, t.update(node)
+}
+
+// Merge moves every value of other into t, leaving other empty. Overlapping ranges are
+// allowed; when every value of t is less than every value of other the two are simply joined.
+func (t *Treap[T]) Merge(other *Treap[T]) {
+	if maxT, ok := t.Max(); ok {
+		if minOther, ok := other.Min(); ok && t.less(maxT, minOther) {
+			t.root = t.join(t.root, other.root)
+			other.root = nil
+			return
+		}
+	}
+	t.root = t.union(t.root, other.root)
+	other.root = nil
+}
+
+// Size returns the number of values in the treap.
+func (t *Treap[T]) Size() int {
+	return treapSize(t.root)
+}
+
+// IsEmpty checks if the treap is empty.
+func (t *Treap[T]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Clear removes all values from the treap.
+func (t *Treap[T]) Clear() {
+	t.root = nil
+}
+
+// Min returns the minimum value in the treap.
+func (t *Treap[T]) Min() (T, bool) {
+	if t.root == nil {
+		var zero T
+		return zero, false
+	}
+	current := t.root
+	for current.left != nil {
+		current = current.left
+	}
+	return current.value, true
+}
+
+// Max returns the maximum value in the treap.
+func (t *Treap[T]) Max() (T, bool) {
+	if t.root == nil {
+		var zero T
+		return zero, false
+	}
+	current := t.root
+	for current.right != nil {
+		current = current.right
+	}
+	return current.value, true
+}
+
+// Rank returns the number of values less than the given value.
+func (t *Treap[T]) Rank(value T) int {
+	rank := 0
+	current := t.root
+	for current != nil {
+		if t.less(current.value, value) {
+			rank += 1 + treapSize(current.left)
+			current = current.right
+		} else {
+			current = current.left
+		}
+	}
+	return rank
+}
+
+// Select returns the value with the given rank.
+func (t *Treap[T]) Select(rank int) (T, bool) {
+	if rank < 0 || rank >= t.Size() {
+		var zero T
+		return zero, false
+	}
+
+	current := t.root
+	for {
+		leftSize := treapSize(current.left)
+		switch {
+		case rank < leftSize:
+			current = current.left
+		case rank > leftSize:
+			rank -= leftSize + 1
+			current = current.right
+		default:
+			return current.value, true
+		}
+	}
+}
+
+// Contains checks if a value exists in the tree.
+func (t *Treap[T]) Contains(value T) bool {
+	return t.Search(value)
+}
+
+// ToSlice returns the values in sorted order.
+func (t *Treap[T]) ToSlice() []T {
+	return t.InOrder()
+}
+
+// InOrder returns the values in sorted order.
+func (t *Treap[T]) InOrder() []T {
+	return t.AppendTo(nil)
+}
+
+// AppendTo appends the values in sorted order to dst and returns the extended slice.
+func (t *Treap[T]) AppendTo(dst []T) []T {
+	t.ForEach(func(value T) {
+		dst = append(dst, value)
+	})
+	return dst
+}
+
+// ForEach applies a function to each value in sorted order.
+func (t *Treap[T]) ForEach(fn func(T)) {
+	t.forEachRecursive(t.root, fn)
+}
+
+// forEachRecursive is the recursive helper for ForEach.
+func (t *Treap[T]) forEachRecursive(node *treapNode[T], fn func(T)) {
+	if node != nil {
+		t.forEachRecursive(node.left, fn)
+		fn(node.value)
+		t.forEachRecursive(node.right, fn)
+	}
+}
+
+// Range returns all values between min and max (inclusive) without modifying the treap.
+func (t *Treap[T]) Range(min, max T) []T {
+	var result []T
+	t.rangeRecursive(t.root, min, max, &result)
+	return result
+}
+
+// rangeRecursive is the recursive helper for Range.
+func (t *Treap[T]) rangeRecursive(node *treapNode[T], min, max T, result *[]T) {
+	if node == nil {
+		return
+	}
+	if t.less(min, node.value) {
+		t.rangeRecursive(node.left, min, max, result)
+	}
+	if !t.less(node.value, min) && !t.less(max, node.value) {
+		*result = append(*result, node.value)
+	}
+	if t.less(node.value, max) {
+		t.rangeRecursive(node.right, min, max, result)
+	}
+}
+
+// String returns a string representation of the treap.
+func (t *Treap[T]) String() string {
+	return fmt.Sprintf("Treap%v", t.InOrder())
+}
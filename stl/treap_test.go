@@ -0,0 +1,134 @@
+package stl
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestTreapBasicOperations(t *testing.T) {
+	treap := NewTreapWithSource[int](lessInt, rand.NewSource(1))
+	for _, value := range []int{50, 30, 70, 20, 40, 60, 80, 30} {
+		treap.Insert(value)
+	}
+
+	if treap.Size() != 7 {
+		t.Errorf("Expected size 7, got %d", treap.Size())
+	}
+	if !treap.Search(40) || treap.Search(45) {
+		t.Error("Search returned an unexpected result")
+	}
+	if !treap.Delete(30) || treap.Delete(30) {
+		t.Error("Expected Delete to succeed once")
+	}
+	if treap.String() != "Treap[20 40 50 60 70 80]" {
+		t.Errorf("Unexpected treap %s", treap.String())
+	}
+	if treap.Size() != 6 {
+		t.Errorf("Expected size 6, got %d", treap.Size())
+	}
+
+	if value, _ := treap.Min(); value != 20 {
+		t.Errorf("Expected min 20, got %d", value)
+	}
+	if value, _ := treap.Max(); value != 80 {
+		t.Errorf("Expected max 80, got %d", value)
+	}
+	if rank := treap.Rank(60); rank != 3 {
+		t.Errorf("Expected rank 3, got %d", rank)
+	}
+	if value, _ := treap.Select(3); value != 60 {
+		t.Errorf("Expected value 60 at rank 3, got %d", value)
+	}
+	if values := treap.Range(35, 65); len(values) != 3 || values[0] != 40 || values[2] != 60 {
+		t.Errorf("Expected range [40 50 60], got %v", values)
+	}
+
+	treap.Clear()
+	if !treap.IsEmpty() {
+		t.Error("Treap should be empty after Clear")
+	}
+	if _, ok := treap.Min(); ok {
+		t.Error("Empty treap should have no minimum")
+	}
+}
+
+func TestTreapSplitAndMerge(t *testing.T) {
+	treap := NewTreapWithSource[int](lessInt, rand.NewSource(2))
+	for i := 0; i < 100; i++ {
+		treap.Insert(i)
+	}
+
+	upper := treap.Split(40)
+	if treap.Size() != 40 || upper.Size() != 60 {
+		t.Errorf("Expected sizes 40 and 60, got %d and %d", treap.Size(), upper.Size())
+	}
+	if value, _ := treap.Max(); value != 39 {
+		t.Errorf("Expected max 39, got %d", value)
+	}
+	if value, _ := upper.Min(); value != 40 {
+		t.Errorf("Expected min 40, got %d", value)
+	}
+
+	treap.Merge(upper)
+	if treap.Size() != 100 || !upper.IsEmpty() {
+		t.Errorf("Expected merged size 100, got %d", treap.Size())
+	}
+
+	middle := treap.ExtractRange(10, 19)
+	if middle.String() != "Treap[10 11 12 13 14 15 16 17 18 19]" {
+		t.Errorf("Unexpected extracted range %s", middle.String())
+	}
+	if treap.Size() != 90 || treap.Search(15) {
+		t.Errorf("Expected extracted values to be removed, got size %d", treap.Size())
+	}
+	if value, _ := treap.Select(10); value != 20 {
+		t.Errorf("Expected value 20 at rank 10, got %d", value)
+	}
+}
+
+func TestTreapMergeOverlapping(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	a := NewTreapWithSource[int](lessInt, rand.NewSource(4))
+	b := NewTreapWithSource[int](lessInt, rand.NewSource(5))
+	expected := NewSet[int]()
+	for i := 0; i < 500; i++ {
+		x, y := r.Intn(300), r.Intn(300)
+		a.Insert(x)
+		b.Insert(y)
+		expected.Add(x)
+		expected.Add(y)
+	}
+
+	a.Merge(b)
+	if a.Size() != expected.Size() {
+		t.Errorf("Expected size %d, got %d", expected.Size(), a.Size())
+	}
+	values := a.InOrder()
+	for i, value := range values {
+		if !expected.Contains(value) {
+			t.Errorf("Unexpected value %d", value)
+		}
+		if i > 0 && values[i-1] >= value {
+			t.Fatalf("Values out of order at %d: %v", i, values)
+		}
+		if rank := a.Rank(value); rank != i {
+			t.Errorf("Expected rank %d for %d, got %d", i, value, rank)
+		}
+	}
+}
+
+func TestTreapDeleteEquivalent(t *testing.T) {
+	lessFold := func(a, b string) bool { return strings.ToLower(a) < strings.ToLower(b) }
+	treap := NewTreapFromSlice([]string{"Apple", "banana", "Cherry", "date"}, lessFold)
+
+	if !treap.Search("APPLE") || !treap.Delete("APPLE") {
+		t.Error("Expected Delete to remove a value Search finds with the comparator")
+	}
+	if treap.Search("apple") || treap.Size() != 3 {
+		t.Errorf("Expected apple gone and 3 values left, got %v", treap.ToSlice())
+	}
+	if !treap.Delete("CHERRY") || treap.Delete("fig") || treap.Size() != 2 {
+		t.Errorf("Expected [banana date], got %v", treap.ToSlice())
+	}
+}
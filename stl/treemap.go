@@ -1,7 +1,9 @@
 package stl
 
 import (
+	"cmp"
 	"fmt"
+	"iter"
 )
 
 // TreeMapNode represents a node in a TreeMap.
@@ -10,24 +12,63 @@ type TreeMapNode[K comparable, V any] struct {
 	Value V
 	Left  *TreeMapNode[K, V]
 	Right *TreeMapNode[K, V]
+
+	// height is the number of nodes on the longest path down to a leaf, used for AVL balancing.
+	height int
+	// size is the number of nodes in the subtree, used for Rank and Select.
+	size int
+	// owner is the owner token of the map that may modify the node in place.
+	owner *treeOwner
 }
 
-// TreeMap represents an ordered map using a binary search tree.
+// treeOwner identifies the map that owns a node. Nodes with any other owner may be shared
+// with a snapshot and are copied before they are modified.
+type treeOwner struct{ _ byte }
+
+// TreeMap represents an ordered map using an AVL tree, a self-balancing binary search tree,
+// so lookups, insertions, and removals take O(log n) even for sorted input.
 type TreeMap[K comparable, V any] struct {
-	root *TreeMapNode[K, V]
-	less func(K, K) bool
-	size int
+	root        *TreeMapNode[K, V]
+	less        func(K, K) bool
+	valueEquals func(V, V) bool
+	size        int
+	// owner is replaced by Snapshot, so nodes created before it are copied on write.
+	owner *treeOwner
 }
 
 // NewTreeMap creates a new empty TreeMap with a comparator function.
-func NewTreeMap[K comparable, V any](less func(K, K) bool) *TreeMap[K, V] {
+// Values are compared with reflect.DeepEqual unless WithEquals supplies a value equality.
+func NewTreeMap[K comparable, V any](less func(K, K) bool, opts ...Option) *TreeMap[K, V] {
+	return NewTreeMapWithValueEquals[K, V](less, equalsOf[V](applyOptions(opts), nil))
+}
+
+// NewOrderedTreeMap creates a new empty TreeMap sorted by the natural order of K.
+// WithComparator replaces the natural order, and WithEquals is honored as by NewTreeMap.
+func NewOrderedTreeMap[K cmp.Ordered, V any](opts ...Option) *TreeMap[K, V] {
+	return NewTreeMap[K, V](comparatorOf(applyOptions(opts), cmp.Less[K]), opts...)
+}
+
+// NewTreeMapWithValueEquals creates a new empty TreeMap that uses valueEquals to compare values
+// in ContainsValue and Equals. A nil valueEquals falls back to reflect.DeepEqual.
+func NewTreeMapWithValueEquals[K comparable, V any](less func(K, K) bool, valueEquals func(V, V) bool) *TreeMap[K, V] {
 	return &TreeMap[K, V]{
-		root: nil,
-		size: 0,
-		less: less,
+		root:        nil,
+		size:        0,
+		less:        less,
+		valueEquals: valueEquals,
 	}
 }
 
+// newEmpty returns an empty TreeMap sharing the comparators of tm.
+func (tm *TreeMap[K, V]) newEmpty() *TreeMap[K, V] {
+	return NewTreeMapWithValueEquals[K, V](tm.less, tm.valueEquals)
+}
+
+// equalValues compares two values with the configured valueEquals or reflect.DeepEqual.
+func (tm *TreeMap[K, V]) equalValues(a, b V) bool {
+	return elementsEqual(tm.valueEquals, a, b)
+}
+
 // NewTreeMapFromMap creates a TreeMap from a regular map.
 func NewTreeMapFromMap[K comparable, V any](m map[K]V, less func(K, K) bool) *TreeMap[K, V] {
 	tm := NewTreeMap[K, V](less)
@@ -37,6 +78,44 @@ func NewTreeMapFromMap[K comparable, V any](m map[K]V, less func(K, K) bool) *Tr
 	return tm
 }
 
+// NewTreeMapFromSortedSlice creates a TreeMap from entries sorted by strictly increasing key,
+// building a perfectly balanced tree in O(n). If the keys are not strictly increasing, the
+// entries are inserted one by one instead and later duplicates overwrite earlier ones.
+func NewTreeMapFromSortedSlice[K comparable, V any](entries []Entry[K, V], less func(K, K) bool) *TreeMap[K, V] {
+	tm := NewTreeMap[K, V](less)
+
+	for i := 1; i < len(entries); i++ {
+		if !less(entries[i-1].Key, entries[i].Key) {
+			for _, entry := range entries {
+				tm.Put(entry.Key, entry.Value)
+			}
+			return tm
+		}
+	}
+
+	tm.root = tm.buildBalanced(entries)
+	tm.size = len(entries)
+	return tm
+}
+
+// buildBalanced builds a balanced subtree from sorted entries by rooting it at the middle entry.
+func (tm *TreeMap[K, V]) buildBalanced(entries []Entry[K, V]) *TreeMapNode[K, V] {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	mid := len(entries) / 2
+	node := &TreeMapNode[K, V]{
+		Key:   entries[mid].Key,
+		Value: entries[mid].Value,
+		Left:  tm.buildBalanced(entries[:mid]),
+		Right: tm.buildBalanced(entries[mid+1:]),
+		owner: tm.owner,
+	}
+	tm.update(node)
+	return node
+}
+
 // Put adds or updates a key-value pair in the TreeMap.
 func (tm *TreeMap[K, V]) Put(key K, value V) {
 	tm.root = tm.putRecursive(tm.root, key, value)
@@ -47,11 +126,15 @@ func (tm *TreeMap[K, V]) putRecursive(node *TreeMapNode[K, V], key K, value V) *
 	if node == nil {
 		tm.size++
 		return &TreeMapNode[K, V]{
-			Key:   key,
-			Value: value,
+			Key:    key,
+			Value:  value,
+			height: 1,
+			size:   1,
+			owner:  tm.owner,
 		}
 	}
 
+	node = tm.own(node)
 	switch {
 	case tm.less(key, node.Key):
 		node.Left = tm.putRecursive(node.Left, key, value)
@@ -60,6 +143,64 @@ func (tm *TreeMap[K, V]) putRecursive(node *TreeMapNode[K, V], key K, value V) *
 	default:
 		// Key already exists, update value
 		node.Value = value
+		return node
+	}
+
+	return tm.rebalance(node)
+}
+
+// nodeHeight returns the height of a subtree, 0 for an empty one.
+func nodeHeight[K comparable, V any](node *TreeMapNode[K, V]) int {
+	if node == nil {
+		return 0
+	}
+	return node.height
+}
+
+// update recomputes the cached height and size of node from its children.
+func (tm *TreeMap[K, V]) update(node *TreeMapNode[K, V]) {
+	node.height = 1 + max(nodeHeight(node.Left), nodeHeight(node.Right))
+	node.size = 1 + tm.sizeOf(node.Left) + tm.sizeOf(node.Right)
+}
+
+// rotateLeft lifts the right child of node into its place and returns it.
+func (tm *TreeMap[K, V]) rotateLeft(node *TreeMapNode[K, V]) *TreeMapNode[K, V] {
+	node = tm.own(node)
+	pivot := tm.own(node.Right)
+	node.Right = pivot.Left
+	pivot.Left = node
+	tm.update(node)
+	tm.update(pivot)
+	return pivot
+}
+
+// rotateRight lifts the left child of node into its place and returns it.
+func (tm *TreeMap[K, V]) rotateRight(node *TreeMapNode[K, V]) *TreeMapNode[K, V] {
+	node = tm.own(node)
+	pivot := tm.own(node.Left)
+	node.Left = pivot.Right
+	pivot.Right = node
+	tm.update(node)
+	tm.update(pivot)
+	return pivot
+}
+
+// rebalance restores the AVL invariant at node after one of its subtrees changed height by at
+// most one, and returns the new subtree root.
+func (tm *TreeMap[K, V]) rebalance(node *TreeMapNode[K, V]) *TreeMapNode[K, V] {
+	tm.update(node)
+
+	switch balance := nodeHeight(node.Left) - nodeHeight(node.Right); {
+	case balance > 1:
+		if nodeHeight(node.Left.Left) < nodeHeight(node.Left.Right) {
+			node.Left = tm.rotateLeft(node.Left)
+		}
+		return tm.rotateRight(node)
+	case balance < -1:
+		if nodeHeight(node.Right.Right) < nodeHeight(node.Right.Left) {
+			node.Right = tm.rotateRight(node.Right)
+		}
+		return tm.rotateLeft(node)
 	}
 
 	return node
@@ -109,28 +250,124 @@ func (tm *TreeMap[K, V]) removeRecursive(node *TreeMapNode[K, V], key K) *TreeMa
 		return nil
 	}
 
+	node = tm.own(node)
 	switch {
 	case tm.less(key, node.Key):
 		node.Left = tm.removeRecursive(node.Left, key)
 	case tm.less(node.Key, key):
 		node.Right = tm.removeRecursive(node.Right, key)
 	default:
-		// Node to remove found
-		if node.Left == nil {
-			return node.Right
-		} else if node.Right == nil {
-			return node.Left
+		return tm.removeNode(node)
+	}
+
+	return tm.rebalance(node)
+}
+
+// removeNode unlinks node from its subtree and returns the new subtree root.
+func (tm *TreeMap[K, V]) removeNode(node *TreeMapNode[K, V]) *TreeMapNode[K, V] {
+	if node.Left == nil {
+		return node.Right
+	} else if node.Right == nil {
+		return node.Left
+	}
+
+	// Node has two children
+	// Find the inorder successor (smallest key in right subtree)
+	node = tm.own(node)
+	successor := tm.minNode(node.Right)
+	node.Key = successor.Key
+	node.Value = successor.Value
+	node.Right = tm.removeRecursive(node.Right, successor.Key)
+	return tm.rebalance(node)
+}
+
+// GetOrDefault returns the value associated with key, or defaultValue if the key is absent.
+func (tm *TreeMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if node := tm.getNode(key); node != nil {
+		return node.Value
+	}
+	return defaultValue
+}
+
+// PutIfAbsent stores value under key unless the key is already present. It returns the value
+// associated with key afterwards and whether the key was already present.
+func (tm *TreeMap[K, V]) PutIfAbsent(key K, value V) (V, bool) {
+	return tm.ComputeIfAbsent(key, func(K) V { return value })
+}
+
+// ComputeIfAbsent returns the value associated with key, first storing compute(key) if the key
+// is absent. The second result reports whether the key was already present.
+func (tm *TreeMap[K, V]) ComputeIfAbsent(key K, compute func(K) V) (V, bool) {
+	var present bool
+	result, _ := tm.Compute(key, func(k K, old V, exists bool) (V, bool) {
+		present = exists
+		if exists {
+			return old, true
 		}
+		return compute(k), true
+	})
+	return result, present
+}
 
-		// Node has two children
-		// Find the inorder successor (smallest key in right subtree)
-		successor := tm.minNode(node.Right)
-		node.Key = successor.Key
-		node.Value = successor.Value
-		node.Right = tm.removeRecursive(node.Right, successor.Key)
+// Compute updates the entry for key in a single traversal. The remap function receives the key,
+// the current value, and whether the key is present, and returns the new value and whether to
+// keep the entry; returning false removes the key. Compute returns the new value and whether
+// the key is present afterwards.
+func (tm *TreeMap[K, V]) Compute(key K, remap func(key K, value V, exists bool) (V, bool)) (V, bool) {
+	var result V
+	var kept bool
+	tm.root = tm.computeRecursive(tm.root, key, func(old V, exists bool) (V, bool) {
+		result, kept = remap(key, old, exists)
+		return result, kept
+	})
+	if !kept {
+		var zero V
+		return zero, false
 	}
+	return result, true
+}
 
-	return node
+// computeRecursive is the recursive helper for Compute.
+func (tm *TreeMap[K, V]) computeRecursive(node *TreeMapNode[K, V], key K, remap func(V, bool) (V, bool)) *TreeMapNode[K, V] {
+	if node == nil {
+		var zero V
+		value, keep := remap(zero, false)
+		if !keep {
+			return nil
+		}
+		tm.size++
+		return &TreeMapNode[K, V]{Key: key, Value: value, height: 1, size: 1, owner: tm.owner}
+	}
+
+	node = tm.own(node)
+	switch {
+	case tm.less(key, node.Key):
+		node.Left = tm.computeRecursive(node.Left, key, remap)
+	case tm.less(node.Key, key):
+		node.Right = tm.computeRecursive(node.Right, key, remap)
+	default:
+		value, keep := remap(node.Value, true)
+		if keep {
+			node.Value = value
+			return node
+		}
+		tm.size--
+		return tm.removeNode(node)
+	}
+
+	return tm.rebalance(node)
+}
+
+// Merge stores value under key if the key is absent, and otherwise replaces the current value
+// with merge(current, value). It returns the value stored.
+func (tm *TreeMap[K, V]) Merge(key K, value V, merge func(current, value V) V) V {
+	result, _ := tm.Compute(key, func(_ K, current V, exists bool) (V, bool) {
+		if exists {
+			return merge(current, value), true
+		}
+		return value, true
+	})
+	return result
 }
 
 // minNode finds the node with the minimum key in a subtree.
@@ -158,20 +395,25 @@ func (tm *TreeMap[K, V]) ContainsKey(key K) bool {
 
 // ContainsValue checks if a value exists in the TreeMap.
 func (tm *TreeMap[K, V]) ContainsValue(value V) bool {
-	return tm.containsValueRecursive(tm.root, value)
+	return tm.ContainsValueFunc(value, tm.equalValues)
+}
+
+// ContainsValueFunc checks if a value exists in the TreeMap using the given equality function.
+func (tm *TreeMap[K, V]) ContainsValueFunc(value V, equals func(V, V) bool) bool {
+	return tm.containsValueRecursive(tm.root, value, equals)
 }
 
-// containsValueRecursive is the recursive helper for ContainsValue.
-func (tm *TreeMap[K, V]) containsValueRecursive(node *TreeMapNode[K, V], value V) bool {
+// containsValueRecursive is the recursive helper for ContainsValueFunc.
+func (tm *TreeMap[K, V]) containsValueRecursive(node *TreeMapNode[K, V], value V, equals func(V, V) bool) bool {
 	if node == nil {
 		return false
 	}
 
-	if fmt.Sprintf("%v", node.Value) == fmt.Sprintf("%v", value) {
+	if equals(node.Value, value) {
 		return true
 	}
 
-	return tm.containsValueRecursive(node.Left, value) || tm.containsValueRecursive(node.Right, value)
+	return tm.containsValueRecursive(node.Left, value, equals) || tm.containsValueRecursive(node.Right, value, equals)
 }
 
 // Named return values for clarity and to satisfy linter.
@@ -322,23 +564,22 @@ func (tm *TreeMap[K, V]) higherRecursive(node *TreeMapNode[K, V], key K) *TreeMa
 
 // Rank returns the number of keys less than the given key.
 func (tm *TreeMap[K, V]) Rank(key K) int {
-	return tm.rankRecursive(tm.root, key)
-}
+	rank := 0
+	current := tm.root
 
-// rankRecursive is the recursive helper for Rank.
-func (tm *TreeMap[K, V]) rankRecursive(node *TreeMapNode[K, V], key K) int {
-	if node == nil {
-		return 0
+	for current != nil {
+		switch {
+		case tm.less(key, current.Key):
+			current = current.Left
+		case tm.less(current.Key, key):
+			rank += 1 + tm.sizeOf(current.Left)
+			current = current.Right
+		default:
+			return rank + tm.sizeOf(current.Left)
+		}
 	}
 
-	switch {
-	case tm.less(key, node.Key):
-		return tm.rankRecursive(node.Left, key)
-	case tm.less(node.Key, key):
-		return 1 + tm.sizeOf(node.Left) + tm.rankRecursive(node.Right, key)
-	default:
-		return tm.sizeOf(node.Left)
-	}
+	return rank
 }
 
 // Select returns the key-value pair with the given rank.
@@ -348,33 +589,34 @@ func (tm *TreeMap[K, V]) Select(rank int) (K, V, bool) {
 		var zeroV V
 		return zeroK, zeroV, false
 	}
-	result := tm.selectRecursive(tm.root, rank)
+	result := tm.selectNode(rank)
 	return result.Key, result.Value, true
 }
 
-// selectRecursive is the recursive helper for Select.
-func (tm *TreeMap[K, V]) selectRecursive(node *TreeMapNode[K, V], rank int) *TreeMapNode[K, V] {
-	if node == nil {
-		return nil
-	}
+// selectNode returns the node with the given rank, which must be in range.
+func (tm *TreeMap[K, V]) selectNode(rank int) *TreeMapNode[K, V] {
+	current := tm.root
 
-	leftSize := tm.sizeOf(node.Left)
-	switch {
-	case rank < leftSize:
-		return tm.selectRecursive(node.Left, rank)
-	case rank > leftSize:
-		return tm.selectRecursive(node.Right, rank-leftSize-1)
-	default:
-		return node
+	for {
+		leftSize := tm.sizeOf(current.Left)
+		switch {
+		case rank < leftSize:
+			current = current.Left
+		case rank > leftSize:
+			rank -= leftSize + 1
+			current = current.Right
+		default:
+			return current
+		}
 	}
 }
 
-// sizeOf returns the size of a subtree.
+// sizeOf returns the cached size of a subtree.
 func (tm *TreeMap[K, V]) sizeOf(node *TreeMapNode[K, V]) int {
 	if node == nil {
 		return 0
 	}
-	return 1 + tm.sizeOf(node.Left) + tm.sizeOf(node.Right)
+	return node.size
 }
 
 // Size returns the number of key-value pairs in the TreeMap.
@@ -393,38 +635,57 @@ func (tm *TreeMap[K, V]) Clear() {
 	tm.size = 0
 }
 
+// own returns node if the map may modify it in place, and otherwise a copy of it owned by the
+// map, leaving the original to the snapshots that share it.
+func (tm *TreeMap[K, V]) own(node *TreeMapNode[K, V]) *TreeMapNode[K, V] {
+	if node.owner == tm.owner {
+		return node
+	}
+	clone := *node
+	clone.owner = tm.owner
+	return &clone
+}
+
+// Snapshot returns an immutable view of the map in O(1). The view shares nodes with the map,
+// and each later write copies only the O(log n) nodes on its path, so the view can be read from
+// other goroutines while the map keeps changing.
+func (tm *TreeMap[K, V]) Snapshot() *TreeMapSnapshot[K, V] {
+	frozen := *tm
+	tm.owner = &treeOwner{}
+	return &TreeMapSnapshot[K, V]{tm: &frozen}
+}
+
 // Keys returns all keys in the TreeMap in sorted order.
 func (tm *TreeMap[K, V]) Keys() []K {
-	var keys []K
+	return tm.AppendKeys(nil)
+}
+
+// AppendKeys appends the keys in sorted order to dst and returns the extended slice.
+func (tm *TreeMap[K, V]) AppendKeys(dst []K) []K {
 	tm.inOrderTraversal(tm.root, func(key K, value V) {
-		keys = append(keys, key)
+		dst = append(dst, key)
 	})
-	return keys
+	return dst
 }
 
 // Values returns all values in the TreeMap in key order.
 func (tm *TreeMap[K, V]) Values() []V {
-	var values []V
+	return tm.AppendValues(nil)
+}
+
+// AppendValues appends the values in key order to dst and returns the extended slice.
+func (tm *TreeMap[K, V]) AppendValues(dst []V) []V {
 	tm.inOrderTraversal(tm.root, func(key K, value V) {
-		values = append(values, value)
+		dst = append(dst, value)
 	})
-	return values
+	return dst
 }
 
 // Entries returns all key-value pairs in the TreeMap in sorted order.
-func (tm *TreeMap[K, V]) Entries() []struct {
-	Key   K
-	Value V
-} {
-	var entries []struct {
-		Key   K
-		Value V
-	}
+func (tm *TreeMap[K, V]) Entries() []Entry[K, V] {
+	var entries []Entry[K, V]
 	tm.inOrderTraversal(tm.root, func(key K, value V) {
-		entries = append(entries, struct {
-			Key   K
-			Value V
-		}{key, value})
+		entries = append(entries, Entry[K, V]{Key: key, Value: value})
 	})
 	return entries
 }
@@ -457,9 +718,24 @@ func (tm *TreeMap[K, V]) ForEach(fn func(K, V)) {
 	tm.inOrderTraversal(tm.root, fn)
 }
 
+// All returns an iterator over the key-value pairs in sorted order.
+func (tm *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		tm.allRecursive(tm.root, yield)
+	}
+}
+
+// allRecursive is the recursive helper for All. It returns false once yield asks to stop.
+func (tm *TreeMap[K, V]) allRecursive(node *TreeMapNode[K, V], yield func(K, V) bool) bool {
+	if node == nil {
+		return true
+	}
+	return tm.allRecursive(node.Left, yield) && yield(node.Key, node.Value) && tm.allRecursive(node.Right, yield)
+}
+
 // Filter returns a new TreeMap containing entries that satisfy the predicate.
 func (tm *TreeMap[K, V]) Filter(predicate func(K, V) bool) *TreeMap[K, V] {
-	result := NewTreeMap[K, V](tm.less)
+	result := tm.newEmpty()
 	tm.filterRecursive(tm.root, predicate, result)
 	return result
 }
@@ -477,22 +753,35 @@ func (tm *TreeMap[K, V]) filterRecursive(node *TreeMapNode[K, V], predicate func
 
 // Clone creates a deep copy of the TreeMap.
 func (tm *TreeMap[K, V]) Clone() *TreeMap[K, V] {
-	result := NewTreeMap[K, V](tm.less)
-	tm.cloneRecursive(tm.root, result)
+	return tm.CloneWith(nil, nil)
+}
+
+// CloneWith creates a copy of the TreeMap whose keys are copied by cloneKey and values by
+// cloneValue. cloneKey must preserve the order of the keys. A nil function copies as is.
+func (tm *TreeMap[K, V]) CloneWith(cloneKey func(K) K, cloneValue func(V) V) *TreeMap[K, V] {
+	result := tm.newEmpty()
+	tm.cloneRecursive(tm.root, result, orIdentity(cloneKey), orIdentity(cloneValue))
 	return result
 }
 
 // cloneRecursive is the recursive helper for Clone.
-func (tm *TreeMap[K, V]) cloneRecursive(node *TreeMapNode[K, V], result *TreeMap[K, V]) {
+func (tm *TreeMap[K, V]) cloneRecursive(node *TreeMapNode[K, V], result *TreeMap[K, V], cloneKey func(K) K, cloneValue func(V) V) {
 	if node != nil {
-		tm.cloneRecursive(node.Left, result)
-		result.Put(node.Key, node.Value)
-		tm.cloneRecursive(node.Right, result)
+		tm.cloneRecursive(node.Left, result, cloneKey, cloneValue)
+		result.Put(cloneKey(node.Key), cloneValue(node.Value))
+		tm.cloneRecursive(node.Right, result, cloneKey, cloneValue)
 	}
 }
 
-// Equals checks if two TreeMaps contain the same key-value pairs.
+// Equals checks if two TreeMaps contain the same key-value pairs, comparing values with the
+// valueEquals of tm.
 func (tm *TreeMap[K, V]) Equals(other *TreeMap[K, V]) bool {
+	return tm.EqualsFunc(other, tm.equalValues)
+}
+
+// EqualsFunc checks if two TreeMaps contain the same key-value pairs using the given value
+// equality function.
+func (tm *TreeMap[K, V]) EqualsFunc(other *TreeMap[K, V], equals func(V, V) bool) bool {
 	if tm.size != other.size {
 		return false
 	}
@@ -501,7 +790,7 @@ func (tm *TreeMap[K, V]) Equals(other *TreeMap[K, V]) bool {
 	entries2 := other.Entries()
 
 	for i := 0; i < len(entries1); i++ {
-		if entries1[i].Key != entries2[i].Key || fmt.Sprintf("%v", entries1[i].Value) != fmt.Sprintf("%v", entries2[i].Value) {
+		if entries1[i].Key != entries2[i].Key || !equals(entries1[i].Value, entries2[i].Value) {
 			return false
 		}
 	}
@@ -510,43 +799,101 @@ func (tm *TreeMap[K, V]) Equals(other *TreeMap[K, V]) bool {
 }
 
 // Range returns all key-value pairs in the TreeMap between min and max (inclusive).
-func (tm *TreeMap[K, V]) Range(min, max K) []struct {
-	Key   K
-	Value V
-} {
-	var result []struct {
-		Key   K
-		Value V
-	}
-	tm.rangeRecursive(tm.root, min, max, &result)
+func (tm *TreeMap[K, V]) Range(min, max K) []Entry[K, V] {
+	var result []Entry[K, V]
+	tm.RangeFunc(min, max, func(key K, value V) bool {
+		result = append(result, Entry[K, V]{Key: key, Value: value})
+		return true
+	})
 	return result
 }
 
-// rangeRecursive is the recursive helper for Range.
-func (tm *TreeMap[K, V]) rangeRecursive(node *TreeMapNode[K, V], min, max K, result *[]struct {
-	Key   K
-	Value V
-}) {
+// RangeFunc calls fn for each key-value pair between min and max (inclusive) in key order,
+// stopping early if fn returns false. Unlike Range it does not allocate a result slice.
+func (tm *TreeMap[K, V]) RangeFunc(min, max K, fn func(K, V) bool) {
+	tm.rangeRecursive(tm.root, min, max, fn)
+}
+
+// rangeRecursive is the recursive helper for RangeFunc. It returns false once fn has stopped
+// the traversal.
+func (tm *TreeMap[K, V]) rangeRecursive(node *TreeMapNode[K, V], min, max K, fn func(K, V) bool) bool {
 	if node == nil {
-		return
+		return true
 	}
 
 	// If current node is greater than min, recur for left subtree
-	if tm.less(min, node.Key) {
-		tm.rangeRecursive(node.Left, min, max, result)
+	if tm.less(min, node.Key) && !tm.rangeRecursive(node.Left, min, max, fn) {
+		return false
 	}
 
-	// If current node is in range, add it
-	if !tm.less(node.Key, min) && !tm.less(max, node.Key) {
-		*result = append(*result, struct {
-			Key   K
-			Value V
-		}{node.Key, node.Value})
+	// If current node is in range, visit it
+	if !tm.less(node.Key, min) && !tm.less(max, node.Key) && !fn(node.Key, node.Value) {
+		return false
 	}
 
 	// If current node is less than max, recur for right subtree
 	if tm.less(node.Key, max) {
-		tm.rangeRecursive(node.Right, min, max, result)
+		return tm.rangeRecursive(node.Right, min, max, fn)
+	}
+	return true
+}
+
+// CountRange returns the number of keys between min and max (inclusive) in O(log n) using the
+// cached subtree sizes.
+func (tm *TreeMap[K, V]) CountRange(min, max K) int {
+	if tm.less(max, min) {
+		return 0
+	}
+
+	count := tm.Rank(max) - tm.Rank(min)
+	if tm.ContainsKey(max) {
+		count++
+	}
+	return count
+}
+
+// HeadMap returns a new TreeMap with the entries whose keys are strictly less than toKey.
+// The result is a snapshot; later changes to either map do not affect the other.
+func (tm *TreeMap[K, V]) HeadMap(toKey K) *TreeMap[K, V] {
+	result := tm.newEmpty()
+	tm.copyBounded(tm.root, nil, &toKey, result)
+	return result
+}
+
+// TailMap returns a new TreeMap with the entries whose keys are greater than or equal to
+// fromKey. The result is a snapshot.
+func (tm *TreeMap[K, V]) TailMap(fromKey K) *TreeMap[K, V] {
+	result := tm.newEmpty()
+	tm.copyBounded(tm.root, &fromKey, nil, result)
+	return result
+}
+
+// SubMap returns a new TreeMap with the entries whose keys lie in [fromKey, toKey).
+// The result is a snapshot.
+func (tm *TreeMap[K, V]) SubMap(fromKey, toKey K) *TreeMap[K, V] {
+	result := tm.newEmpty()
+	tm.copyBounded(tm.root, &fromKey, &toKey, result)
+	return result
+}
+
+// copyBounded puts every entry of the subtree with from <= key < to into result, skipping
+// subtrees outside the bounds. A nil bound is unbounded.
+func (tm *TreeMap[K, V]) copyBounded(node *TreeMapNode[K, V], from, to *K, result *TreeMap[K, V]) {
+	if node == nil {
+		return
+	}
+
+	aboveFrom := from == nil || !tm.less(node.Key, *from)
+	belowTo := to == nil || tm.less(node.Key, *to)
+
+	if aboveFrom {
+		tm.copyBounded(node.Left, from, to, result)
+	}
+	if aboveFrom && belowTo {
+		result.Put(node.Key, node.Value)
+	}
+	if belowTo {
+		tm.copyBounded(node.Right, from, to, result)
 	}
 }
 
@@ -598,3 +945,92 @@ func (tm *TreeMap[K, V]) isBalancedRecursive(node *TreeMapNode[K, V]) int {
 	}
 	return 1 + rightHeight
 }
+
+// TreeMapSnapshot is a read-only view of a TreeMap at the moment Snapshot was called.
+type TreeMapSnapshot[K comparable, V any] struct {
+	tm *TreeMap[K, V]
+}
+
+// Get retrieves the value associated with a key.
+func (ts *TreeMapSnapshot[K, V]) Get(key K) (V, bool) {
+	return ts.tm.Get(key)
+}
+
+// ContainsKey checks if a key exists in the snapshot.
+func (ts *TreeMapSnapshot[K, V]) ContainsKey(key K) bool {
+	return ts.tm.ContainsKey(key)
+}
+
+// Size returns the number of key-value pairs in the snapshot.
+func (ts *TreeMapSnapshot[K, V]) Size() int {
+	return ts.tm.Size()
+}
+
+// IsEmpty checks if the snapshot is empty.
+func (ts *TreeMapSnapshot[K, V]) IsEmpty() bool {
+	return ts.tm.IsEmpty()
+}
+
+// Min returns the smallest key in the snapshot.
+func (ts *TreeMapSnapshot[K, V]) Min() (K, V, bool) {
+	return ts.tm.Min()
+}
+
+// Max returns the greatest key in the snapshot.
+func (ts *TreeMapSnapshot[K, V]) Max() (K, V, bool) {
+	return ts.tm.Max()
+}
+
+// Floor returns the greatest key less than or equal to the given key.
+func (ts *TreeMapSnapshot[K, V]) Floor(key K) (K, V, bool) {
+	return ts.tm.Floor(key)
+}
+
+// Ceiling returns the smallest key greater than or equal to the given key.
+func (ts *TreeMapSnapshot[K, V]) Ceiling(key K) (K, V, bool) {
+	return ts.tm.Ceiling(key)
+}
+
+// Keys returns all keys in sorted order.
+func (ts *TreeMapSnapshot[K, V]) Keys() []K {
+	return ts.tm.Keys()
+}
+
+// Values returns all values in key order.
+func (ts *TreeMapSnapshot[K, V]) Values() []V {
+	return ts.tm.Values()
+}
+
+// Entries returns all key-value pairs in key order.
+func (ts *TreeMapSnapshot[K, V]) Entries() []Entry[K, V] {
+	return ts.tm.Entries()
+}
+
+// ForEach applies a function to each key-value pair in sorted order.
+func (ts *TreeMapSnapshot[K, V]) ForEach(fn func(K, V)) {
+	ts.tm.ForEach(fn)
+}
+
+// All returns an iterator over the key-value pairs in sorted order.
+func (ts *TreeMapSnapshot[K, V]) All() iter.Seq2[K, V] {
+	return ts.tm.All()
+}
+
+// RangeFunc calls fn for each key between min and max (inclusive) in sorted order until fn
+// returns false.
+func (ts *TreeMapSnapshot[K, V]) RangeFunc(min, max K, fn func(K, V) bool) {
+	ts.tm.RangeFunc(min, max, fn)
+}
+
+// ToTreeMap returns a mutable map with the snapshot's entries. It is O(1); each write to the
+// returned map copies the nodes on its path.
+func (ts *TreeMapSnapshot[K, V]) ToTreeMap() *TreeMap[K, V] {
+	clone := *ts.tm
+	clone.owner = &treeOwner{}
+	return &clone
+}
+
+// String returns a string representation of the snapshot.
+func (ts *TreeMapSnapshot[K, V]) String() string {
+	return fmt.Sprintf("TreeMapSnapshot%v", ts.tm.ToMap())
+}
@@ -0,0 +1,120 @@
+package stl
+
+// iteratorPosition describes where a TreeMapIterator currently stands.
+type iteratorPosition int
+
+const (
+	beforeFirst iteratorPosition = iota
+	atEntry
+	removedEntry
+	afterLast
+)
+
+// TreeMapIterator is a bidirectional cursor over the entries of a TreeMap in key order.
+// It navigates by key, so the map may be modified while iterating: each move finds the
+// neighbor of the last key visited in the current map.
+type TreeMapIterator[K comparable, V any] struct {
+	tm       *TreeMap[K, V]
+	key      K
+	value    V
+	position iteratorPosition
+}
+
+var _ SeekableMapIterator[int, int] = (*TreeMapIterator[int, int])(nil)
+
+// Iterator returns an iterator positioned before the first entry. Call Next to advance to it.
+func (tm *TreeMap[K, V]) Iterator() *TreeMapIterator[K, V] {
+	return &TreeMapIterator[K, V]{tm: tm}
+}
+
+// Next moves to the entry with the next larger key and reports whether one exists.
+func (it *TreeMapIterator[K, V]) Next() bool {
+	switch it.position {
+	case beforeFirst:
+		return it.First()
+	case afterLast:
+		return false
+	}
+
+	key, value, ok := it.tm.Higher(it.key)
+	return it.moveTo(key, value, ok, afterLast)
+}
+
+// Prev moves to the entry with the next smaller key and reports whether one exists.
+func (it *TreeMapIterator[K, V]) Prev() bool {
+	switch it.position {
+	case afterLast:
+		return it.Last()
+	case beforeFirst:
+		return false
+	}
+
+	key, value, ok := it.tm.Lower(it.key)
+	return it.moveTo(key, value, ok, beforeFirst)
+}
+
+// First moves to the entry with the smallest key and reports whether the map is non-empty.
+func (it *TreeMapIterator[K, V]) First() bool {
+	key, value, ok := it.tm.Min()
+	return it.moveTo(key, value, ok, afterLast)
+}
+
+// Last moves to the entry with the largest key and reports whether the map is non-empty.
+func (it *TreeMapIterator[K, V]) Last() bool {
+	key, value, ok := it.tm.Max()
+	return it.moveTo(key, value, ok, beforeFirst)
+}
+
+// Seek moves to the entry with the smallest key greater than or equal to key and reports
+// whether one exists. If none does, the iterator is positioned after the last entry.
+func (it *TreeMapIterator[K, V]) Seek(key K) bool {
+	found, value, ok := it.tm.Ceiling(key)
+	return it.moveTo(found, value, ok, afterLast)
+}
+
+// moveTo positions the iterator at the given entry, or at the end position if ok is false.
+func (it *TreeMapIterator[K, V]) moveTo(key K, value V, ok bool, end iteratorPosition) bool {
+	if !ok {
+		var zeroK K
+		var zeroV V
+		it.key, it.value, it.position = zeroK, zeroV, end
+		return false
+	}
+	it.key, it.value, it.position = key, value, atEntry
+	return true
+}
+
+// Valid reports whether the iterator is positioned at an entry.
+func (it *TreeMapIterator[K, V]) Valid() bool {
+	return it.position == atEntry
+}
+
+// Key returns the key of the current entry, or the zero value if the iterator is not valid.
+func (it *TreeMapIterator[K, V]) Key() K {
+	if !it.Valid() {
+		var zero K
+		return zero
+	}
+	return it.key
+}
+
+// Value returns the value of the current entry as of the last move, or the zero value if the
+// iterator is not valid.
+func (it *TreeMapIterator[K, V]) Value() V {
+	if !it.Valid() {
+		var zero V
+		return zero
+	}
+	return it.value
+}
+
+// Remove deletes the current entry from the map. The iterator stays between its neighbors, so
+// Next and Prev continue from the removed key. It returns false if the iterator is not valid.
+func (it *TreeMapIterator[K, V]) Remove() bool {
+	if !it.Valid() {
+		return false
+	}
+	it.tm.Remove(it.key)
+	it.position = removedEntry
+	return true
+}
@@ -0,0 +1,114 @@
+package stl
+
+import "testing"
+
+func TestTreeMapIteratorForwardAndBackward(t *testing.T) {
+	tm := NewTreeMap[int, string](lessInt)
+	tm.Put(3, testValueThree)
+	tm.Put(1, testValueOne)
+	tm.Put(2, testValueTwo)
+
+	it := tm.Iterator()
+	if it.Valid() || it.Prev() {
+		t.Error("New iterator should start before the first entry")
+	}
+
+	var keys []int
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if len(keys) != 3 || keys[0] != 1 || keys[2] != 3 {
+		t.Errorf("Expected forward keys [1 2 3], got %v", keys)
+	}
+	if it.Valid() || it.Next() {
+		t.Error("Iterator should be exhausted after the last entry")
+	}
+
+	keys = keys[:0]
+	for it.Prev() {
+		keys = append(keys, it.Key())
+	}
+	if len(keys) != 3 || keys[0] != 3 || keys[2] != 1 {
+		t.Errorf("Expected backward keys [3 2 1], got %v", keys)
+	}
+
+	if !it.Last() || it.Key() != 3 || it.Value() != testValueThree {
+		t.Errorf("Expected Last at 3, got %d", it.Key())
+	}
+	if !it.First() || it.Key() != 1 {
+		t.Errorf("Expected First at 1, got %d", it.Key())
+	}
+}
+
+func TestTreeMapIteratorSeekPagination(t *testing.T) {
+	tm := NewTreeMap[int, int](lessInt)
+	for i := 0; i < 50; i += 5 {
+		tm.Put(i, i)
+	}
+
+	it := tm.Iterator()
+	if !it.Seek(12) || it.Key() != 15 {
+		t.Errorf("Expected Seek(12) to land on 15, got %d", it.Key())
+	}
+	if !it.Seek(20) || it.Key() != 20 {
+		t.Errorf("Expected Seek(20) to land on 20, got %d", it.Key())
+	}
+	if it.Seek(100) || it.Valid() {
+		t.Error("Seeking past the end should invalidate the iterator")
+	}
+	if !it.Prev() || it.Key() != 45 {
+		t.Errorf("Expected Prev after the end to return 45, got %d", it.Key())
+	}
+
+	// Read pages of three keys, resuming after the last key of each page
+	var pages [][]int
+	last := -1
+	for {
+		page := []int{}
+		for ok := it.Seek(last + 1); ok && len(page) < 3; ok = it.Next() {
+			page = append(page, it.Key())
+		}
+		if len(page) == 0 {
+			break
+		}
+		pages = append(pages, page)
+		last = page[len(page)-1]
+	}
+	if len(pages) != 4 || pages[1][0] != 15 || len(pages[3]) != 1 {
+		t.Errorf("Unexpected pages %v", pages)
+	}
+}
+
+func TestTreeMapIteratorRemove(t *testing.T) {
+	tm := NewTreeMap[int, int](lessInt)
+	for i := 1; i <= 10; i++ {
+		tm.Put(i, i)
+	}
+
+	// Remove odd keys while iterating
+	it := tm.Iterator()
+	if it.Remove() {
+		t.Error("Remove before the first entry should fail")
+	}
+	for it.Next() {
+		if it.Key()%2 == 1 {
+			it.Remove()
+			if it.Valid() || it.Remove() {
+				t.Error("Iterator should be invalid after removing its entry")
+			}
+		}
+	}
+
+	if keys := tm.Keys(); len(keys) != 5 || keys[0] != 2 || keys[4] != 10 {
+		t.Errorf("Expected even keys to remain, got %v", keys)
+	}
+
+	it.Seek(6)
+	it.Remove()
+	if !it.Prev() || it.Key() != 4 {
+		t.Errorf("Expected Prev after removing 6 to return 4, got %d", it.Key())
+	}
+	if !tm.IsBalanced() || tm.Size() != 4 {
+		t.Errorf("Expected balanced map of 4 entries, got %v", tm)
+	}
+}
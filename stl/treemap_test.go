@@ -1,6 +1,8 @@
 package stl
 
 import (
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -303,5 +305,426 @@ func TestTreeMapAdvancedOperations(t *testing.T) {
 	if height := tm.Height(); height < 2 {
 		t.Errorf("Expected height >= 2 for 5 nodes, got %d", height)
 	}
-	// Note: Tree may not be balanced after sequential insertions as this is not a self-balancing BST
+	if !tm.IsBalanced() {
+		t.Error("TreeMap should stay balanced")
+	}
+}
+
+func TestTreeMapBalancedSortedInsertions(t *testing.T) {
+	tm := NewTreeMap[int, int](lessInt)
+	const n = 1 << 12
+
+	// Sorted insertions would degenerate an unbalanced BST into a list
+	for i := 0; i < n; i++ {
+		tm.Put(i, i*i)
+	}
+	if !tm.IsBalanced() {
+		t.Error("TreeMap should be balanced after sorted insertions")
+	}
+	// An AVL tree of n nodes is at most about 1.44 log2(n) high
+	if height := tm.Height(); height > 18 {
+		t.Errorf("Expected height at most 18 for %d nodes, got %d", n, height)
+	}
+
+	// Remove every other key and check order, values, and balance
+	for i := 0; i < n; i += 2 {
+		if !tm.Remove(i) {
+			t.Fatalf("Expected to remove key %d", i)
+		}
+	}
+	if tm.Size() != n/2 || !tm.IsBalanced() {
+		t.Errorf("Expected balanced map of %d entries, got size %d", n/2, tm.Size())
+	}
+	for i, key := range tm.Keys() {
+		if key != 2*i+1 {
+			t.Fatalf("Expected key %d at position %d, got %d", 2*i+1, i, key)
+		}
+		if value, _ := tm.Get(key); value != key*key {
+			t.Errorf("Expected value %d for key %d, got %d", key*key, key, value)
+		}
+	}
+	if rank := tm.Rank(101); rank != 50 {
+		t.Errorf("Expected rank 50 for key 101, got %d", rank)
+	}
+}
+
+func TestTreeMapRankSelectConsistency(t *testing.T) {
+	tm := NewTreeMap[int, string](lessInt)
+	for i := 0; i < 500; i++ {
+		tm.Put((i*37)%500, "")
+	}
+	for i := 0; i < 500; i += 3 {
+		tm.Remove(i)
+	}
+	tm.Put(42, "updated")
+
+	for rank, key := range tm.Keys() {
+		if got := tm.Rank(key); got != rank {
+			t.Errorf("Expected rank %d for key %d, got %d", rank, key, got)
+		}
+		if selected, _, ok := tm.Select(rank); !ok || selected != key {
+			t.Errorf("Expected Select(%d) = %d, got %d", rank, key, selected)
+		}
+	}
+	if rank := tm.Rank(1000); rank != tm.Size() {
+		t.Errorf("Expected rank %d past the largest key, got %d", tm.Size(), rank)
+	}
+}
+
+func BenchmarkTreeMapRank(b *testing.B) {
+	tm := NewTreeMap[int, int](lessInt)
+	for key := 0; key < 100000; key++ {
+		tm.Put(key, key)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tm.Rank(i % 100000)
+	}
+}
+
+func TestTreeMapSubMapViews(t *testing.T) {
+	tm := NewTreeMap[int, string](lessInt)
+	for i := 1; i <= 9; i++ {
+		tm.Put(i, "")
+	}
+	tm.Put(1, testValueOne)
+	tm.Put(5, testValueFive)
+
+	expectKeys := func(name string, got *TreeMap[int, string], expected ...int) {
+		t.Helper()
+		keys := got.Keys()
+		if len(keys) != len(expected) {
+			t.Errorf("%s: expected keys %v, got %v", name, expected, keys)
+			return
+		}
+		for i := range expected {
+			if keys[i] != expected[i] {
+				t.Errorf("%s: expected keys %v, got %v", name, expected, keys)
+				return
+			}
+		}
+	}
+
+	expectKeys("HeadMap(4)", tm.HeadMap(4), 1, 2, 3)
+	expectKeys("TailMap(7)", tm.TailMap(7), 7, 8, 9)
+	expectKeys("SubMap(3, 6)", tm.SubMap(3, 6), 3, 4, 5)
+	expectKeys("SubMap(6, 3)", tm.SubMap(6, 3))
+	expectKeys("HeadMap(0)", tm.HeadMap(0))
+	expectKeys("TailMap(0)", tm.TailMap(0), 1, 2, 3, 4, 5, 6, 7, 8, 9)
+
+	head := tm.HeadMap(6)
+	if value, _ := head.Get(5); value != testValueFive {
+		t.Errorf("Expected view to carry values, got %q", value)
+	}
+
+	// Views are snapshots
+	head.Remove(1)
+	if !tm.ContainsKey(1) {
+		t.Error("Changing a view should not change the original map")
+	}
+}
+
+func TestTreeMapComputeOperations(t *testing.T) {
+	tm := NewTreeMap[string, int](func(a, b string) bool { return a < b })
+
+	if value := tm.GetOrDefault("missing", 7); value != 7 {
+		t.Errorf("Expected default 7, got %d", value)
+	}
+
+	if value, present := tm.PutIfAbsent("a", 1); present || value != 1 {
+		t.Errorf("Expected PutIfAbsent to store 1, got %d (present %v)", value, present)
+	}
+	if value, present := tm.PutIfAbsent("a", 2); !present || value != 1 {
+		t.Errorf("Expected PutIfAbsent to keep 1, got %d (present %v)", value, present)
+	}
+
+	calls := 0
+	compute := func(key string) int {
+		calls++
+		return len(key)
+	}
+	tm.ComputeIfAbsent("bbb", compute)
+	if value, present := tm.ComputeIfAbsent("bbb", compute); !present || value != 3 || calls != 1 {
+		t.Errorf("Expected ComputeIfAbsent to compute once, got %d after %d calls", value, calls)
+	}
+
+	// Word counting with Merge
+	for _, word := range []string{"x", "y", "x", "x"} {
+		tm.Merge(word, 1, func(current, value int) int { return current + value })
+	}
+	if count := tm.GetOrDefault("x", 0); count != 3 {
+		t.Errorf("Expected merged count 3, got %d", count)
+	}
+
+	// Compute can update and remove
+	if value, ok := tm.Compute("a", func(_ string, v int, exists bool) (int, bool) { return v + 10, exists }); !ok || value != 11 {
+		t.Errorf("Expected Compute to update a to 11, got %d", value)
+	}
+	if _, ok := tm.Compute("y", func(string, int, bool) (int, bool) { return 0, false }); ok || tm.ContainsKey("y") {
+		t.Error("Expected Compute to remove y")
+	}
+	if _, ok := tm.Compute("z", func(string, int, bool) (int, bool) { return 0, false }); ok || tm.ContainsKey("z") {
+		t.Error("Expected Compute not to insert z")
+	}
+
+	if tm.Size() != 3 || !tm.IsBalanced() {
+		t.Errorf("Expected balanced map of 3 entries, got %v", tm)
+	}
+}
+
+func TestTreeMapFromSortedSlice(t *testing.T) {
+	entries := make([]Entry[int, int], 1000)
+	for i := range entries {
+		entries[i] = Entry[int, int]{Key: i * 2, Value: i}
+	}
+
+	tm := NewTreeMapFromSortedSlice(entries, lessInt)
+	if tm.Size() != 1000 || !tm.IsBalanced() {
+		t.Fatalf("Expected balanced map of 1000 entries, got size %d", tm.Size())
+	}
+	if height := tm.Height(); height != 9 {
+		t.Errorf("Expected perfectly balanced height 9, got %d", height)
+	}
+	if value, _ := tm.Get(500); value != 250 {
+		t.Errorf("Expected value 250 for key 500, got %d", value)
+	}
+	if key, _, _ := tm.Select(10); key != 20 || tm.Rank(20) != 10 {
+		t.Errorf("Expected key 20 at rank 10, got %d", key)
+	}
+
+	// The tree stays usable for further updates
+	tm.Put(-1, -1)
+	tm.Remove(0)
+	if minKey, _, _ := tm.Min(); minKey != -1 || !tm.IsBalanced() {
+		t.Errorf("Expected minimum -1 after updates, got %d", minKey)
+	}
+
+	// Unsorted input falls back to regular insertion
+	unsorted := NewTreeMapFromSortedSlice([]Entry[int, int]{{Key: 3, Value: 1}, {Key: 1, Value: 2}, {Key: 3, Value: 3}}, lessInt)
+	if unsorted.Size() != 2 || unsorted.GetOrDefault(3, 0) != 3 {
+		t.Errorf("Expected fallback with last duplicate winning, got %v", unsorted)
+	}
+
+	if empty := NewTreeMapFromSortedSlice[int, int](nil, lessInt); !empty.IsEmpty() {
+		t.Error("Expected empty map from empty slice")
+	}
+}
+
+func BenchmarkTreeMapFromSortedSlice(b *testing.B) {
+	entries := make([]Entry[int, int], 10000)
+	for i := range entries {
+		entries[i] = Entry[int, int]{Key: i, Value: i}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NewTreeMapFromSortedSlice(entries, lessInt)
+	}
+}
+
+func TestTreeMapCountRangeAndRangeFunc(t *testing.T) {
+	tm := NewTreeMap[int, int](lessInt)
+	for i := 0; i < 100; i += 2 {
+		tm.Put(i, i/2)
+	}
+
+	if count := tm.CountRange(10, 20); count != 6 {
+		t.Errorf("Expected 6 keys in [10, 20], got %d", count)
+	}
+	if count := tm.CountRange(11, 19); count != 4 {
+		t.Errorf("Expected 4 keys in [11, 19], got %d", count)
+	}
+	if count := tm.CountRange(-50, 500); count != 50 {
+		t.Errorf("Expected all 50 keys, got %d", count)
+	}
+	if count := tm.CountRange(20, 10); count != 0 {
+		t.Errorf("Expected 0 keys in an inverted range, got %d", count)
+	}
+
+	var keys []int
+	tm.RangeFunc(10, 90, func(key, value int) bool {
+		keys = append(keys, key)
+		return len(keys) < 3
+	})
+	if len(keys) != 3 || keys[0] != 10 || keys[1] != 12 || keys[2] != 14 {
+		t.Errorf("Expected RangeFunc to stop after [10 12 14], got %v", keys)
+	}
+
+	sum := 0
+	tm.RangeFunc(0, 8, func(key, value int) bool {
+		sum += value
+		return true
+	})
+	if sum != 10 {
+		t.Errorf("Expected sum of values 0..4 to be 10, got %d", sum)
+	}
+}
+
+func TestTreeMapValueEquality(t *testing.T) {
+	// Values that format identically must not compare equal
+	mixed := NewTreeMap[int, any](lessInt)
+	mixed.Put(1, 1)
+	if mixed.ContainsValue("1") {
+		t.Error("String \"1\" should not equal int 1")
+	}
+	other := NewTreeMap[int, any](lessInt)
+	other.Put(1, "1")
+	if mixed.Equals(other) {
+		t.Error("Maps with int and string values should not be equal")
+	}
+
+	slices := NewTreeMap[int, []int](lessInt)
+	slices.Put(1, []int{1, 2})
+	if !slices.ContainsValue([]int{1, 2}) {
+		t.Error("Default comparison should compare slice contents")
+	}
+
+	// Custom value equality is used by ContainsValue, Equals, and derived maps
+	fold := func(a, b string) bool { return strings.EqualFold(a, b) }
+	tm := NewTreeMapWithValueEquals[int, string](lessInt, fold)
+	tm.Put(1, "Apple")
+	if !tm.ContainsValue("APPLE") {
+		t.Error("Expected case-insensitive ContainsValue")
+	}
+	lower := NewTreeMap[int, string](lessInt)
+	lower.Put(1, "apple")
+	if !tm.Equals(lower) || lower.Equals(tm) {
+		t.Error("Equals should use the receiver's value equality")
+	}
+	if !tm.Clone().ContainsValue("apple") {
+		t.Error("Clone should keep the value equality")
+	}
+
+	if !lower.ContainsValueFunc("APPLE", fold) || !lower.EqualsFunc(tm, fold) {
+		t.Error("Per-call equality functions should override the default")
+	}
+}
+
+func BenchmarkTreeMapSortedPut(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		tm := NewTreeMap[int, int](lessInt)
+		for key := 0; key < 10000; key++ {
+			tm.Put(key, key)
+		}
+	}
+}
+
+func TestTreeMapSnapshot(t *testing.T) {
+	tm := NewTreeMap[int, string](lessInt)
+	for i := 0; i < 100; i++ {
+		tm.Put(i, strconv.Itoa(i))
+	}
+	snapshot := tm.Snapshot()
+
+	done := make(chan []int)
+	go func() {
+		// Readers see a consistent view while the map is being rewritten
+		var keys []int
+		snapshot.ForEach(func(k int, v string) {
+			keys = append(keys, k)
+		})
+		done <- keys
+	}()
+	for i := 0; i < 100; i += 2 {
+		tm.Remove(i)
+	}
+	tm.Put(7, "seven")
+	tm.Merge(200, "x", func(current, value string) string { return current + value })
+
+	if keys := <-done; len(keys) != 100 || keys[0] != 0 || keys[99] != 99 {
+		t.Errorf("Expected snapshot iteration to see 100 keys, got %d", len(keys))
+	}
+	if v, _ := snapshot.Get(7); v != "7" || !snapshot.ContainsKey(0) || snapshot.ContainsKey(200) {
+		t.Errorf("Expected snapshot to be unchanged, got %v", snapshot)
+	}
+	if v, _ := tm.Get(7); v != "seven" || tm.Size() != 51 || !tm.IsBalanced() {
+		t.Errorf("Expected map to reflect the writes, got size %d", tm.Size())
+	}
+	if k, _, ok := snapshot.Floor(150); !ok || k != 99 {
+		t.Errorf("Expected snapshot Floor(150) to be 99, got %d", k)
+	}
+
+	copied := snapshot.ToTreeMap()
+	copied.Clear()
+	if snapshot.Size() != 100 {
+		t.Error("Modifying ToTreeMap result should not affect the snapshot")
+	}
+}
+
+func TestTreeMapSnapshotPathCopying(t *testing.T) {
+	tm := NewTreeMap[int, int](lessInt)
+	for i := 0; i < 1024; i++ {
+		tm.Put(i, i)
+	}
+	snapshot := tm.Snapshot()
+
+	// Each write copies only the nodes on its path, leaving the rest shared with the snapshot.
+	shared := make(map[*TreeMapNode[int, int]]bool)
+	var collect func(node *TreeMapNode[int, int])
+	collect = func(node *TreeMapNode[int, int]) {
+		if node != nil {
+			shared[node] = true
+			collect(node.Left)
+			collect(node.Right)
+		}
+	}
+	collect(snapshot.tm.root)
+
+	tm.Put(500, -1)
+	tm.Remove(10)
+	copied := 0
+	var count func(node *TreeMapNode[int, int])
+	count = func(node *TreeMapNode[int, int]) {
+		if node != nil {
+			if !shared[node] {
+				copied++
+			}
+			count(node.Left)
+			count(node.Right)
+		}
+	}
+	count(tm.root)
+	if limit := 2 * (tm.Height() + 2); copied > limit {
+		t.Errorf("Expected at most %d copied nodes, got %d", limit, copied)
+	}
+
+	if v, _ := snapshot.Get(500); v != 500 || !snapshot.ContainsKey(10) || snapshot.Size() != 1024 {
+		t.Errorf("Expected snapshot to be unchanged, got %d at 500", v)
+	}
+	if v, _ := tm.Get(500); v != -1 || tm.ContainsKey(10) || !tm.IsBalanced() {
+		t.Errorf("Expected map to reflect the writes, got %d at 500", v)
+	}
+
+	again := snapshot.ToTreeMap()
+	again.Put(500, 0)
+	if v, _ := snapshot.Get(500); v != 500 {
+		t.Errorf("Expected ToTreeMap writes to leave the snapshot alone, got %d", v)
+	}
+}
+
+func TestTreeMapAll(t *testing.T) {
+	tm := NewTreeMap[int, string](lessInt)
+	for _, k := range []int{3, 1, 2} {
+		tm.Put(k, strconv.Itoa(k))
+	}
+
+	var keys []int
+	for k, v := range tm.All() {
+		if v != strconv.Itoa(k) {
+			t.Errorf("Expected value %q for key %d, got %q", strconv.Itoa(k), k, v)
+		}
+		keys = append(keys, k)
+	}
+	if len(keys) != 3 || keys[0] != 1 || keys[2] != 3 {
+		t.Errorf("Expected keys in order [1 2 3], got %v", keys)
+	}
+}
+
+func TestOrderedTreeMap(t *testing.T) {
+	tm := NewOrderedTreeMap[string, int]()
+	tm.Put("b", 2)
+	tm.Put("a", 1)
+	tm.Put("c", 3)
+	if keys := tm.Keys(); len(keys) != 3 || keys[0] != "a" || keys[2] != "c" {
+		t.Errorf("Expected keys [a b c], got %v", keys)
+	}
 }
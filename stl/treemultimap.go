@@ -0,0 +1,236 @@
+package stl
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// TreeMultiMap represents a multimap whose keys are kept in sorted order by a comparator. It
+// combines the ordered queries of TreeMap with the multiple values per key of MultiMap; the
+// values of each key keep their insertion order.
+type TreeMultiMap[K comparable, V any] struct {
+	tree *TreeMap[K, []V]
+	size int
+}
+
+// NewTreeMultiMap creates a new empty TreeMultiMap with a key comparator function.
+func NewTreeMultiMap[K comparable, V any](less func(K, K) bool) *TreeMultiMap[K, V] {
+	return &TreeMultiMap[K, V]{tree: NewTreeMap[K, []V](less)}
+}
+
+// Put adds a value for the given key.
+func (mm *TreeMultiMap[K, V]) Put(key K, value V) {
+	values, _ := mm.tree.Get(key)
+	mm.tree.Put(key, append(values, value))
+	mm.size++
+}
+
+// PutAll adds multiple values for the given key.
+func (mm *TreeMultiMap[K, V]) PutAll(key K, values []V) {
+	if len(values) == 0 {
+		return
+	}
+	existing, _ := mm.tree.Get(key)
+	mm.tree.Put(key, append(existing, values...))
+	mm.size += len(values)
+}
+
+// Get returns a copy of the values associated with the given key.
+func (mm *TreeMultiMap[K, V]) Get(key K) []V {
+	values, _ := mm.tree.Get(key)
+	return append([]V{}, values...)
+}
+
+// Remove removes one occurrence of a value for the given key and reports whether it was
+// present. The key is removed with its last value.
+func (mm *TreeMultiMap[K, V]) Remove(key K, value V) bool {
+	values, exists := mm.tree.Get(key)
+	if !exists {
+		return false
+	}
+	for i, v := range values {
+		if elementsEqual(nil, v, value) {
+			values = slices.Delete(values, i, i+1)
+			if len(values) == 0 {
+				mm.tree.Remove(key)
+			} else {
+				mm.tree.Put(key, values)
+			}
+			mm.size--
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveAll removes all values for the given key.
+func (mm *TreeMultiMap[K, V]) RemoveAll(key K) bool {
+	values, exists := mm.tree.Get(key)
+	if !exists {
+		return false
+	}
+	mm.tree.Remove(key)
+	mm.size -= len(values)
+	return true
+}
+
+// ContainsKey checks if the multimap contains the given key.
+func (mm *TreeMultiMap[K, V]) ContainsKey(key K) bool {
+	return mm.tree.ContainsKey(key)
+}
+
+// ContainsEntry checks if the multimap contains the given key-value pair.
+func (mm *TreeMultiMap[K, V]) ContainsEntry(key K, value V) bool {
+	values, _ := mm.tree.Get(key)
+	for _, v := range values {
+		if elementsEqual(nil, v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Size returns the total number of key-value pairs.
+func (mm *TreeMultiMap[K, V]) Size() int {
+	return mm.size
+}
+
+// KeySize returns the number of unique keys.
+func (mm *TreeMultiMap[K, V]) KeySize() int {
+	return mm.tree.Size()
+}
+
+// ValueCount returns the number of values for a given key.
+func (mm *TreeMultiMap[K, V]) ValueCount(key K) int {
+	values, _ := mm.tree.Get(key)
+	return len(values)
+}
+
+// IsEmpty checks if the multimap is empty.
+func (mm *TreeMultiMap[K, V]) IsEmpty() bool {
+	return mm.size == 0
+}
+
+// Clear removes all key-value pairs.
+func (mm *TreeMultiMap[K, V]) Clear() {
+	mm.tree.Clear()
+	mm.size = 0
+}
+
+// Keys returns the keys in sorted order.
+func (mm *TreeMultiMap[K, V]) Keys() []K {
+	return mm.tree.Keys()
+}
+
+// Values returns all values in key order.
+func (mm *TreeMultiMap[K, V]) Values() []V {
+	result := make([]V, 0, mm.size)
+	mm.tree.ForEach(func(_ K, values []V) {
+		result = append(result, values...)
+	})
+	return result
+}
+
+// entry converts a result of the underlying TreeMap, copying the values.
+func (mm *TreeMultiMap[K, V]) entry(key K, values []V, ok bool) (K, []V, bool) {
+	return key, append([]V(nil), values...), ok
+}
+
+// MinKey returns the smallest key and its values.
+func (mm *TreeMultiMap[K, V]) MinKey() (K, []V, bool) {
+	return mm.entry(mm.tree.Min())
+}
+
+// MaxKey returns the largest key and its values.
+func (mm *TreeMultiMap[K, V]) MaxKey() (K, []V, bool) {
+	return mm.entry(mm.tree.Max())
+}
+
+// Floor returns the largest key less than or equal to the given key, and its values.
+func (mm *TreeMultiMap[K, V]) Floor(key K) (K, []V, bool) {
+	return mm.entry(mm.tree.Floor(key))
+}
+
+// Ceiling returns the smallest key greater than or equal to the given key, and its values.
+func (mm *TreeMultiMap[K, V]) Ceiling(key K) (K, []V, bool) {
+	return mm.entry(mm.tree.Ceiling(key))
+}
+
+// Lower returns the largest key strictly less than the given key, and its values.
+func (mm *TreeMultiMap[K, V]) Lower(key K) (K, []V, bool) {
+	return mm.entry(mm.tree.Lower(key))
+}
+
+// Higher returns the smallest key strictly greater than the given key, and its values.
+func (mm *TreeMultiMap[K, V]) Higher(key K) (K, []V, bool) {
+	return mm.entry(mm.tree.Higher(key))
+}
+
+// Range returns all values whose keys are between min and max (inclusive), in key order.
+func (mm *TreeMultiMap[K, V]) Range(min, max K) []V {
+	var result []V
+	mm.RangeFunc(min, max, func(_ K, value V) bool {
+		result = append(result, value)
+		return true
+	})
+	return result
+}
+
+// RangeFunc calls fn for each key-value pair whose key is between min and max (inclusive), in
+// key order, stopping early if fn returns false.
+func (mm *TreeMultiMap[K, V]) RangeFunc(min, max K, fn func(K, V) bool) {
+	mm.tree.RangeFunc(min, max, func(key K, values []V) bool {
+		for _, value := range values {
+			if !fn(key, value) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// ForEach applies a function to each key-value pair in key order.
+func (mm *TreeMultiMap[K, V]) ForEach(fn func(K, V)) {
+	mm.tree.ForEach(func(key K, values []V) {
+		for _, value := range values {
+			fn(key, value)
+		}
+	})
+}
+
+// ForEachKey applies a function to each key and its values in key order.
+func (mm *TreeMultiMap[K, V]) ForEachKey(fn func(K, []V)) {
+	mm.tree.ForEach(fn)
+}
+
+// All returns an iterator over every key-value pair in key order.
+func (mm *TreeMultiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, values := range mm.tree.All() {
+			for _, value := range values {
+				if !yield(key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Clone creates a copy of the multimap.
+func (mm *TreeMultiMap[K, V]) Clone() *TreeMultiMap[K, V] {
+	return &TreeMultiMap[K, V]{
+		tree: mm.tree.CloneWith(nil, slices.Clone[[]V]),
+		size: mm.size,
+	}
+}
+
+// String returns a string representation of the multimap in key order.
+func (mm *TreeMultiMap[K, V]) String() string {
+	var parts []string
+	mm.tree.ForEach(func(key K, values []V) {
+		parts = append(parts, fmt.Sprintf("%v:%v", key, values))
+	})
+	return "TreeMultiMap[" + strings.Join(parts, " ") + "]"
+}
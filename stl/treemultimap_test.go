@@ -0,0 +1,91 @@
+package stl
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTreeMultiMapBasicOperations(t *testing.T) {
+	mm := NewTreeMultiMap[int, string](lessInt)
+	mm.Put(20, "b")
+	mm.Put(10, "a")
+	mm.Put(20, "c")
+	mm.PutAll(30, []string{"d", "e"})
+
+	if mm.Size() != 5 || mm.KeySize() != 3 || mm.ValueCount(20) != 2 {
+		t.Errorf("Expected 5 pairs under 3 keys, got %v", mm)
+	}
+	if !slices.Equal(mm.Keys(), []int{10, 20, 30}) || !slices.Equal(mm.Values(), []string{"a", "b", "c", "d", "e"}) {
+		t.Errorf("Expected keys [10 20 30] and values [a b c d e], got %v and %v", mm.Keys(), mm.Values())
+	}
+	if got := mm.String(); got != "TreeMultiMap[10:[a] 20:[b c] 30:[d e]]" {
+		t.Errorf("Expected TreeMultiMap[10:[a] 20:[b c] 30:[d e]], got %s", got)
+	}
+
+	values := mm.Get(20)
+	values[0] = "z"
+	if !mm.ContainsEntry(20, "b") || mm.ContainsEntry(20, "z") {
+		t.Error("Expected Get to return a copy")
+	}
+
+	if !mm.Remove(20, "b") || mm.Remove(20, "b") || !mm.Remove(10, "a") || mm.ContainsKey(10) {
+		t.Errorf("Expected each value to be removed once and 10 to go with its last value, got %v", mm)
+	}
+	if !mm.RemoveAll(30) || mm.RemoveAll(30) || mm.Size() != 1 {
+		t.Errorf("Expected only 20:[c] left, got %v", mm)
+	}
+
+	mm.Clear()
+	if !mm.IsEmpty() || len(mm.Get(20)) != 0 {
+		t.Errorf("Expected an empty multimap, got %v", mm)
+	}
+}
+
+func TestTreeMultiMapOrderedQueries(t *testing.T) {
+	// Events keyed by timestamp, several per timestamp
+	mm := NewTreeMultiMap[int, string](lessInt)
+	for _, e := range []struct {
+		at    int
+		event string
+	}{{100, "start"}, {200, "read"}, {200, "write"}, {300, "read"}, {400, "stop"}} {
+		mm.Put(e.at, e.event)
+	}
+
+	if got := mm.Range(150, 300); !slices.Equal(got, []string{"read", "write", "read"}) {
+		t.Errorf("Expected [read write read], got %v", got)
+	}
+	if key, values, ok := mm.Floor(250); !ok || key != 200 || !slices.Equal(values, []string{"read", "write"}) {
+		t.Errorf("Expected 200 [read write], got %d %v", key, values)
+	}
+	if key, _, ok := mm.Ceiling(250); !ok || key != 300 {
+		t.Errorf("Expected 300, got %d", key)
+	}
+	if _, _, ok := mm.Higher(400); ok {
+		t.Error("Expected no key above 400")
+	}
+	if key, _, ok := mm.Lower(100); ok {
+		t.Errorf("Expected no key below 100, got %d", key)
+	}
+	if key, values, _ := mm.MinKey(); key != 100 || values[0] != "start" {
+		t.Errorf("Expected 100 [start], got %d %v", key, values)
+	}
+
+	var seen []string
+	mm.RangeFunc(0, 1000, func(_ int, event string) bool {
+		seen = append(seen, event)
+		return len(seen) < 2
+	})
+	if !slices.Equal(seen, []string{"start", "read"}) {
+		t.Errorf("Expected RangeFunc to stop after 2 values, got %v", seen)
+	}
+
+	count := 0
+	for range mm.All() {
+		count++
+	}
+	clone := mm.Clone()
+	clone.Put(500, "late")
+	if count != 5 || mm.ContainsKey(500) || clone.Size() != 6 {
+		t.Errorf("Expected 5 pairs and an independent clone, got %d and %v", count, clone)
+	}
+}
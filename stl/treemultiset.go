@@ -0,0 +1,176 @@
+package stl
+
+import (
+	"fmt"
+	"iter"
+)
+
+// TreeMultiSet represents an ordered collection that allows duplicate elements, like C++
+// std::multiset. It is backed by the size-augmented AVL tree of SortedList, so adding,
+// removing, counting, and rank queries take O(log n), with n counting duplicates. AddCount
+// takes O(log n + count) and RemoveAll O(log n), however many copies there are.
+type TreeMultiSet[T comparable] struct {
+	list *SortedList[T]
+}
+
+// NewTreeMultiSet creates a new empty TreeMultiSet with a comparator function.
+func NewTreeMultiSet[T comparable](less func(T, T) bool) *TreeMultiSet[T] {
+	return &TreeMultiSet[T]{list: NewSortedList(less)}
+}
+
+// NewTreeMultiSetFromSlice creates a TreeMultiSet holding every element of the slice.
+func NewTreeMultiSetFromSlice[T comparable](slice []T, less func(T, T) bool) *TreeMultiSet[T] {
+	return &TreeMultiSet[T]{list: NewSortedListFromSlice(slice, less)}
+}
+
+// Add adds one occurrence of an element.
+func (ms *TreeMultiSet[T]) Add(element T) {
+	ms.list.Add(element)
+}
+
+// AddCount adds count occurrences of an element.
+func (ms *TreeMultiSet[T]) AddCount(element T, count int) {
+	if count > 0 {
+		ms.list.insertRun(element, count)
+	}
+}
+
+// Remove removes one occurrence of an element and reports whether it was present.
+func (ms *TreeMultiSet[T]) Remove(element T) bool {
+	return ms.list.Remove(element)
+}
+
+// RemoveAll removes all occurrences of an element and returns how many there were.
+func (ms *TreeMultiSet[T]) RemoveAll(element T) int {
+	from, to := ms.list.Rank(element), ms.list.RankRight(element)
+	if from < to {
+		ms.list.removeRange(from, to)
+	}
+	return to - from
+}
+
+// Count returns the number of occurrences of an element.
+func (ms *TreeMultiSet[T]) Count(element T) int {
+	return ms.list.Count(element)
+}
+
+// Contains checks if an element exists in the multiset.
+func (ms *TreeMultiSet[T]) Contains(element T) bool {
+	return ms.list.Contains(element)
+}
+
+// Size returns the total number of elements, including duplicates.
+func (ms *TreeMultiSet[T]) Size() int {
+	return ms.list.Size()
+}
+
+// IsEmpty checks if the multiset is empty.
+func (ms *TreeMultiSet[T]) IsEmpty() bool {
+	return ms.list.IsEmpty()
+}
+
+// Clear removes all elements from the multiset.
+func (ms *TreeMultiSet[T]) Clear() {
+	ms.list.Clear()
+}
+
+// Min returns the smallest element.
+func (ms *TreeMultiSet[T]) Min() (T, bool) {
+	return ms.list.Min()
+}
+
+// Max returns the largest element.
+func (ms *TreeMultiSet[T]) Max() (T, bool) {
+	return ms.list.Max()
+}
+
+// Floor returns the largest element less than or equal to the given element.
+func (ms *TreeMultiSet[T]) Floor(element T) (T, bool) {
+	return ms.list.At(ms.list.RankRight(element) - 1)
+}
+
+// Ceiling returns the smallest element greater than or equal to the given element.
+func (ms *TreeMultiSet[T]) Ceiling(element T) (T, bool) {
+	return ms.list.At(ms.list.Rank(element))
+}
+
+// Lower returns the largest element strictly less than the given element.
+func (ms *TreeMultiSet[T]) Lower(element T) (T, bool) {
+	return ms.list.At(ms.list.Rank(element) - 1)
+}
+
+// Higher returns the smallest element strictly greater than the given element.
+func (ms *TreeMultiSet[T]) Higher(element T) (T, bool) {
+	return ms.list.At(ms.list.RankRight(element))
+}
+
+// Rank returns the number of elements less than the given element, counting duplicates.
+func (ms *TreeMultiSet[T]) Rank(element T) int {
+	return ms.list.Rank(element)
+}
+
+// Select returns the element at the given index in sorted order, counting duplicates.
+func (ms *TreeMultiSet[T]) Select(index int) (T, bool) {
+	return ms.list.At(index)
+}
+
+// CountRange returns the number of elements between from and to (inclusive), counting
+// duplicates.
+func (ms *TreeMultiSet[T]) CountRange(from, to T) int {
+	return max(ms.list.RankRight(to)-ms.list.Rank(from), 0)
+}
+
+// Range returns the elements between from and to (inclusive) in sorted order.
+func (ms *TreeMultiSet[T]) Range(from, to T) []T {
+	return ms.list.Range(from, to)
+}
+
+// ToSlice returns the elements, with duplicates, in sorted order.
+func (ms *TreeMultiSet[T]) ToSlice() []T {
+	return ms.list.ToSlice()
+}
+
+// AppendTo appends the elements in sorted order to dst and returns the extended slice.
+func (ms *TreeMultiSet[T]) AppendTo(dst []T) []T {
+	return ms.list.AppendTo(dst)
+}
+
+// ForEach applies a function to each element, with duplicates, in sorted order.
+func (ms *TreeMultiSet[T]) ForEach(fn func(T)) {
+	ms.list.ForEach(fn)
+}
+
+// ForEachUnique applies a function to each distinct element and its count, in sorted order.
+func (ms *TreeMultiSet[T]) ForEachUnique(fn func(T, int)) {
+	for i := 0; i < ms.list.Size(); {
+		element, _ := ms.list.At(i)
+		count := ms.list.Count(element)
+		fn(element, count)
+		i += count
+	}
+}
+
+// All returns an iterator over the elements, with duplicates, in sorted order.
+func (ms *TreeMultiSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		walkAVLNode(ms.list.tree.root, yield)
+	}
+}
+
+// walkAVLNode yields the values of a subtree in order and reports whether to continue.
+func walkAVLNode[T comparable](node *avlNode[T], yield func(T) bool) bool {
+	if node == nil {
+		return true
+	}
+	return walkAVLNode(node.left, yield) && yield(node.value) && walkAVLNode(node.right, yield)
+}
+
+// Clone creates a copy of the multiset.
+func (ms *TreeMultiSet[T]) Clone() *TreeMultiSet[T] {
+	return &TreeMultiSet[T]{list: ms.list.Clone()}
+}
+
+// String returns a string representation of the multiset.
+func (ms *TreeMultiSet[T]) String() string {
+	return fmt.Sprintf("TreeMultiSet%v", ms.ToSlice())
+}
@@ -0,0 +1,130 @@
+package stl
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestTreeMultiSetBasicOperations(t *testing.T) {
+	ms := NewTreeMultiSetFromSlice([]int{5, 1, 3, 3, 5, 5}, lessInt)
+	ms.AddCount(4, 2)
+	if !slices.Equal(ms.ToSlice(), []int{1, 3, 3, 4, 4, 5, 5, 5}) {
+		t.Errorf("Expected [1 3 3 4 4 5 5 5], got %v", ms)
+	}
+	if ms.Count(5) != 3 || ms.Count(2) != 0 || !ms.Contains(4) {
+		t.Errorf("Expected counts 5:3 2:0 and 4 present, got %v", ms)
+	}
+
+	if !ms.Remove(5) || ms.Count(5) != 2 || ms.Remove(2) {
+		t.Errorf("Expected Remove to drop one 5 and fail for 2, got %v", ms)
+	}
+	if removed := ms.RemoveAll(4); removed != 2 || ms.Contains(4) || ms.Size() != 5 {
+		t.Errorf("Expected 2 removed leaving 5 elements, got %d and %v", removed, ms)
+	}
+
+	var unique []int
+	var counts []int
+	ms.ForEachUnique(func(element, count int) {
+		unique = append(unique, element)
+		counts = append(counts, count)
+	})
+	if !slices.Equal(unique, []int{1, 3, 5}) || !slices.Equal(counts, []int{1, 2, 2}) {
+		t.Errorf("Expected [1 3 5] with counts [1 2 2], got %v and %v", unique, counts)
+	}
+	if got := slices.Collect(ms.All()); !slices.Equal(got, ms.ToSlice()) {
+		t.Errorf("Expected All to match ToSlice, got %v", got)
+	}
+}
+
+func TestTreeMultiSetOrderedQueries(t *testing.T) {
+	ms := NewTreeMultiSetFromSlice([]int{10, 20, 20, 20, 30}, lessInt)
+
+	if ms.Rank(20) != 1 || ms.Rank(25) != 4 || ms.Rank(5) != 0 {
+		t.Errorf("Expected ranks 1, 4, and 0, got %d, %d, and %d", ms.Rank(20), ms.Rank(25), ms.Rank(5))
+	}
+	if value, ok := ms.Select(3); !ok || value != 20 {
+		t.Errorf("Expected 20 at index 3, got %d", value)
+	}
+	if ms.CountRange(15, 30) != 4 || ms.CountRange(21, 29) != 0 || ms.CountRange(30, 10) != 0 {
+		t.Errorf("Expected range counts 4, 0, and 0, got %d, %d, and %d", ms.CountRange(15, 30), ms.CountRange(21, 29), ms.CountRange(30, 10))
+	}
+	if got := ms.Range(20, 25); !slices.Equal(got, []int{20, 20, 20}) {
+		t.Errorf("Expected [20 20 20], got %v", got)
+	}
+
+	tests := []struct {
+		name  string
+		query func(int) (int, bool)
+		arg   int
+		want  int
+		found bool
+	}{
+		{"Floor", ms.Floor, 20, 20, true},
+		{"Floor", ms.Floor, 25, 20, true},
+		{"Floor", ms.Floor, 5, 0, false},
+		{"Ceiling", ms.Ceiling, 20, 20, true},
+		{"Ceiling", ms.Ceiling, 31, 0, false},
+		{"Lower", ms.Lower, 20, 10, true},
+		{"Higher", ms.Higher, 20, 30, true},
+		{"Higher", ms.Higher, 30, 0, false},
+	}
+	for _, tt := range tests {
+		got, found := tt.query(tt.arg)
+		if found != tt.found || (found && got != tt.want) {
+			t.Errorf("%s(%d): Expected %d, %v, got %d, %v", tt.name, tt.arg, tt.want, tt.found, got, found)
+		}
+	}
+
+	if smallest, _ := ms.Min(); smallest != 10 {
+		t.Errorf("Expected min 10, got %d", smallest)
+	}
+	clone := ms.Clone()
+	clone.Clear()
+	if clone.Size() != 0 || ms.Size() != 5 {
+		t.Errorf("Expected clearing the clone to leave the original, got %v and %v", clone, ms)
+	}
+}
+
+// checkAVLNode verifies the cached heights and sizes and the balance of a subtree, and
+// returns its height.
+func checkAVLNode(t *testing.T, node *avlNode[int]) int {
+	if node == nil {
+		return 0
+	}
+	left, right := checkAVLNode(t, node.left), checkAVLNode(t, node.right)
+	if height := 1 + max(left, right); node.height != height || abs(left-right) > 1 {
+		t.Fatalf("Expected a balanced node of height %d, got height %d with children %d and %d", height, node.height, left, right)
+	}
+	if size := 1 + avlSize(node.left) + avlSize(node.right); node.size != size {
+		t.Fatalf("Expected cached size %d, got %d", size, node.size)
+	}
+	return node.height
+}
+
+func TestTreeMultiSetBulkCounts(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	ms := NewTreeMultiSet(lessInt)
+	var expected []int
+	for i := 0; i < 300; i++ {
+		element := r.Intn(20)
+		if r.Intn(3) == 0 {
+			before := len(expected)
+			expected = slices.DeleteFunc(expected, func(x int) bool { return x == element })
+			if removed := ms.RemoveAll(element); removed != before-len(expected) {
+				t.Fatalf("RemoveAll(%d): expected %d, got %d", element, before-len(expected), removed)
+			}
+		} else {
+			count := r.Intn(50)
+			ms.AddCount(element, count)
+			for j := 0; j < count; j++ {
+				expected = append(expected, element)
+			}
+			slices.Sort(expected)
+		}
+		checkAVLNode(t, ms.list.tree.root)
+		if !slices.Equal(ms.ToSlice(), expected) || ms.Size() != len(expected) {
+			t.Fatalf("Expected %v, got %v", expected, ms)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package stl
+
+import (
+	"fmt"
+)
+
+// TreeSet represents an ordered set of unique elements backed by the balanced TreeMap engine.
+type TreeSet[T comparable] struct {
+	tree *TreeMap[T, struct{}]
+}
+
+// NewTreeSet creates a new empty TreeSet with a comparator function.
+func NewTreeSet[T comparable](less func(T, T) bool) *TreeSet[T] {
+	return &TreeSet[T]{
+		tree: NewTreeMap[T, struct{}](less),
+	}
+}
+
+// NewTreeSetFromSlice creates a TreeSet from a slice, removing duplicates.
+func NewTreeSetFromSlice[T comparable](slice []T, less func(T, T) bool) *TreeSet[T] {
+	ts := NewTreeSet[T](less)
+	for _, element := range slice {
+		ts.Add(element)
+	}
+	return ts
+}
+
+// Add adds an element to the set.
+func (ts *TreeSet[T]) Add(element T) {
+	ts.tree.Put(element, struct{}{})
+}
+
+// Remove removes an element from the set and reports whether it was present.
+func (ts *TreeSet[T]) Remove(element T) bool {
+	return ts.tree.Remove(element)
+}
+
+// Contains checks if an element exists in the set.
+func (ts *TreeSet[T]) Contains(element T) bool {
+	return ts.tree.ContainsKey(element)
+}
+
+// Size returns the number of elements in the set.
+func (ts *TreeSet[T]) Size() int {
+	return ts.tree.Size()
+}
+
+// IsEmpty checks if the set is empty.
+func (ts *TreeSet[T]) IsEmpty() bool {
+	return ts.tree.IsEmpty()
+}
+
+// Clear removes all elements from the set.
+func (ts *TreeSet[T]) Clear() {
+	ts.tree.Clear()
+}
+
+// Min returns the smallest element.
+func (ts *TreeSet[T]) Min() (T, bool) {
+	element, _, ok := ts.tree.Min()
+	return element, ok
+}
+
+// Max returns the largest element.
+func (ts *TreeSet[T]) Max() (T, bool) {
+	element, _, ok := ts.tree.Max()
+	return element, ok
+}
+
+// Floor returns the largest element less than or equal to the given element.
+func (ts *TreeSet[T]) Floor(element T) (T, bool) {
+	result, _, ok := ts.tree.Floor(element)
+	return result, ok
+}
+
+// Ceiling returns the smallest element greater than or equal to the given element.
+func (ts *TreeSet[T]) Ceiling(element T) (T, bool) {
+	result, _, ok := ts.tree.Ceiling(element)
+	return result, ok
+}
+
+// Lower returns the largest element strictly less than the given element.
+func (ts *TreeSet[T]) Lower(element T) (T, bool) {
+	result, _, ok := ts.tree.Lower(element)
+	return result, ok
+}
+
+// Higher returns the smallest element strictly greater than the given element.
+func (ts *TreeSet[T]) Higher(element T) (T, bool) {
+	result, _, ok := ts.tree.Higher(element)
+	return result, ok
+}
+
+// Rank returns the number of elements less than the given element.
+func (ts *TreeSet[T]) Rank(element T) int {
+	return ts.tree.Rank(element)
+}
+
+// Select returns the element with the given rank.
+func (ts *TreeSet[T]) Select(rank int) (T, bool) {
+	element, _, ok := ts.tree.Select(rank)
+	return element, ok
+}
+
+// Range returns all elements between min and max (inclusive) in sorted order.
+func (ts *TreeSet[T]) Range(min, max T) []T {
+	var result []T
+	ts.tree.RangeFunc(min, max, func(element T, _ struct{}) bool {
+		result = append(result, element)
+		return true
+	})
+	return result
+}
+
+// CountRange returns the number of elements between min and max (inclusive).
+func (ts *TreeSet[T]) CountRange(min, max T) int {
+	return ts.tree.CountRange(min, max)
+}
+
+// ToSlice returns the elements in sorted order.
+func (ts *TreeSet[T]) ToSlice() []T {
+	return ts.tree.Keys()
+}
+
+// AppendTo appends the elements in sorted order to dst and returns the extended slice.
+func (ts *TreeSet[T]) AppendTo(dst []T) []T {
+	return ts.tree.AppendKeys(dst)
+}
+
+// ForEach applies a function to each element in sorted order.
+func (ts *TreeSet[T]) ForEach(fn func(T)) {
+	ts.tree.ForEach(func(element T, _ struct{}) {
+		fn(element)
+	})
+}
+
+// Iterator returns a seekable iterator over the elements in sorted order. It navigates by
+// value, so the set may be modified while iterating.
+func (ts *TreeSet[T]) Iterator() SeekableIterator[T] {
+	return &orderedIterator[T]{
+		min:     ts.Min,
+		max:     ts.Max,
+		higher:  ts.Higher,
+		lower:   ts.Lower,
+		ceiling: ts.Ceiling,
+		remove:  func(element T) { ts.Remove(element) },
+	}
+}
+
+// Filter returns a new set containing elements that satisfy the predicate.
+func (ts *TreeSet[T]) Filter(predicate func(T) bool) *TreeSet[T] {
+	return &TreeSet[T]{
+		tree: ts.tree.Filter(func(element T, _ struct{}) bool {
+			return predicate(element)
+		}),
+	}
+}
+
+// Union returns a new set containing all elements from both sets, ordered by the receiver's
+// comparator.
+func (ts *TreeSet[T]) Union(other *TreeSet[T]) *TreeSet[T] {
+	result := ts.Clone()
+	other.ForEach(result.Add)
+	return result
+}
+
+// Intersection returns a new set containing elements present in both sets.
+func (ts *TreeSet[T]) Intersection(other *TreeSet[T]) *TreeSet[T] {
+	return ts.Filter(other.Contains)
+}
+
+// Difference returns a new set containing elements in ts but not in other.
+func (ts *TreeSet[T]) Difference(other *TreeSet[T]) *TreeSet[T] {
+	return ts.Filter(func(element T) bool {
+		return !other.Contains(element)
+	})
+}
+
+// Clone creates a copy of the set.
+func (ts *TreeSet[T]) Clone() *TreeSet[T] {
+	return &TreeSet[T]{
+		tree: ts.tree.Clone(),
+	}
+}
+
+// CloneWith creates a copy of the set whose elements are copied by cloneElem, which must
+// preserve their order.
+func (ts *TreeSet[T]) CloneWith(cloneElem func(T) T) *TreeSet[T] {
+	return &TreeSet[T]{
+		tree: ts.tree.CloneWith(cloneElem, nil),
+	}
+}
+
+// Equals checks if two sets contain the same elements.
+func (ts *TreeSet[T]) Equals(other *TreeSet[T]) bool {
+	if ts.Size() != other.Size() {
+		return false
+	}
+	equal := true
+	ts.ForEach(func(element T) {
+		if equal && !other.Contains(element) {
+			equal = false
+		}
+	})
+	return equal
+}
+
+// String returns a string representation of the set.
+func (ts *TreeSet[T]) String() string {
+	return fmt.Sprintf("TreeSet%v", ts.ToSlice())
+}
@@ -0,0 +1,97 @@
+package stl
+
+import "testing"
+
+func TestTreeSetBasicOperations(t *testing.T) {
+	ts := NewTreeSetFromSlice([]int{5, 1, 3, 1, 9, 7}, lessInt)
+
+	if ts.Size() != 5 {
+		t.Errorf("Expected size 5 after removing duplicates, got %d", ts.Size())
+	}
+	if slice := ts.ToSlice(); len(slice) != 5 || slice[0] != 1 || slice[4] != 9 {
+		t.Errorf("Expected sorted elements [1 3 5 7 9], got %v", slice)
+	}
+	if !ts.Contains(3) || ts.Contains(4) {
+		t.Error("Contains returned an unexpected result")
+	}
+
+	if !ts.Remove(3) || ts.Remove(3) {
+		t.Error("Expected Remove to succeed once")
+	}
+	if ts.String() != "TreeSet[1 5 7 9]" {
+		t.Errorf("Unexpected string %s", ts.String())
+	}
+
+	ts.Clear()
+	if !ts.IsEmpty() {
+		t.Error("Set should be empty after Clear")
+	}
+	if _, ok := ts.Min(); ok {
+		t.Error("Min of empty set should fail")
+	}
+}
+
+func TestTreeSetNavigation(t *testing.T) {
+	ts := NewTreeSetFromSlice([]int{10, 20, 30, 40, 50}, lessInt)
+
+	if value, _ := ts.Min(); value != 10 {
+		t.Errorf("Expected min 10, got %d", value)
+	}
+	if value, _ := ts.Max(); value != 50 {
+		t.Errorf("Expected max 50, got %d", value)
+	}
+	if value, _ := ts.Floor(25); value != 20 {
+		t.Errorf("Expected floor 20, got %d", value)
+	}
+	if value, _ := ts.Ceiling(25); value != 30 {
+		t.Errorf("Expected ceiling 30, got %d", value)
+	}
+	if value, _ := ts.Lower(30); value != 20 {
+		t.Errorf("Expected lower 20, got %d", value)
+	}
+	if value, _ := ts.Higher(30); value != 40 {
+		t.Errorf("Expected higher 40, got %d", value)
+	}
+	if _, ok := ts.Higher(50); ok {
+		t.Error("Higher than the maximum should fail")
+	}
+	if rank := ts.Rank(35); rank != 3 {
+		t.Errorf("Expected rank 3, got %d", rank)
+	}
+	if value, _ := ts.Select(1); value != 20 {
+		t.Errorf("Expected element 20 at rank 1, got %d", value)
+	}
+	if values := ts.Range(15, 40); len(values) != 3 || values[0] != 20 {
+		t.Errorf("Expected range [20 30 40], got %v", values)
+	}
+	if count := ts.CountRange(15, 40); count != 3 {
+		t.Errorf("Expected 3 elements in range, got %d", count)
+	}
+}
+
+func TestTreeSetSetOperations(t *testing.T) {
+	a := NewTreeSetFromSlice([]int{1, 2, 3, 4}, lessInt)
+	b := NewTreeSetFromSlice([]int{3, 4, 5}, lessInt)
+
+	if union := a.Union(b); union.Size() != 5 {
+		t.Errorf("Expected union of 5 elements, got %v", union)
+	}
+	if intersection := a.Intersection(b); !intersection.Equals(NewTreeSetFromSlice([]int{3, 4}, lessInt)) {
+		t.Errorf("Expected intersection [3 4], got %v", intersection)
+	}
+	if difference := a.Difference(b); difference.String() != "TreeSet[1 2]" {
+		t.Errorf("Expected difference [1 2], got %v", difference)
+	}
+
+	clone := a.Clone()
+	clone.Add(10)
+	if a.Contains(10) || a.Equals(clone) {
+		t.Error("Clone should be independent of the original")
+	}
+
+	sum := 0
+	a.Filter(func(x int) bool { return x%2 == 0 }).ForEach(func(x int) { sum += x })
+	if sum != 6 {
+		t.Errorf("Expected sum of even elements 6, got %d", sum)
+	}
+}
@@ -2,6 +2,7 @@ package stl
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 )
 
@@ -154,6 +155,16 @@ func (t *Trie) Clear() {
 	t.size = 0
 }
 
+// Contains checks if a word exists in the trie.
+func (t *Trie) Contains(word string) bool {
+	return t.Search(word)
+}
+
+// ToSlice returns all words in the trie.
+func (t *Trie) ToSlice() []string {
+	return t.GetAllWords()
+}
+
 // GetAllWords returns all words in the trie.
 func (t *Trie) GetAllWords() []string {
 	var words []string
@@ -421,6 +432,31 @@ func (t *Trie) forEachRecursive(node *TrieNode, prefix string, fn func(string))
 	}
 }
 
+// All returns an iterator over the words in unspecified order.
+func (t *Trie) All() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		t.allRecursive(t.root, "", yield)
+	}
+}
+
+// allRecursive is the recursive helper for All. It returns false once yield asks to stop.
+func (t *Trie) allRecursive(node *TrieNode, prefix string, yield func(string) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	if node.isEnd && !yield(prefix) {
+		return false
+	}
+
+	for char, child := range node.children {
+		if !t.allRecursive(child, prefix+string(char), yield) {
+			return false
+		}
+	}
+	return true
+}
+
 // Filter returns a new trie containing words that satisfy the predicate.
 func (t *Trie) Filter(predicate func(string) bool) *Trie {
 	result := NewTrie()
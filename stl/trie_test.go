@@ -333,3 +333,24 @@ func contains(slice []string, str string) bool {
 	}
 	return false
 }
+
+func TestTrieAll(t *testing.T) {
+	trie := NewTrieFromSlice([]string{"go", "gopher", "rust"})
+
+	words := NewSet[string]()
+	for word := range trie.All() {
+		words.Add(word)
+	}
+	if words.Size() != 3 || !words.Contains("gopher") {
+		t.Errorf("Expected all three words, got %v", words)
+	}
+
+	count := 0
+	for range trie.All() {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("Expected iteration to stop after 1 word, got %d", count)
+	}
+}
@@ -0,0 +1,87 @@
+package stl
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+)
+
+// ValuePriorityQueue is a priority queue of values with separate ordered priorities, so the
+// common case needs neither a wrapper struct nor a comparator. Values with equal priority
+// dequeue in insertion order. Priorities are compared with cmp.Less, so a floating-point NaN
+// ranks below every other priority instead of breaking the heap order.
+type ValuePriorityQueue[V any, P cmp.Ordered] struct {
+	pq         *PriorityQueue[Pair[V, P]]
+	descending bool
+}
+
+// NewValuePriorityQueue creates a queue that dequeues the lowest priority first.
+func NewValuePriorityQueue[V any, P cmp.Ordered]() *ValuePriorityQueue[V, P] {
+	return &ValuePriorityQueue[V, P]{
+		pq: NewStablePriorityQueue(func(a, b Pair[V, P]) bool { return cmp.Less(a.Second, b.Second) }),
+	}
+}
+
+// NewMaxValuePriorityQueue creates a queue that dequeues the highest priority first.
+func NewMaxValuePriorityQueue[V any, P cmp.Ordered]() *ValuePriorityQueue[V, P] {
+	return &ValuePriorityQueue[V, P]{
+		pq:         NewStablePriorityQueue(func(a, b Pair[V, P]) bool { return cmp.Less(b.Second, a.Second) }),
+		descending: true,
+	}
+}
+
+// Enqueue adds a value with the given priority.
+func (q *ValuePriorityQueue[V, P]) Enqueue(value V, priority P) {
+	q.pq.Enqueue(Pair[V, P]{First: value, Second: priority})
+}
+
+// Dequeue removes and returns the value with the best priority together with its priority.
+func (q *ValuePriorityQueue[V, P]) Dequeue() (V, P, bool) {
+	entry, ok := q.pq.Dequeue()
+	return entry.First, entry.Second, ok
+}
+
+// Peek returns the value with the best priority and its priority without removing it.
+func (q *ValuePriorityQueue[V, P]) Peek() (V, P, bool) {
+	entry, ok := q.pq.Peek()
+	return entry.First, entry.Second, ok
+}
+
+// Size returns the number of values in the queue.
+func (q *ValuePriorityQueue[V, P]) Size() int {
+	return q.pq.Size()
+}
+
+// IsEmpty returns true if the queue is empty.
+func (q *ValuePriorityQueue[V, P]) IsEmpty() bool {
+	return q.pq.IsEmpty()
+}
+
+// Clear removes all values from the queue.
+func (q *ValuePriorityQueue[V, P]) Clear() {
+	q.pq.Clear()
+}
+
+// Values returns the values in the order Dequeue would return them.
+func (q *ValuePriorityQueue[V, P]) Values() []V {
+	values := make([]V, 0, q.pq.Size())
+	for entry := range q.pq.Sorted() {
+		values = append(values, entry.First)
+	}
+	return values
+}
+
+// Entries returns value-priority pairs in the order Dequeue would return them.
+func (q *ValuePriorityQueue[V, P]) Entries() []Pair[V, P] {
+	return q.pq.SortedSlice()
+}
+
+// String returns a string representation of the queue in priority order.
+func (q *ValuePriorityQueue[V, P]) String() string {
+	entries := q.Entries()
+	parts := make([]string, len(entries))
+	for i, entry := range entries {
+		parts[i] = fmt.Sprintf("%v:%v", entry.First, entry.Second)
+	}
+	return "ValuePriorityQueue[" + strings.Join(parts, " ") + "]"
+}
@@ -0,0 +1,75 @@
+package stl
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestValuePriorityQueue(t *testing.T) {
+	q := NewValuePriorityQueue[string, int]()
+	q.Enqueue("write docs", 3)
+	q.Enqueue("fix bug", 1)
+	q.Enqueue("review", 3)
+	q.Enqueue("deploy", 2)
+
+	if value, priority, _ := q.Peek(); value != "fix bug" || priority != 1 {
+		t.Errorf("Expected fix bug:1, got %s:%d", value, priority)
+	}
+	if q.String() != "ValuePriorityQueue[fix bug:1 deploy:2 write docs:3 review:3]" {
+		t.Errorf("Unexpected queue %s", q.String())
+	}
+
+	expected := []string{"fix bug", "deploy", "write docs", "review"}
+	for _, want := range expected {
+		if value, _, _ := q.Dequeue(); value != want {
+			t.Fatalf("Expected %s, got %s", want, value)
+		}
+	}
+	if _, _, ok := q.Dequeue(); ok {
+		t.Error("Dequeue on an empty queue should fail")
+	}
+
+	maxQueue := NewMaxValuePriorityQueue[string, float64]()
+	maxQueue.Enqueue("low", 0.1)
+	maxQueue.Enqueue("high", 0.9)
+	if values := maxQueue.Values(); values[0] != "high" || values[1] != "low" {
+		t.Errorf("Expected [high low], got %v", values)
+	}
+	maxQueue.Clear()
+	if !maxQueue.IsEmpty() || maxQueue.Size() != 0 {
+		t.Error("Queue should be empty after Clear")
+	}
+}
+
+func TestValuePriorityQueueNaN(t *testing.T) {
+	priorities := []float64{3, math.NaN(), 1, 5, math.NaN(), 2, 4}
+	minQueue := NewValuePriorityQueue[int, float64]()
+	maxQueue := NewMaxValuePriorityQueue[int, float64]()
+	for i, priority := range priorities {
+		minQueue.Enqueue(i, priority)
+		maxQueue.Enqueue(i, priority)
+	}
+
+	// NaN ranks lowest, so it comes first from a min queue and last from a max queue
+	var fromMin, fromMax []float64
+	for !minQueue.IsEmpty() {
+		_, priority, _ := minQueue.Dequeue()
+		fromMin = append(fromMin, priority)
+		_, priority, _ = maxQueue.Dequeue()
+		fromMax = append(fromMax, priority)
+	}
+	want := []float64{math.NaN(), math.NaN(), 1, 2, 3, 4, 5}
+	if !slices.EqualFunc(fromMin, want, sameFloat) {
+		t.Errorf("Expected %v, got %v", want, fromMin)
+	}
+	slices.Reverse(want)
+	if !slices.EqualFunc(fromMax, want, sameFloat) {
+		t.Errorf("Expected %v, got %v", want, fromMax)
+	}
+}
+
+// sameFloat reports whether a and b are equal or both NaN.
+func sameFloat(a, b float64) bool {
+	return a == b || math.IsNaN(a) && math.IsNaN(b)
+}
@@ -0,0 +1,228 @@
+package stl
+
+import (
+	"math"
+	"math/bits"
+	"math/rand"
+)
+
+// validWeight reports whether w can be used as a selection weight.
+func validWeight(w float64) bool {
+	return w >= 0 && !math.IsInf(w, 1)
+}
+
+// WeightedChooser picks items at random with probability proportional to fixed weights,
+// using Vose's alias method for O(1) picks.
+type WeightedChooser[T any] struct {
+	items []T
+	prob  []float64
+	alias []int
+}
+
+// NewWeightedChooser builds a chooser from items and their weights. It returns false if the
+// slices differ in length, a weight is negative, infinite, or NaN, or all weights are zero.
+func NewWeightedChooser[T any](items []T, weights []float64) (*WeightedChooser[T], bool) {
+	n := len(items)
+	if n == 0 || len(weights) != n {
+		return nil, false
+	}
+
+	total := 0.0
+	for _, w := range weights {
+		if !validWeight(w) {
+			return nil, false
+		}
+		total += w
+	}
+	if total == 0 {
+		return nil, false
+	}
+
+	wc := &WeightedChooser[T]{
+		items: append([]T(nil), items...),
+		prob:  make([]float64, n),
+		alias: make([]int, n),
+	}
+
+	// Scale weights so the average is 1, then pair each underfull slot with an overfull one
+	scaled := make([]float64, n)
+	var small, large []int
+	for i, w := range weights {
+		scaled[i] = w * float64(n) / total
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+
+	for len(small) > 0 && len(large) > 0 {
+		s := small[len(small)-1]
+		small = small[:len(small)-1]
+		l := large[len(large)-1]
+
+		wc.prob[s] = scaled[s]
+		wc.alias[s] = l
+		scaled[l] -= 1 - scaled[s]
+		if scaled[l] < 1 {
+			large = large[:len(large)-1]
+			small = append(small, l)
+		}
+	}
+
+	// Whatever remains is full up to rounding error
+	for _, i := range large {
+		wc.prob[i] = 1
+	}
+	for _, i := range small {
+		wc.prob[i] = 1
+	}
+	return wc, true
+}
+
+// Pick returns a random item chosen in proportion to its weight. A nil r uses a
+// time-seeded generator.
+func (wc *WeightedChooser[T]) Pick(r *rand.Rand) T {
+	if r == nil {
+		r = newRand(nil)
+	}
+	i := r.Intn(len(wc.items))
+	if r.Float64() < wc.prob[i] {
+		return wc.items[i]
+	}
+	return wc.items[wc.alias[i]]
+}
+
+// Size returns the number of items.
+func (wc *WeightedChooser[T]) Size() int {
+	return len(wc.items)
+}
+
+// Items returns a copy of the items in construction order.
+func (wc *WeightedChooser[T]) Items() []T {
+	return append([]T(nil), wc.items...)
+}
+
+// DynamicWeightedChooser picks items at random in proportion to weights that can change
+// after construction, keeping prefix sums in a Fenwick tree.
+type DynamicWeightedChooser[T any] struct {
+	items   []T
+	weights []float64
+	tree    []float64 // 1-indexed Fenwick tree over weights
+}
+
+// NewDynamicWeightedChooser creates an empty dynamic chooser.
+func NewDynamicWeightedChooser[T any]() *DynamicWeightedChooser[T] {
+	return &DynamicWeightedChooser[T]{tree: []float64{0}}
+}
+
+// Add appends an item with the given weight and returns its index, or -1 if the weight is
+// negative, infinite, or NaN.
+func (dc *DynamicWeightedChooser[T]) Add(item T, weight float64) int {
+	if !validWeight(weight) {
+		return -1
+	}
+
+	dc.items = append(dc.items, item)
+	dc.weights = append(dc.weights, weight)
+
+	// Node i covers the range (i - lowbit(i), i]
+	i := len(dc.items)
+	dc.tree = append(dc.tree, weight+dc.prefix(i-1)-dc.prefix(i-(i&-i)))
+	return i - 1
+}
+
+// SetWeight changes the weight of the item at index. It returns false if the index is out
+// of range or the weight is invalid.
+func (dc *DynamicWeightedChooser[T]) SetWeight(index int, weight float64) bool {
+	if index < 0 || index >= len(dc.items) || !validWeight(weight) {
+		return false
+	}
+
+	delta := weight - dc.weights[index]
+	dc.weights[index] = weight
+	for i := index + 1; i < len(dc.tree); i += i & -i {
+		dc.tree[i] += delta
+	}
+	return true
+}
+
+// Weight returns the weight of the item at index.
+func (dc *DynamicWeightedChooser[T]) Weight(index int) (float64, bool) {
+	if index < 0 || index >= len(dc.items) {
+		return 0, false
+	}
+	return dc.weights[index], true
+}
+
+// Item returns the item at index.
+func (dc *DynamicWeightedChooser[T]) Item(index int) (T, bool) {
+	if index < 0 || index >= len(dc.items) {
+		var zero T
+		return zero, false
+	}
+	return dc.items[index], true
+}
+
+// Total returns the sum of all weights.
+func (dc *DynamicWeightedChooser[T]) Total() float64 {
+	return dc.prefix(len(dc.items))
+}
+
+// Pick returns a random item chosen in proportion to its current weight, along with its
+// index. It returns false if the total weight is zero. A nil r uses a time-seeded generator.
+func (dc *DynamicWeightedChooser[T]) Pick(r *rand.Rand) (T, int, bool) {
+	total := dc.Total()
+	if total <= 0 {
+		var zero T
+		return zero, -1, false
+	}
+	if r == nil {
+		r = newRand(nil)
+	}
+
+	// Descend the Fenwick tree to the first index whose prefix sum exceeds the target
+	target := r.Float64() * total
+	pos := 0
+	for step := 1 << (bits.Len(uint(len(dc.items))) - 1); step > 0; step >>= 1 {
+		if next := pos + step; next < len(dc.tree) && dc.tree[next] <= target {
+			pos = next
+			target -= dc.tree[next]
+		}
+	}
+
+	// Rounding can land past the end or on a zero weight; fall back to the nearest
+	// weighted item
+	pos = min(pos, len(dc.items)-1)
+	for i := pos; i >= 0; i-- {
+		if dc.weights[i] > 0 {
+			return dc.items[i], i, true
+		}
+	}
+	for i := pos + 1; i < len(dc.items); i++ {
+		if dc.weights[i] > 0 {
+			return dc.items[i], i, true
+		}
+	}
+	var zero T
+	return zero, -1, false
+}
+
+// Size returns the number of items.
+func (dc *DynamicWeightedChooser[T]) Size() int {
+	return len(dc.items)
+}
+
+// IsEmpty returns true if the chooser has no items.
+func (dc *DynamicWeightedChooser[T]) IsEmpty() bool {
+	return len(dc.items) == 0
+}
+
+// prefix returns the sum of the first n weights.
+func (dc *DynamicWeightedChooser[T]) prefix(n int) float64 {
+	sum := 0.0
+	for ; n > 0; n -= n & -n {
+		sum += dc.tree[n]
+	}
+	return sum
+}
@@ -0,0 +1,86 @@
+package stl
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestWeightedChooserDistribution(t *testing.T) {
+	items := []string{"a", "b", "c", "d"}
+	weights := []float64{1, 2, 0, 5}
+	wc, ok := NewWeightedChooser(items, weights)
+	if !ok || wc.Size() != 4 {
+		t.Fatal("Expected a valid chooser")
+	}
+
+	r := rand.New(rand.NewSource(1))
+	counts := make(map[string]int)
+	const n = 80000
+	for i := 0; i < n; i++ {
+		counts[wc.Pick(r)]++
+	}
+
+	if counts["c"] != 0 {
+		t.Errorf("Zero-weight item was picked %d times", counts["c"])
+	}
+	for i, item := range items {
+		expected := weights[i] / 8
+		if got := float64(counts[item]) / n; math.Abs(got-expected) > 0.01 {
+			t.Errorf("Item %s: expected frequency %.3f, got %.3f", item, expected, got)
+		}
+	}
+
+	if _, ok := NewWeightedChooser([]int{1, 2}, []float64{1}); ok {
+		t.Error("Mismatched lengths should be rejected")
+	}
+	if _, ok := NewWeightedChooser([]int{1}, []float64{-1}); ok {
+		t.Error("Negative weights should be rejected")
+	}
+	if _, ok := NewWeightedChooser([]int{1, 2}, []float64{0, 0}); ok {
+		t.Error("All-zero weights should be rejected")
+	}
+}
+
+func TestDynamicWeightedChooser(t *testing.T) {
+	dc := NewDynamicWeightedChooser[string]()
+	if _, _, ok := dc.Pick(nil); ok {
+		t.Error("Pick on an empty chooser should fail")
+	}
+
+	for i, item := range []string{"a", "b", "c", "d", "e"} {
+		if index := dc.Add(item, float64(i+1)); index != i {
+			t.Errorf("Expected index %d, got %d", i, index)
+		}
+	}
+	if dc.Add("bad", math.NaN()) != -1 {
+		t.Error("NaN weights should be rejected")
+	}
+	if dc.Total() != 15 {
+		t.Errorf("Expected total 15, got %v", dc.Total())
+	}
+
+	// Leave only c and e with weight, in a 1:3 ratio
+	dc.SetWeight(0, 0)
+	dc.SetWeight(1, 0)
+	dc.SetWeight(2, 1)
+	dc.SetWeight(3, 0)
+	dc.SetWeight(4, 3)
+	if dc.SetWeight(5, 1) {
+		t.Error("SetWeight out of range should fail")
+	}
+
+	r := rand.New(rand.NewSource(2))
+	counts := make(map[string]int)
+	const n = 40000
+	for i := 0; i < n; i++ {
+		item, index, _ := dc.Pick(r)
+		if weight, _ := dc.Weight(index); weight == 0 {
+			t.Fatalf("Picked zero-weight item %s", item)
+		}
+		counts[item]++
+	}
+	if got := float64(counts["e"]) / n; math.Abs(got-0.75) > 0.01 {
+		t.Errorf("Expected e with frequency 0.75, got %.3f", got)
+	}
+}